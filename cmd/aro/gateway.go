@@ -14,7 +14,6 @@ import (
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
 	pkggateway "github.com/Azure/ARO-RP/pkg/gateway"
-	"github.com/Azure/ARO-RP/pkg/metrics/statsd"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/golang"
 	utilnet "github.com/Azure/ARO-RP/pkg/util/net"
 )
@@ -27,8 +26,12 @@ func gateway(ctx context.Context, _log *logrus.Entry) error {
 		return err
 	}
 
-	m := statsd.New(ctx, _env, os.Getenv("MDM_ACCOUNT"), os.Getenv("MDM_NAMESPACE"), os.Getenv("MDM_STATSD_SOCKET"))
-	go m.Run(stop)
+	emitters, err := newMetricsEmitters(_env)
+	if err != nil {
+		return err
+	}
+
+	m := emitters.New(ctx, _env, stop)
 
 	g, err := golang.NewMetrics(_env.LoggerForComponent("metrics"), m)
 	if err != nil {
@@ -27,7 +27,8 @@ func usage() {
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s monitor\n", os.Args[0])
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s portal\n", os.Args[0])
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s rp\n", os.Args[0])
-	fmt.Fprintf(flag.CommandLine.Output(), "  %s operator {master,worker}\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "  %s operator {master,worker} [internetchecker]\n", os.Args[0])
+	fmt.Fprintf(flag.CommandLine.Output(), "  %s operator master etcdbackup\n", os.Args[0])
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s update-versions\n", os.Args[0])
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s update-role-sets\n", os.Args[0])
 	fmt.Fprintf(flag.CommandLine.Output(), "  %s mimo-actuator\n", os.Args[0])
@@ -73,7 +74,7 @@ func main() {
 		audit := utillog.GetAuditEntry()
 		err = portal(ctx, log, audit)
 	case env.SERVICE_OPERATOR:
-		checkArgs(2)
+		checkMinArgs(2)
 		err = operator(log)
 	case env.SERVICE_UPDATE_OCP_VERSIONS:
 		checkArgs(1)
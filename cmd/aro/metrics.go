@@ -0,0 +1,156 @@
+package main
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/metrics/fanout"
+	"github.com/Azure/ARO-RP/pkg/metrics/prometheus"
+	"github.com/Azure/ARO-RP/pkg/metrics/statsd"
+)
+
+// metricsEmitters creates the metrics.Emitters selected by METRICS_EMITTERS, a
+// comma separated list of "statsd" (the default) and "prometheus". Service and
+// cluster metrics share the same Prometheus registry.
+type metricsEmitters struct {
+	statsd     bool
+	prometheus *prometheus.Prometheus
+}
+
+func newMetricsEmitters(_env env.Core) (*metricsEmitters, error) {
+	e := &metricsEmitters{}
+
+	emitters := os.Getenv("METRICS_EMITTERS")
+	if emitters == "" {
+		emitters = "statsd"
+	}
+
+	for name := range strings.SplitSeq(emitters, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "statsd":
+			e.statsd = true
+		case "prometheus":
+			maxSeries, err := prometheusMaxSeriesPerMetric(_env)
+			if err != nil {
+				return nil, err
+			}
+			e.prometheus = prometheus.New(_env, maxSeries)
+		default:
+			return nil, fmt.Errorf("unknown metrics emitter %q in METRICS_EMITTERS", name)
+		}
+	}
+
+	return e, nil
+}
+
+// monitorMaxSeriesPerMetric is the default number of series kept for each
+// metric by the monitor, whose cluster metrics have series for every cluster
+// in its shard and often for each of their nodes, operators and the like
+const monitorMaxSeriesPerMetric = 100000
+
+// prometheusMaxSeriesPerMetric returns the number of series to keep for each
+// metric in PROMETHEUS_MAX_SERIES_PER_METRIC, or the service's default if it
+// is not set
+func prometheusMaxSeriesPerMetric(_env env.Core) (int, error) {
+	s := os.Getenv("PROMETHEUS_MAX_SERIES_PER_METRIC")
+	if s == "" {
+		if _env.Service() == strings.ToLower(string(env.SERVICE_MONITOR)) {
+			return monitorMaxSeriesPerMetric, nil
+		}
+		return prometheus.DefaultMaxSeriesPerMetric, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid PROMETHEUS_MAX_SERIES_PER_METRIC %q", s)
+	}
+
+	return n, nil
+}
+
+// New returns the metrics.Emitter for the service's own metrics
+func (e *metricsEmitters) New(ctx context.Context, _env env.Core, stop <-chan struct{}) metrics.Emitter {
+	var s metrics.Emitter
+	if e.statsd {
+		m := statsd.New(ctx, _env, os.Getenv("MDM_ACCOUNT"), os.Getenv("MDM_NAMESPACE"), os.Getenv("MDM_STATSD_SOCKET"))
+		go m.Run(stop)
+		s = m
+	}
+
+	return e.withPrometheus(s)
+}
+
+// NewForCluster returns the metrics.Emitter for the metrics of the clusters
+// which the service manages
+func (e *metricsEmitters) NewForCluster(ctx context.Context, _env env.Core, stop <-chan struct{}) metrics.Emitter {
+	var s metrics.Emitter
+	if e.statsd {
+		m := statsd.NewMetricsForCluster(ctx, _env, os.Getenv("CLUSTER_MDM_ACCOUNT"), os.Getenv("CLUSTER_MDM_NAMESPACE"), os.Getenv("MDM_STATSD_SOCKET"))
+		go m.Run(stop)
+		s = m
+	}
+
+	return e.withPrometheus(s)
+}
+
+func (e *metricsEmitters) withPrometheus(s metrics.Emitter) metrics.Emitter {
+	switch {
+	case s == nil:
+		return e.prometheus
+	case e.prometheus == nil:
+		return s
+	default:
+		return fanout.New(s, e.prometheus)
+	}
+}
+
+// rpMetricsAddress and monitorMetricsAddress are where the RP and the monitor
+// serve /metrics. Their metrics carry cluster and subscription resource IDs,
+// so they are only served on localhost.
+const (
+	rpMetricsAddress      = "localhost:8448"
+	monitorMetricsAddress = "localhost:8447"
+)
+
+// serveMetrics serves /metrics on the listener returned by listen, for services
+// which do not otherwise have a private listener to serve it on, if the metrics
+// can be scraped
+func serveMetrics(_env env.Interface, m metrics.Emitter, listen func() (net.Listener, error)) error {
+	h := metrics.Handler(m)
+	if h == nil {
+		return nil
+	}
+
+	l, err := listen()
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", h)
+
+	s := &http.Server{
+		Handler:  mux,
+		ErrorLog: log.New(_env.Logger().Writer(), "", 0),
+	}
+
+	go func() {
+		err := s.Serve(l)
+		if err != http.ErrServerClosed {
+			_env.Logger().Error(err)
+		}
+	}()
+
+	return nil
+}
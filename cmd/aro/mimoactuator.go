@@ -17,7 +17,6 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
-	"github.com/Azure/ARO-RP/pkg/metrics/statsd"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/azure"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/golang"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/k8s"
@@ -49,8 +48,12 @@ func mimoActuator(ctx context.Context, _log *logrus.Entry) error {
 
 	log := _env.Logger()
 
-	m := statsd.New(ctx, _env, os.Getenv("MDM_ACCOUNT"), os.Getenv("MDM_NAMESPACE"), os.Getenv("MDM_STATSD_SOCKET"))
-	go m.Run(stop)
+	emitters, err := newMetricsEmitters(_env)
+	if err != nil {
+		return err
+	}
+
+	m := emitters.New(ctx, _env, stop)
 
 	g, err := golang.NewMetrics(log, m)
 	if err != nil {
@@ -15,7 +15,6 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
-	"github.com/Azure/ARO-RP/pkg/metrics/statsd"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/azure"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/golang"
 	"github.com/Azure/ARO-RP/pkg/mimo/scheduler"
@@ -45,8 +44,12 @@ func mimoScheduler(ctx context.Context, _log *logrus.Entry) error {
 
 	log := _env.Logger()
 
-	m := statsd.New(ctx, _env, os.Getenv("MDM_ACCOUNT"), os.Getenv("MDM_NAMESPACE"), os.Getenv("MDM_STATSD_SOCKET"))
-	go m.Run(stop)
+	emitters, err := newMetricsEmitters(_env)
+	if err != nil {
+		return err
+	}
+
+	m := emitters.New(ctx, _env, stop)
 
 	g, err := golang.NewMetrics(log, m)
 	if err != nil {
@@ -96,12 +99,18 @@ func mimoScheduler(ctx context.Context, _log *logrus.Entry) error {
 		return err
 	}
 
+	healthSnapshots, err := database.NewClusterHealthSnapshots(ctx, dbc, dbName)
+	if err != nil {
+		return err
+	}
+
 	dbg := database.NewDBGroup().
 		WithOpenShiftClusters(clusters).
 		WithSubscriptions(subscriptions).
 		WithMaintenanceManifests(manifests).
 		WithMaintenanceSchedules(schedules).
-		WithPoolWorkers(poolWorkers)
+		WithPoolWorkers(poolWorkers).
+		WithClusterHealthSnapshots(healthSnapshots)
 
 	a := scheduler.NewService(_env, log, dbg, m)
 	a.SetMaintenanceTasks(tasks.DEFAULT_MAINTENANCE_TASKS)
@@ -5,9 +5,12 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 
 	"github.com/sirupsen/logrus"
@@ -18,7 +21,6 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
-	"github.com/Azure/ARO-RP/pkg/metrics/statsd"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/azure"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/golang"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/k8s"
@@ -47,8 +49,12 @@ func monitor(ctx context.Context, _log *logrus.Entry) error {
 		}
 	}
 
-	m := statsd.New(ctx, _env, os.Getenv("MDM_ACCOUNT"), os.Getenv("MDM_NAMESPACE"), os.Getenv("MDM_STATSD_SOCKET"))
-	go m.Run(monitorWorkersDone)
+	emitters, err := newMetricsEmitters(_env)
+	if err != nil {
+		return err
+	}
+
+	m := emitters.New(ctx, _env, monitorWorkersDone)
 
 	g, err := golang.NewMetrics(_env.LoggerForComponent("metrics"), m)
 	if err != nil {
@@ -63,8 +69,14 @@ func monitor(ctx context.Context, _log *logrus.Entry) error {
 		RequestLatency: k8s.NewLatency(m),
 	})
 
-	clusterm := statsd.NewMetricsForCluster(ctx, _env, os.Getenv("CLUSTER_MDM_ACCOUNT"), os.Getenv("CLUSTER_MDM_NAMESPACE"), os.Getenv("MDM_STATSD_SOCKET"))
-	go clusterm.Run(monitorWorkersDone)
+	clusterm := emitters.NewForCluster(ctx, _env, monitorWorkersDone)
+
+	err = serveMetrics(_env, m, func() (net.Listener, error) {
+		return net.Listen("tcp", monitorMetricsAddress)
+	})
+	if err != nil {
+		return err
+	}
 
 	aead, err := encryption.NewAEADWithCore(ctx, _env, env.EncryptionSecretV2Name, env.EncryptionSecretName)
 	if err != nil {
@@ -96,16 +108,32 @@ func monitor(ctx context.Context, _log *logrus.Entry) error {
 		return err
 	}
 
+	dbClusterHealthSnapshots, err := database.NewClusterHealthSnapshots(ctx, dbc, dbName)
+	if err != nil {
+		return err
+	}
+
 	dbg := database.NewDBGroup().WithOpenShiftClusters(dbOpenShiftClusters).
 		WithSubscriptions(dbSubscriptions).
-		WithPoolWorkers(dbPoolWorkers)
+		WithPoolWorkers(dbPoolWorkers).
+		WithClusterHealthSnapshots(dbClusterHealthSnapshots)
 
 	dialer, err := proxy.NewDialer(_env.IsLocalDevelopmentMode(), _env.LoggerForComponent("dialer"))
 	if err != nil {
 		return err
 	}
 
-	mon := pkgmonitor.NewMonitor(_env.LoggerForComponent("monitor"), dialer, dbg, m, clusterm, _env)
+	eventSink, err := newMonitorEventSink(_env)
+	if err != nil {
+		return err
+	}
+
+	maxConcurrentRuns, err := monitorMaxConcurrentRuns()
+	if err != nil {
+		return err
+	}
+
+	mon := pkgmonitor.NewMonitor(_env.LoggerForComponent("monitor"), dialer, dbg, m, clusterm, eventSink, _env, maxConcurrentRuns)
 
 	sigterm := make(chan os.Signal, 1)
 	signal.Notify(sigterm, syscall.SIGTERM)
@@ -127,3 +155,19 @@ func monitor(ctx context.Context, _log *logrus.Entry) error {
 
 	return nil
 }
+
+// monitorMaxConcurrentRuns returns the budget of concurrent monitoring passes
+// in MONITOR_MAX_CONCURRENT_RUNS, or 0 (unlimited) if it is not set
+func monitorMaxConcurrentRuns() (int, error) {
+	s := os.Getenv("MONITOR_MAX_CONCURRENT_RUNS")
+	if s == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid MONITOR_MAX_CONCURRENT_RUNS %q", s)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,60 @@
+package main
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
+)
+
+// newMonitorEventSink returns the events.Sink selected by MONITOR_EVENT_SINKS,
+// a comma separated list of "log" (the default), "webhook" and "file", or nil
+// if it is "none". The webhook sink POSTs to MONITOR_EVENT_WEBHOOK_URL and the
+// file sink appends to MONITOR_EVENT_FILE.
+func newMonitorEventSink(_env env.Core) (events.Sink, error) {
+	names := os.Getenv("MONITOR_EVENT_SINKS")
+	if names == "" {
+		names = "log"
+	}
+
+	var sinks []events.Sink
+	for name := range strings.SplitSeq(names, ",") {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "none":
+			return nil, nil
+		case "log":
+			sinks = append(sinks, events.NewLogSink(_env.LoggerForComponent("monitor-events")))
+		case "webhook":
+			u, err := url.Parse(os.Getenv("MONITOR_EVENT_WEBHOOK_URL"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid MONITOR_EVENT_WEBHOOK_URL: %w", err)
+			}
+			if u.Scheme != "https" && !(_env.IsLocalDevelopmentMode() && u.Scheme == "http") {
+				return nil, fmt.Errorf("MONITOR_EVENT_WEBHOOK_URL must be an https URL")
+			}
+			sinks = append(sinks, events.NewWebhookSink(u.String(), &http.Client{Timeout: 10 * time.Second}))
+		case "file":
+			path := os.Getenv("MONITOR_EVENT_FILE")
+			if path == "" {
+				return nil, fmt.Errorf("MONITOR_EVENT_FILE must be set for the file event sink")
+			}
+			sinks = append(sinks, events.NewFileSink(path))
+		default:
+			return nil, fmt.Errorf("unknown event sink %q in MONITOR_EVENT_SINKS", name)
+		}
+	}
+
+	if len(sinks) == 1 {
+		return sinks[0], nil
+	}
+
+	return events.NewMultiSink(sinks...), nil
+}
@@ -13,6 +13,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/autosizednodes"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/banner"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/checkers/clusterdnschecker"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/checkers/dnsresolutionchecker"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/checkers/ingresscertificatechecker"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/checkers/internetchecker"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/checkers/serviceprincipalchecker"
@@ -31,6 +33,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/clusteroperatoraro"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/cpms"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/dnsmasq"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/etcdbackup"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/etchosts"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/genevalogging"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/guardrails"
@@ -62,6 +65,19 @@ func operator(log *logrus.Entry) error {
 	default:
 		return fmt.Errorf("invalid role %s", role)
 	}
+	switch flag.Arg(2) {
+	case "":
+	case "internetchecker":
+		return internetCheckerNode(log, role)
+	case "etcdbackup":
+		if role != pkgoperator.RoleMaster {
+			return fmt.Errorf("invalid role %s for operator command %s", role, flag.Arg(2))
+		}
+		return etcdBackupUpload(log)
+	default:
+		return fmt.Errorf("invalid operator command %s", flag.Arg(2))
+	}
+
 	isLocalDevelopmentMode := env.IsLocalDevelopmentMode()
 	if isLocalDevelopmentMode {
 		log.Info("running in local development mode")
@@ -115,6 +131,11 @@ func operator(log *logrus.Entry) error {
 			client)).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller %s: %v", pullsecret.ControllerName, err)
 		}
+		if err = (internetchecker.NewDaemonSetReconciler(
+			log.WithField("controller", internetchecker.DaemonSetControllerName),
+			client, ch)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller %s: %v", internetchecker.DaemonSetControllerName, err)
+		}
 		if err = (alertwebhook.NewReconciler(
 			log.WithField("controller", alertwebhook.ControllerName),
 			client)).SetupWithManager(mgr); err != nil {
@@ -236,7 +257,7 @@ func operator(log *logrus.Entry) error {
 		}
 		if err = (guardrails.NewReconciler(
 			log.WithField("controller", guardrails.ControllerName),
-			client, dh, kubernetescli)).SetupWithManager(mgr); err != nil {
+			client, dh, kubernetescli, restConfig)).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller %s: %v", guardrails.ControllerName, err)
 		}
 		if err = (cloudproviderconfig.NewReconciler(
@@ -254,6 +275,11 @@ func operator(log *logrus.Entry) error {
 			client, ch)).SetupWithManager(mgr); err != nil {
 			return fmt.Errorf("unable to create controller %s: %v", etchosts.ClusterControllerName, err)
 		}
+		if err = (etcdbackup.NewReconciler(
+			log.WithField("controller", etcdbackup.ControllerName),
+			client)).SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create controller %s: %v", etcdbackup.ControllerName, err)
+		}
 
 		// only register CPMS controller on clusters that support the CRD
 		if err := discovery.ServerSupportsVersion(discoverycli, machinev1.GroupVersion); err == nil {
@@ -273,6 +299,12 @@ func operator(log *logrus.Entry) error {
 		return fmt.Errorf("unable to create controller %s: %v", internetchecker.ControllerName, err)
 	}
 
+	if err = (dnsresolutionchecker.NewReconciler(
+		log.WithField("controller", dnsresolutionchecker.ControllerName),
+		client, role)).SetupWithManager(mgr); err != nil {
+		return fmt.Errorf("unable to create controller %s: %v", dnsresolutionchecker.ControllerName, err)
+	}
+
 	// +kubebuilder:scaffold:builder
 
 	log.Info("starting manager")
@@ -288,3 +320,45 @@ func operator(log *logrus.Entry) error {
 
 	return mgr.Start(ctrl.SetupSignalHandler())
 }
+
+// internetCheckerNode runs the internet checker on a single node, as an
+// internet checker DaemonSet pod
+func internetCheckerNode(log *logrus.Entry, role string) error {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return err
+	}
+
+	n, err := internetchecker.NewNodeChecker(log.WithField("controller", internetchecker.ControllerName), c, role)
+	if err != nil {
+		return err
+	}
+
+	return n.Run(ctrl.SetupSignalHandler())
+}
+
+// etcdBackupUpload uploads the etcd snapshot taken by an etcd backup Job, as
+// the Job's main container
+func etcdBackupUpload(log *logrus.Entry) error {
+	restConfig, err := ctrl.GetConfig()
+	if err != nil {
+		return err
+	}
+
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return err
+	}
+
+	u, err := etcdbackup.NewUploader(log.WithField("controller", etcdbackup.ControllerName), c)
+	if err != nil {
+		return err
+	}
+
+	return u.Run(ctrl.SetupSignalHandler())
+}
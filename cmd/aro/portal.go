@@ -97,9 +97,15 @@ func portal(ctx context.Context, _log *logrus.Entry, auditLog *logrus.Entry) err
 		return err
 	}
 
+	dbClusterHealthSnapshots, err := database.NewClusterHealthSnapshots(ctx, dbc, dbName)
+	if err != nil {
+		return err
+	}
+
 	dbGroup := database.NewDBGroup().
 		WithOpenShiftClusters(dbOpenShiftClusters).
-		WithPortal(dbPortal)
+		WithPortal(dbPortal).
+		WithClusterHealthSnapshots(dbClusterHealthSnapshots)
 
 	msiCredential, err := _env.NewMSITokenCredential()
 	if err != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"os/signal"
 	"syscall"
@@ -30,16 +31,17 @@ import (
 	_ "github.com/Azure/ARO-RP/pkg/api/v20231122"
 	_ "github.com/Azure/ARO-RP/pkg/api/v20240812preview"
 	_ "github.com/Azure/ARO-RP/pkg/api/v20250725"
+	_ "github.com/Azure/ARO-RP/pkg/api/v20261001preview"
 	"github.com/Azure/ARO-RP/pkg/backend"
 	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/frontend"
 	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
 	"github.com/Azure/ARO-RP/pkg/hive"
-	"github.com/Azure/ARO-RP/pkg/metrics/statsd"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/azure"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/golang"
 	"github.com/Azure/ARO-RP/pkg/metrics/statsd/k8s"
+	oteltracing "github.com/Azure/ARO-RP/pkg/otel/tracing"
 	"github.com/Azure/ARO-RP/pkg/util/clusterdata"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
 	"github.com/Azure/ARO-RP/pkg/util/log/audit"
@@ -91,8 +93,12 @@ func rp(ctx context.Context, _log, auditLog *logrus.Entry) error {
 		return err
 	}
 
-	metrics := statsd.New(ctx, _env, os.Getenv("MDM_ACCOUNT"), os.Getenv("MDM_NAMESPACE"), os.Getenv("MDM_STATSD_SOCKET"))
-	go metrics.Run(stop)
+	emitters, err := newMetricsEmitters(_env)
+	if err != nil {
+		return err
+	}
+
+	metrics := emitters.New(ctx, _env, stop)
 
 	g, err := golang.NewMetrics(_env.LoggerForComponent("metrics"), metrics)
 	if err != nil {
@@ -107,8 +113,25 @@ func rp(ctx context.Context, _log, auditLog *logrus.Entry) error {
 		RequestLatency: k8s.NewLatency(metrics),
 	})
 
-	clusterm := statsd.NewMetricsForCluster(ctx, _env, os.Getenv("CLUSTER_MDM_ACCOUNT"), os.Getenv("CLUSTER_MDM_NAMESPACE"), os.Getenv("MDM_STATSD_SOCKET"))
-	go clusterm.Run(stop)
+	shutdownTracing, err := oteltracing.Setup(ctx, _env.LoggerForComponent("tracing"), "aro-rp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := shutdownTracing(context.Background())
+		if err != nil {
+			log.Print(err)
+		}
+	}()
+
+	clusterm := emitters.NewForCluster(ctx, _env, stop)
+
+	err = serveMetrics(_env, metrics, func() (net.Listener, error) {
+		return net.Listen("tcp", rpMetricsAddress)
+	})
+	if err != nil {
+		return err
+	}
 
 	aead, err := encryption.NewAEADWithCore(ctx, _env, env.EncryptionSecretV2Name, env.EncryptionSecretName)
 	if err != nil {
@@ -172,6 +195,11 @@ func rp(ctx context.Context, _log, auditLog *logrus.Entry) error {
 		return err
 	}
 
+	dbClusterHealthSnapshots, err := database.NewClusterHealthSnapshots(ctx, dbc, dbName)
+	if err != nil {
+		return err
+	}
+
 	// Note: When handling DB operations don't delete records but set TTL on them otherwise if we're leveraging change feeds, it will break.
 	dbPlatformWorkloadIdentityRoleSets, err := database.NewPlatformWorkloadIdentityRoleSets(ctx, dbc, dbName)
 	if err != nil {
@@ -202,6 +230,7 @@ func rp(ctx context.Context, _log, auditLog *logrus.Entry) error {
 		WithSubscriptions(dbSubscriptions).
 		WithMaintenanceManifests(dbMaintenanceManifests).
 		WithMaintenanceSchedules(dbMaintenanceSchedules).
+		WithClusterHealthSnapshots(dbClusterHealthSnapshots).
 		WithPortal(dbPortal)
 
 	size, err := _env.OtelAuditQueueSize()
@@ -0,0 +1,83 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// ClusterHealthSnapshot represents the health of a cluster as seen by the
+// monitor's collectors.
+type ClusterHealthSnapshot struct {
+	// The resource ID of the cluster.
+	ClusterResourceID string `json:"clusterResourceID,omitempty"`
+
+	// The time, in Unix seconds, at which the monitoring pass which produced
+	// the snapshot started.
+	CollectedAt int64 `json:"collectedAt,omitempty"`
+
+	// The latest results of each collector, ordered by name.
+	Collectors []ClusterHealthCollectorResult `json:"collectors"`
+
+	// What the drift monitor found the last time it compared the cluster's
+	// managed resource group with the cluster document.
+	Drift *ClusterHealthDriftReport `json:"drift,omitempty"`
+}
+
+// ClusterHealthCollectorResult represents the outcome of a single monitor
+// collector and what it found wrong with the cluster.
+type ClusterHealthCollectorResult struct {
+	Name            string  `json:"name,omitempty"`
+	CollectedAt     int64   `json:"collectedAt,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+	Error           string  `json:"error,omitempty"`
+
+	Conditions   []ClusterHealthCondition   `json:"conditions,omitempty"`
+	Certificates []ClusterHealthCertificate `json:"certificates,omitempty"`
+	Alerts       []ClusterHealthAlert       `json:"alerts,omitempty"`
+
+	// The time, in Unix seconds, at which the earliest expiring certificate
+	// found by the collector expires.
+	EarliestCertificateExpiry int64 `json:"earliestCertificateExpiry,omitempty"`
+}
+
+// ClusterHealthCondition represents an unexpected condition of a cluster
+// operator, node or machine config pool.
+type ClusterHealthCondition struct {
+	Name    string `json:"name,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterHealthCertificate represents a certificate which expires soon.
+type ClusterHealthCertificate struct {
+	Namespace           string `json:"namespace,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Subject             string `json:"subject,omitempty"`
+	DaysUntilExpiration int    `json:"daysUntilExpiration"`
+}
+
+// ClusterHealthAlert represents a firing Prometheus alert.
+type ClusterHealthAlert struct {
+	Name            string `json:"name,omitempty"`
+	Severity        string `json:"severity,omitempty"`
+	Target          string `json:"target,omitempty"`
+	SecondaryTarget string `json:"secondaryTarget,omitempty"`
+	Count           int64  `json:"count,omitempty"`
+}
+
+// ClusterHealthDriftReport represents the resources in a cluster's managed
+// resource group which differ from what the RP expects.
+type ClusterHealthDriftReport struct {
+	// The time, in Unix seconds, at which the drift monitor ran.
+	CheckedAt int64                          `json:"checkedAt,omitempty"`
+	Error     string                         `json:"error,omitempty"`
+	Resources []ClusterHealthDriftedResource `json:"resources,omitempty"`
+}
+
+// ClusterHealthDriftedResource represents a resource which differs from what
+// the RP expects.
+type ClusterHealthDriftedResource struct {
+	ResourceType string `json:"resourceType,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
@@ -0,0 +1,77 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type clusterHealthSnapshotConverter struct{}
+
+func (c clusterHealthSnapshotConverter) ToExternal(doc *api.ClusterHealthSnapshotDocument) interface{} {
+	out := &ClusterHealthSnapshot{
+		ClusterResourceID: doc.ClusterResourceID,
+		CollectedAt:       doc.ClusterHealthSnapshot.CollectedAt,
+		Collectors:        make([]ClusterHealthCollectorResult, 0, len(doc.ClusterHealthSnapshot.Collectors)),
+	}
+
+	for _, r := range doc.ClusterHealthSnapshot.Collectors {
+		result := ClusterHealthCollectorResult{
+			Name:            r.Name,
+			CollectedAt:     r.CollectedAt,
+			DurationSeconds: r.DurationSeconds,
+			Error:           r.Error,
+
+			EarliestCertificateExpiry: r.EarliestCertificateExpiry,
+		}
+
+		for _, c := range r.Conditions {
+			result.Conditions = append(result.Conditions, ClusterHealthCondition{
+				Name:    c.Name,
+				Type:    c.Type,
+				Status:  c.Status,
+				Message: c.Message,
+			})
+		}
+
+		for _, c := range r.Certificates {
+			result.Certificates = append(result.Certificates, ClusterHealthCertificate{
+				Namespace:           c.Namespace,
+				Name:                c.Name,
+				Subject:             c.Subject,
+				DaysUntilExpiration: c.DaysUntilExpiration,
+			})
+		}
+
+		for _, a := range r.Alerts {
+			result.Alerts = append(result.Alerts, ClusterHealthAlert{
+				Name:            a.Name,
+				Severity:        a.Severity,
+				Target:          a.Target,
+				SecondaryTarget: a.SecondaryTarget,
+				Count:           a.Count,
+			})
+		}
+
+		out.Collectors = append(out.Collectors, result)
+	}
+
+	if d := doc.ClusterHealthSnapshot.Drift; d != nil {
+		out.Drift = &ClusterHealthDriftReport{
+			CheckedAt: d.CheckedAt,
+			Error:     d.Error,
+		}
+
+		for _, r := range d.Resources {
+			out.Drift.Resources = append(out.Drift.Resources, ClusterHealthDriftedResource{
+				ResourceType: r.ResourceType,
+				ResourceName: r.ResourceName,
+				Reason:       r.Reason,
+				Message:      r.Message,
+			})
+		}
+	}
+
+	return out
+}
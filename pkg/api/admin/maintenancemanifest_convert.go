@@ -28,6 +28,8 @@ func (m maintenanceManifestConverter) ToExternal(d *api.MaintenanceManifestDocum
 
 		RunAfter:  d.MaintenanceManifest.RunAfter,
 		RunBefore: d.MaintenanceManifest.RunBefore,
+
+		Prerequisites: d.MaintenanceManifest.Prerequisites,
 	}
 }
 
@@ -55,4 +57,5 @@ func (m maintenanceManifestConverter) ToInternal(_i interface{}, out *api.Mainte
 	out.MaintenanceManifest.RunBefore = i.RunBefore
 	out.MaintenanceManifest.State = api.MaintenanceManifestState(i.State)
 	out.MaintenanceManifest.StatusText = i.StatusText
+	out.MaintenanceManifest.Prerequisites = i.Prerequisites
 }
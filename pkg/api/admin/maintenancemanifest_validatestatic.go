@@ -4,7 +4,9 @@ package admin
 // Licensed under the Apache License 2.0.
 
 import (
+	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/util/immutable"
@@ -46,6 +48,20 @@ func (sv maintenanceManifestStaticValidator) validate(new *MaintenanceManifest)
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "runBefore", "Must be provided")
 	}
 
+	seen := map[string]bool{}
+	for i, id := range new.Prerequisites {
+		if id == "" || id != strings.ToLower(id) {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("prerequisites[%d]", i), "Must be a lower case manifest ID")
+		}
+		if id == new.ID {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("prerequisites[%d]", i), "Must not refer to this manifest")
+		}
+		if seen[id] {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("prerequisites[%d]", i), fmt.Sprintf("Must not duplicate '%s'", id))
+		}
+		seen[id] = true
+	}
+
 	return nil
 }
 
@@ -0,0 +1,94 @@
+package admin
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceManifestStaticValidator_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		new     *MaintenanceManifest
+		wantErr string
+	}{
+		{
+			name: "valid case",
+			new: &MaintenanceManifest{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				RunAfter:          1,
+				RunBefore:         2,
+				Prerequisites:     []string{"00001", "00002"},
+			},
+		},
+		{
+			name: "no task ID",
+			new: &MaintenanceManifest{
+				ID:        "00000",
+				RunAfter:  1,
+				RunBefore: 2,
+			},
+			wantErr: "400: InvalidParameter: maintenanceTaskID: Must be provided",
+		},
+		{
+			name: "empty prerequisite",
+			new: &MaintenanceManifest{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				RunAfter:          1,
+				RunBefore:         2,
+				Prerequisites:     []string{"00001", ""},
+			},
+			wantErr: "400: InvalidParameter: prerequisites[1]: Must be a lower case manifest ID",
+		},
+		{
+			name: "upper case prerequisite",
+			new: &MaintenanceManifest{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				RunAfter:          1,
+				RunBefore:         2,
+				Prerequisites:     []string{"0000A"},
+			},
+			wantErr: "400: InvalidParameter: prerequisites[0]: Must be a lower case manifest ID",
+		},
+		{
+			name: "prerequisite on itself",
+			new: &MaintenanceManifest{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				RunAfter:          1,
+				RunBefore:         2,
+				Prerequisites:     []string{"00000"},
+			},
+			wantErr: "400: InvalidParameter: prerequisites[0]: Must not refer to this manifest",
+		},
+		{
+			name: "duplicate prerequisite",
+			new: &MaintenanceManifest{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				RunAfter:          1,
+				RunBefore:         2,
+				Prerequisites:     []string{"00001", "00001"},
+			},
+			wantErr: "400: InvalidParameter: prerequisites[1]: Must not duplicate '00001'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sv := maintenanceManifestStaticValidator{}
+			gotErr := sv.validate(tt.new)
+
+			if tt.wantErr != "" {
+				require.Equal(t, tt.wantErr, gotErr.Error())
+			} else {
+				require.NoError(t, gotErr)
+			}
+		})
+	}
+}
@@ -21,6 +21,11 @@ func (m maintenanceScheduleConverter) ToExternal(d *api.MaintenanceScheduleDocum
 		ScheduleAcross:   d.MaintenanceSchedule.ScheduleAcross,
 
 		Selectors: convertSelectorsToExternal(d.MaintenanceSchedule.Selectors),
+
+		Waves:                       d.MaintenanceSchedule.Waves,
+		WaveFailureThresholdPercent: d.MaintenanceSchedule.WaveFailureThresholdPercent,
+
+		PausedReason: d.MaintenanceSchedule.PausedReason,
 	}
 }
 
@@ -48,6 +53,11 @@ func (m maintenanceScheduleConverter) ToInternal(_i interface{}, out *api.Mainte
 	out.MaintenanceSchedule.ScheduleAcross = i.ScheduleAcross
 	out.MaintenanceSchedule.LookForwardCount = i.LookForwardCount
 	out.MaintenanceSchedule.Selectors = convertSelectorsToInternal(i.Selectors)
+
+	out.MaintenanceSchedule.Waves = i.Waves
+	out.MaintenanceSchedule.WaveFailureThresholdPercent = i.WaveFailureThresholdPercent
+
+	// PausedReason is only set by the scheduler
 }
 
 func convertSelectorsToExternal(s []*api.MaintenanceScheduleSelector) []*MaintenanceScheduleSelector {
@@ -59,11 +69,20 @@ func convertSelectorsToExternal(s []*api.MaintenanceScheduleSelector) []*Mainten
 			Operator: MaintenanceScheduleSelectorOperator(i.Operator),
 			Value:    i.Value,
 			Values:   i.Values,
+
+			Selectors: convertNestedSelectorsToExternal(i.Selectors),
 		})
 	}
 	return r
 }
 
+func convertNestedSelectorsToExternal(s []*api.MaintenanceScheduleSelector) []*MaintenanceScheduleSelector {
+	if len(s) == 0 {
+		return nil
+	}
+	return convertSelectorsToExternal(s)
+}
+
 func convertSelectorsToInternal(s []*MaintenanceScheduleSelector) []*api.MaintenanceScheduleSelector {
 	r := []*api.MaintenanceScheduleSelector{}
 
@@ -73,7 +92,16 @@ func convertSelectorsToInternal(s []*MaintenanceScheduleSelector) []*api.Mainten
 			Operator: api.MaintenanceScheduleSelectorOperator(i.Operator),
 			Value:    i.Value,
 			Values:   i.Values,
+
+			Selectors: convertNestedSelectorsToInternal(i.Selectors),
 		})
 	}
 	return r
 }
+
+func convertNestedSelectorsToInternal(s []*MaintenanceScheduleSelector) []*api.MaintenanceScheduleSelector {
+	if len(s) == 0 {
+		return nil
+	}
+	return convertSelectorsToInternal(s)
+}
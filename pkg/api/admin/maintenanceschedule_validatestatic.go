@@ -6,6 +6,7 @@ package admin
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"slices"
 	"strings"
 	"time"
@@ -63,29 +64,87 @@ func (sv maintenanceScheduleStaticValidator) validate(new *MaintenanceSchedule)
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "selectors", "Must be provided")
 	}
 
+	err := sv.validateSelectors("selectors", new.Selectors)
+	if err != nil {
+		return err
+	}
+
+	for i, w := range new.Waves {
+		if w < 1 || w > 100 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("waves[%d]", i), "Must be between 1 and 100")
+		}
+		if i > 0 && w <= new.Waves[i-1] {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("waves[%d]", i), "Must be greater than the previous wave")
+		}
+	}
+
+	if len(new.Waves) > 0 && new.Waves[len(new.Waves)-1] != 100 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("waves[%d]", len(new.Waves)-1), "The final wave must be 100")
+	}
+
+	if new.WaveFailureThresholdPercent < 0 || new.WaveFailureThresholdPercent > 100 {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "waveFailureThresholdPercent", "Must be between 0 and 100")
+	}
+
+	return nil
+}
+
+func (sv maintenanceScheduleStaticValidator) validateSelectors(path string, selectors []*MaintenanceScheduleSelector) error {
 	validOps := validSelectorOperators()
-	for i, s := range new.Selectors {
+	for i, s := range selectors {
 		if !slices.Contains(validOps, s.Operator) {
 			r := []string{}
 			for _, v := range validOps {
 				r = append(r, "'"+string(v)+"'")
 			}
 
-			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("selectors[%d].operator", i), fmt.Sprintf("Must be one of [%s]", strings.Join(r, ", ")))
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].operator", path, i), fmt.Sprintf("Must be one of [%s]", strings.Join(r, ", ")))
 		}
-		if s.Operator == MaintenanceScheduleSelectorOperatorIn || s.Operator == MaintenanceScheduleSelectorOperatorNotIn {
+
+		switch s.Operator {
+		case MaintenanceScheduleSelectorOperatorAny, MaintenanceScheduleSelectorOperatorAll:
+			if len(s.Selectors) == 0 {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].selectors", path, i), fmt.Sprintf("Must be provided for operator type '%s'", s.Operator))
+			}
+			if s.Key != "" {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].key", path, i), fmt.Sprintf("Must not be provided for operator type '%s'", s.Operator))
+			}
+			if s.Value != "" {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].value", path, i), fmt.Sprintf("Must not be provided for operator type '%s'", s.Operator))
+			}
+			if len(s.Values) > 0 {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].values", path, i), fmt.Sprintf("Must not be provided for operator type '%s'", s.Operator))
+			}
+
+			err := sv.validateSelectors(fmt.Sprintf("%s[%d].selectors", path, i), s.Selectors)
+			if err != nil {
+				return err
+			}
+			continue
+		case MaintenanceScheduleSelectorOperatorIn, MaintenanceScheduleSelectorOperatorNotIn:
 			if len(s.Values) == 0 {
-				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("selectors[%d].values", i), fmt.Sprintf("Must be provided for operator type '%s'", s.Operator))
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].values", path, i), fmt.Sprintf("Must be provided for operator type '%s'", s.Operator))
 			}
 			if s.Value != "" {
-				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("selectors[%d].value", i), fmt.Sprintf("Must not be provided for operator type '%s'", s.Operator))
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].value", path, i), fmt.Sprintf("Must not be provided for operator type '%s'", s.Operator))
 			}
-		} else {
+		default:
 			if s.Value == "" {
-				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("selectors[%d].value", i), fmt.Sprintf("Must be provided for operator type '%s'", s.Operator))
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].value", path, i), fmt.Sprintf("Must be provided for operator type '%s'", s.Operator))
 			}
 			if len(s.Values) > 0 {
-				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("selectors[%d].values", i), fmt.Sprintf("Must not be provided for operator type '%s'", s.Operator))
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].values", path, i), fmt.Sprintf("Must not be provided for operator type '%s'", s.Operator))
+			}
+		}
+
+		if len(s.Selectors) > 0 {
+			return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].selectors", path, i), fmt.Sprintf("Must not be provided for operator type '%s'", s.Operator))
+		}
+
+		if s.Operator == MaintenanceScheduleSelectorOperatorMatches {
+			_, err := regexp.Compile(s.Value)
+			if err != nil {
+				return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("%s[%d].value", path, i), fmt.Sprintf("Must be a valid regular expression: %s", err.Error()))
 			}
 		}
 	}
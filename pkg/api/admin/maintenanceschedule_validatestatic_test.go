@@ -84,7 +84,7 @@ func TestMaintenanceScheduleStaticValidator_validate(t *testing.T) {
 					},
 				},
 			},
-			wantErr: "400: InvalidParameter: selectors[0].operator: Must be one of ['eq', 'in', 'notin']",
+			wantErr: "400: InvalidParameter: selectors[0].operator: Must be one of ['eq', 'in', 'notin', 'lt', 'le', 'gt', 'ge', 'matches', 'any', 'all']",
 		},
 		{
 			name: "missing scheduleacross",
@@ -121,6 +121,244 @@ func TestMaintenanceScheduleStaticValidator_validate(t *testing.T) {
 			},
 			wantErr: `400: InvalidParameter: scheduleAcross: Must be a valid time.Duration: unknown unit "srgndf" in duration "1srgndf"`,
 		},
+		{
+			name: "valid waves",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Key:      "something",
+						Operator: MaintenanceScheduleSelectorOperatorIn,
+						Values:   []string{"foobar"},
+					},
+				},
+				Waves:                       []int{1, 10, 50, 100},
+				WaveFailureThresholdPercent: 5,
+			},
+		},
+		{
+			name: "wave out of range",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Key:      "something",
+						Operator: MaintenanceScheduleSelectorOperatorIn,
+						Values:   []string{"foobar"},
+					},
+				},
+				Waves: []int{0, 100},
+			},
+			wantErr: "400: InvalidParameter: waves[0]: Must be between 1 and 100",
+		},
+		{
+			name: "waves not ascending",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Key:      "something",
+						Operator: MaintenanceScheduleSelectorOperatorIn,
+						Values:   []string{"foobar"},
+					},
+				},
+				Waves: []int{10, 10, 100},
+			},
+			wantErr: "400: InvalidParameter: waves[1]: Must be greater than the previous wave",
+		},
+		{
+			name: "waves not ending in 100",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Key:      "something",
+						Operator: MaintenanceScheduleSelectorOperatorIn,
+						Values:   []string{"foobar"},
+					},
+				},
+				Waves: []int{10, 50},
+			},
+			wantErr: "400: InvalidParameter: waves[1]: The final wave must be 100",
+		},
+		{
+			name: "failure threshold out of range",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Key:      "something",
+						Operator: MaintenanceScheduleSelectorOperatorIn,
+						Values:   []string{"foobar"},
+					},
+				},
+				Waves:                       []int{100},
+				WaveFailureThresholdPercent: 101,
+			},
+			wantErr: "400: InvalidParameter: waveFailureThresholdPercent: Must be between 0 and 100",
+		},
+		{
+			name: "valid nested groups",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Operator: MaintenanceScheduleSelectorOperatorAny,
+						Selectors: []*MaintenanceScheduleSelector{
+							{
+								Key:      "version",
+								Operator: MaintenanceScheduleSelectorOperatorLt,
+								Value:    "4.14.0",
+							},
+							{
+								Operator: MaintenanceScheduleSelectorOperatorAll,
+								Selectors: []*MaintenanceScheduleSelector{
+									{
+										Key:      "region",
+										Operator: MaintenanceScheduleSelectorOperatorMatches,
+										Value:    "^east",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "group without selectors",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Operator: MaintenanceScheduleSelectorOperatorAll,
+					},
+				},
+			},
+			wantErr: "400: InvalidParameter: selectors[0].selectors: Must be provided for operator type 'all'",
+		},
+		{
+			name: "group with key",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Key:      "region",
+						Operator: MaintenanceScheduleSelectorOperatorAny,
+						Selectors: []*MaintenanceScheduleSelector{
+							{
+								Key:      "region",
+								Operator: MaintenanceScheduleSelectorOperatorEq,
+								Value:    "eastus",
+							},
+						},
+					},
+				},
+			},
+			wantErr: "400: InvalidParameter: selectors[0].key: Must not be provided for operator type 'any'",
+		},
+		{
+			name: "invalid nested selector",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Operator: MaintenanceScheduleSelectorOperatorAny,
+						Selectors: []*MaintenanceScheduleSelector{
+							{
+								Key:      "region",
+								Operator: MaintenanceScheduleSelectorOperatorEq,
+								Value:    "eastus",
+							},
+							{
+								Key:      "version",
+								Operator: MaintenanceScheduleSelectorOperatorGe,
+							},
+						},
+					},
+				},
+			},
+			wantErr: "400: InvalidParameter: selectors[0].selectors[1].value: Must be provided for operator type 'ge'",
+		},
+		{
+			name: "nested selectors on a non-group selector",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Key:      "region",
+						Operator: MaintenanceScheduleSelectorOperatorEq,
+						Value:    "eastus",
+						Selectors: []*MaintenanceScheduleSelector{
+							{
+								Key:      "region",
+								Operator: MaintenanceScheduleSelectorOperatorEq,
+								Value:    "eastus",
+							},
+						},
+					},
+				},
+			},
+			wantErr: "400: InvalidParameter: selectors[0].selectors: Must not be provided for operator type 'eq'",
+		},
+		{
+			name: "invalid regular expression",
+			new: &MaintenanceSchedule{
+				ID:                "00000",
+				MaintenanceTaskID: MIMOTaskID("0"),
+				State:             MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				ScheduleAcross:    "12h",
+				Selectors: []*MaintenanceScheduleSelector{
+					{
+						Key:      "region",
+						Operator: MaintenanceScheduleSelectorOperatorMatches,
+						Value:    "east(",
+					},
+				},
+			},
+			wantErr: "400: InvalidParameter: selectors[0].value: Must be a valid regular expression: error parsing regexp: missing closing ): `east(`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
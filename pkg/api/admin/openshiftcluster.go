@@ -51,16 +51,26 @@ type OpenShiftClusterProperties struct {
 	// WorkerProfiles is used to store the worker profile data that was sent in the api request
 	WorkerProfiles []WorkerProfile `json:"workerProfiles,omitempty"`
 	// WorkerProfilesStatus is used to store the enriched worker profile data
-	WorkerProfilesStatus            []WorkerProfile   `json:"workerProfilesStatus,omitempty" swagger:"readOnly"`
-	APIServerProfile                APIServerProfile  `json:"apiserverProfile,omitempty"`
-	IngressProfiles                 []IngressProfile  `json:"ingressProfiles,omitempty"`
-	Install                         *Install          `json:"install,omitempty"`
-	StorageSuffix                   string            `json:"storageSuffix,omitempty"`
-	RegistryProfiles                []RegistryProfile `json:"registryProfiles,omitempty"`
-	ImageRegistryStorageAccountName string            `json:"imageRegistryStorageAccountName,omitempty"`
-	InfraID                         string            `json:"infraId,omitempty"`
-	HiveProfile                     HiveProfile       `json:"hiveProfile,omitempty"`
-	MaintenanceState                MaintenanceState  `json:"maintenanceState,omitempty"`
+	WorkerProfilesStatus            []WorkerProfile    `json:"workerProfilesStatus,omitempty" swagger:"readOnly"`
+	APIServerProfile                APIServerProfile   `json:"apiserverProfile,omitempty"`
+	IngressProfiles                 []IngressProfile   `json:"ingressProfiles,omitempty"`
+	Install                         *Install           `json:"install,omitempty"`
+	StorageSuffix                   string             `json:"storageSuffix,omitempty"`
+	RegistryProfiles                []RegistryProfile  `json:"registryProfiles,omitempty"`
+	ImageRegistryStorageAccountName string             `json:"imageRegistryStorageAccountName,omitempty"`
+	InfraID                         string             `json:"infraId,omitempty"`
+	HiveProfile                     HiveProfile        `json:"hiveProfile,omitempty"`
+	MaintenanceState                MaintenanceState   `json:"maintenanceState,omitempty"`
+	MaintenanceWindow               *MaintenanceWindow `json:"maintenanceWindow,omitempty" mutable:"true"`
+}
+
+// MaintenanceWindow restricts when planned maintenance may start on a cluster.
+type MaintenanceWindow struct {
+	DaysOfWeek    []string `json:"daysOfWeek,omitempty" mutable:"true"`
+	StartHour     int      `json:"startHour,omitempty" mutable:"true"`
+	DurationHours int      `json:"durationHours,omitempty" mutable:"true"`
+	TimeZone      string   `json:"timeZone,omitempty" mutable:"true"`
+	BlackoutDates []string `json:"blackoutDates,omitempty" mutable:"true"`
 }
 
 // ProvisioningState represents a provisioning state.
@@ -228,6 +228,16 @@ func (c openShiftClusterConverter) ToExternal(oc *api.OpenShiftCluster) interfac
 		CreatedByHive: oc.Properties.HiveProfile.CreatedByHive,
 	}
 
+	if oc.Properties.MaintenanceWindow != nil {
+		out.Properties.MaintenanceWindow = &MaintenanceWindow{
+			DaysOfWeek:    oc.Properties.MaintenanceWindow.DaysOfWeek,
+			StartHour:     oc.Properties.MaintenanceWindow.StartHour,
+			DurationHours: oc.Properties.MaintenanceWindow.DurationHours,
+			TimeZone:      oc.Properties.MaintenanceWindow.TimeZone,
+			BlackoutDates: oc.Properties.MaintenanceWindow.BlackoutDates,
+		}
+	}
+
 	return out
 }
 
@@ -424,6 +434,17 @@ func (c openShiftClusterConverter) ToInternal(_oc interface{}, out *api.OpenShif
 		}
 	}
 
+	out.Properties.MaintenanceWindow = nil
+	if oc.Properties.MaintenanceWindow != nil {
+		out.Properties.MaintenanceWindow = &api.MaintenanceWindow{
+			DaysOfWeek:    oc.Properties.MaintenanceWindow.DaysOfWeek,
+			StartHour:     oc.Properties.MaintenanceWindow.StartHour,
+			DurationHours: oc.Properties.MaintenanceWindow.DurationHours,
+			TimeZone:      oc.Properties.MaintenanceWindow.TimeZone,
+			BlackoutDates: oc.Properties.MaintenanceWindow.BlackoutDates,
+		}
+	}
+
 	// out.Properties.RegistryProfiles is not converted. The field is immutable and does not have to be converted.
 	// Other fields are converted and this breaks the pattern, however this converting this field creates an issue
 	// with filling the out.Properties.RegistryProfiles[i].Password as default is "" which erases the original value.
@@ -33,7 +33,12 @@ func (sv openShiftClusterStaticValidator) validateDelta(oc, current *OpenShiftCl
 		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodePropertyChangeNotAllowed, "", err.Error())
 	}
 
-	return validateMaintenanceTask(oc.Properties.MaintenanceTask)
+	err = validateMaintenanceTask(oc.Properties.MaintenanceTask)
+	if err != nil {
+		return err
+	}
+
+	return validateMaintenanceWindow(oc.Properties.MaintenanceWindow)
 }
 
 func validateMaintenanceTask(task MaintenanceTask) error {
@@ -43,3 +48,22 @@ func validateMaintenanceTask(task MaintenanceTask) error {
 
 	return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.maintenanceTask", "Invalid enum parameter.")
 }
+
+func validateMaintenanceWindow(w *MaintenanceWindow) error {
+	if w == nil {
+		return nil
+	}
+
+	err := (&api.MaintenanceWindow{
+		DaysOfWeek:    w.DaysOfWeek,
+		StartHour:     w.StartHour,
+		DurationHours: w.DurationHours,
+		TimeZone:      w.TimeZone,
+		BlackoutDates: w.BlackoutDates,
+	}).Validate()
+	if err != nil {
+		return api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "properties.maintenanceWindow", "The provided maintenance window is invalid: "+err.Error()+".")
+	}
+
+	return nil
+}
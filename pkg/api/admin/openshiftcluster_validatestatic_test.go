@@ -761,6 +761,49 @@ func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
 				oc.Properties.MaintenanceTask = ""
 			},
 		},
+		{
+			name: "maintenanceWindow change is allowed",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceWindow = &MaintenanceWindow{
+					DaysOfWeek:    []string{"Saturday"},
+					StartHour:     22,
+					DurationHours: 4,
+					TimeZone:      "Europe/London",
+					BlackoutDates: []string{"2026-12-26"},
+				}
+			},
+		},
+		{
+			name: "maintenanceWindow removal is allowed",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{
+					Properties: OpenShiftClusterProperties{
+						MaintenanceWindow: &MaintenanceWindow{
+							DurationHours: 4,
+						},
+					},
+				}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceWindow = nil
+			},
+		},
+		{
+			name: "invalid maintenanceWindow is disallowed",
+			oc: func() *OpenShiftCluster {
+				return &OpenShiftCluster{}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceWindow = &MaintenanceWindow{
+					DaysOfWeek:    []string{"Caturday"},
+					DurationHours: 4,
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceWindow: The provided maintenance window is invalid: invalid day of week 'Caturday'.",
+		},
 		{
 			name: "maintenanceTask change to other values is disallowed",
 			oc: func() *OpenShiftCluster {
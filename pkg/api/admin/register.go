@@ -23,5 +23,6 @@ func init() {
 		MaintenanceScheduleConverter:                   maintenanceScheduleConverter{},
 		MaintenanceScheduleStaticValidator:             maintenanceScheduleStaticValidator{},
 		BillingDocumentConverter:                       billingDocumentConverter{},
+		ClusterHealthSnapshotConverter:                 clusterHealthSnapshotConverter{},
 	}
 }
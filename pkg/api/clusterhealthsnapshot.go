@@ -0,0 +1,110 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// ClusterHealthSnapshot is the health of a cluster as seen by the monitor's
+// collectors
+type ClusterHealthSnapshot struct {
+	MissingFields
+
+	// CollectedAt is the time, in Unix seconds, at which the monitoring pass
+	// which produced the snapshot started
+	CollectedAt int64 `json:"collectedAt,omitempty"`
+
+	// Collectors are the latest results of each collector, ordered by name.
+	// Collectors which run less often than the monitor keep their result
+	// from the pass in which they last ran.
+	Collectors []ClusterHealthCollectorResult `json:"collectors,omitempty"`
+
+	// Drift is what the drift monitor found the last time it compared the
+	// cluster's managed resource group with the cluster document
+	Drift *ClusterHealthDriftReport `json:"drift,omitempty"`
+}
+
+// ClusterHealthDriftReport is the resources in a cluster's managed resource
+// group which differ from what the RP expects
+type ClusterHealthDriftReport struct {
+	MissingFields
+
+	// CheckedAt is the time, in Unix seconds, at which the drift monitor ran
+	CheckedAt int64 `json:"checkedAt,omitempty"`
+
+	// Error is set if some of the checks failed, in which case the drifted
+	// resources may be incomplete
+	Error string `json:"error,omitempty"`
+
+	Resources []ClusterHealthDriftedResource `json:"resources,omitempty"`
+}
+
+// ClusterHealthDriftedResource is a resource which differs from what the RP
+// expects
+type ClusterHealthDriftedResource struct {
+	MissingFields
+
+	ResourceType string `json:"resourceType,omitempty"`
+	ResourceName string `json:"resourceName,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+	Message      string `json:"message,omitempty"`
+}
+
+// ClusterHealthCollectorResult is the outcome of a single monitor collector
+// and what it found wrong with the cluster
+type ClusterHealthCollectorResult struct {
+	MissingFields
+
+	Name string `json:"name,omitempty"`
+
+	// CollectedAt is the time, in Unix seconds, at which the collector ran
+	CollectedAt     int64   `json:"collectedAt,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds,omitempty"`
+
+	// Error is set if the collector failed, in which case what it found may
+	// be incomplete
+	Error string `json:"error,omitempty"`
+
+	// Conditions are the unexpected conditions of cluster operators, nodes
+	// or machine config pools
+	Conditions []ClusterHealthCondition `json:"conditions,omitempty"`
+
+	// Certificates are certificates which expire soon
+	Certificates []ClusterHealthCertificate `json:"certificates,omitempty"`
+
+	// EarliestCertificateExpiry is the time, in Unix seconds, at which the
+	// earliest expiring certificate found by the collector expires
+	EarliestCertificateExpiry int64 `json:"earliestCertificateExpiry,omitempty"`
+
+	// Alerts are firing Prometheus alerts
+	Alerts []ClusterHealthAlert `json:"alerts,omitempty"`
+}
+
+// ClusterHealthCondition is an unexpected condition of a cluster object
+type ClusterHealthCondition struct {
+	MissingFields
+
+	Name    string `json:"name,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Status  string `json:"status,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterHealthCertificate is a certificate which expires soon
+type ClusterHealthCertificate struct {
+	MissingFields
+
+	Namespace           string `json:"namespace,omitempty"`
+	Name                string `json:"name,omitempty"`
+	Subject             string `json:"subject,omitempty"`
+	DaysUntilExpiration int    `json:"daysUntilExpiration"`
+}
+
+// ClusterHealthAlert is a firing Prometheus alert
+type ClusterHealthAlert struct {
+	MissingFields
+
+	Name            string `json:"name,omitempty"`
+	Severity        string `json:"severity,omitempty"`
+	Target          string `json:"target,omitempty"`
+	SecondaryTarget string `json:"secondaryTarget,omitempty"`
+	Count           int64  `json:"count,omitempty"`
+}
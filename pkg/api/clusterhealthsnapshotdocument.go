@@ -0,0 +1,48 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// ClusterHealthSnapshotDocuments represents cluster health snapshot documents.
+// pkg/database/cosmosdb requires its definition.
+type ClusterHealthSnapshotDocuments struct {
+	Count                          int                              `json:"_count,omitempty"`
+	ResourceID                     string                           `json:"_rid,omitempty"`
+	ClusterHealthSnapshotDocuments []*ClusterHealthSnapshotDocument `json:"Documents,omitempty"`
+}
+
+func (c *ClusterHealthSnapshotDocuments) String() string {
+	return encodeJSON(c)
+}
+
+// ClusterHealthSnapshotDocument represents the latest health snapshot of a
+// cluster. Its ID is the ID of the cluster's OpenShiftClusterDocument.
+// pkg/database/cosmosdb requires its definition.
+type ClusterHealthSnapshotDocument struct {
+	MissingFields
+
+	ID          string                 `json:"id,omitempty"`
+	ResourceID  string                 `json:"_rid,omitempty"`
+	Timestamp   int                    `json:"_ts,omitempty"`
+	Self        string                 `json:"_self,omitempty"`
+	ETag        string                 `json:"_etag,omitempty" deep:"-"`
+	Attachments string                 `json:"_attachments,omitempty"`
+	TTL         int                    `json:"ttl,omitempty"`
+	LSN         int                    `json:"_lsn,omitempty"`
+	Metadata    map[string]interface{} `json:"_metadata,omitempty"`
+
+	ClusterResourceID     string                `json:"clusterResourceID,omitempty"`
+	ClusterHealthSnapshot ClusterHealthSnapshot `json:"clusterHealthSnapshot,omitempty"`
+
+	// SLOState is the monitor's record of the cluster's service level
+	// indicators
+	SLOState *ClusterSLOState `json:"sloState,omitempty"`
+}
+
+func (c *ClusterHealthSnapshotDocument) String() string {
+	return encodeJSON(c)
+}
+
+func (c *ClusterHealthSnapshotDocument) GetKey() string {
+	return c.ID
+}
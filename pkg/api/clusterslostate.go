@@ -0,0 +1,40 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+// ClusterSLOState is the monitor's record of a cluster's service level
+// indicators. It is saved with the cluster's health snapshot so that a
+// monitor taking over the cluster's bucket keeps its availability windows.
+type ClusterSLOState struct {
+	MissingFields
+
+	// Indicators are ordered by name
+	Indicators []ClusterSLOIndicator `json:"indicators,omitempty"`
+}
+
+// ClusterSLOIndicator is the record of one service level indicator, at a fine
+// resolution for short windows and a coarse resolution for long windows
+type ClusterSLOIndicator struct {
+	MissingFields
+
+	Name string `json:"name,omitempty"`
+
+	// LastObservedAt is the time, in Unix seconds, of the last observation
+	LastObservedAt int64 `json:"lastObservedAt,omitempty"`
+
+	FineBuckets   []ClusterSLOBucket `json:"fineBuckets,omitempty"`
+	CoarseBuckets []ClusterSLOBucket `json:"coarseBuckets,omitempty"`
+}
+
+// ClusterSLOBucket counts the observations of a service level indicator
+// within a period, each weighted by the seconds since the previous one
+type ClusterSLOBucket struct {
+	MissingFields
+
+	// Start is the time, in Unix seconds, at which the period starts
+	Start int64 `json:"start,omitempty"`
+
+	Good  int64 `json:"good,omitempty"`
+	Total int64 `json:"total,omitempty"`
+}
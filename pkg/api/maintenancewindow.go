@@ -0,0 +1,141 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	_ "time/tzdata" // time zones must be loadable regardless of the host
+)
+
+// maintenanceWindowSearchDays is how far ahead to look for the next opening
+// of a maintenance window. It covers a full year of blackout dates.
+const maintenanceWindowSearchDays = 400
+
+// MaintenanceWindowDateFormat is the format of MaintenanceWindow.BlackoutDates.
+const MaintenanceWindowDateFormat = time.DateOnly
+
+// MaintenanceWindow restricts when planned maintenance may start on a cluster.
+// The window opens at StartHour on each of DaysOfWeek, in TimeZone, and stays
+// open for DurationHours, other than on BlackoutDates.
+type MaintenanceWindow struct {
+	MissingFields
+
+	// DaysOfWeek are the days on which the window opens, e.g. "Saturday". The
+	// window opens every day if empty.
+	DaysOfWeek []string `json:"daysOfWeek,omitempty"`
+
+	// StartHour is the hour of the day, from 0 to 23, at which the window
+	// opens.
+	StartHour int `json:"startHour,omitempty"`
+
+	// DurationHours is how long the window stays open for, from 1 to 24.
+	DurationHours int `json:"durationHours,omitempty"`
+
+	// TimeZone is the IANA name of the time zone of the window, e.g.
+	// "Europe/London". UTC is used if empty.
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// BlackoutDates are dates, formatted as YYYY-MM-DD in TimeZone, on which
+	// the window does not open.
+	BlackoutDates []string `json:"blackoutDates,omitempty"`
+}
+
+// Validate returns an error describing the first invalid field of the
+// window, if any.
+func (w *MaintenanceWindow) Validate() error {
+	for _, d := range w.DaysOfWeek {
+		if _, ok := parseWeekday(d); !ok {
+			return fmt.Errorf("invalid day of week '%s'", d)
+		}
+	}
+
+	if w.StartHour < 0 || w.StartHour > 23 {
+		return errors.New("startHour must be between 0 and 23")
+	}
+
+	if w.DurationHours < 1 || w.DurationHours > 24 {
+		return errors.New("durationHours must be between 1 and 24")
+	}
+
+	_, err := w.location()
+	if err != nil {
+		return err
+	}
+
+	for _, d := range w.BlackoutDates {
+		_, err := time.Parse(MaintenanceWindowDateFormat, d)
+		if err != nil {
+			return fmt.Errorf("invalid blackout date '%s'", d)
+		}
+	}
+
+	return nil
+}
+
+// Next returns the start and end of the first opening of the window which
+// ends after `t`. If `t` is within an opening, that opening is returned.
+// ok is false if the window does not open within the next year.
+func (w *MaintenanceWindow) Next(t time.Time) (start time.Time, end time.Time, ok bool) {
+	loc, err := w.location()
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+
+	days := make([]time.Weekday, 0, len(w.DaysOfWeek))
+	for _, d := range w.DaysOfWeek {
+		if wd, ok := parseWeekday(d); ok {
+			days = append(days, wd)
+		}
+	}
+
+	// Start from the previous day, as its opening may not have ended yet
+	local := t.In(loc)
+	for i := -1; i < maintenanceWindowSearchDays; i++ {
+		start = time.Date(local.Year(), local.Month(), local.Day()+i, w.StartHour, 0, 0, 0, loc)
+		end = start.Add(time.Duration(w.DurationHours) * time.Hour)
+
+		if len(days) > 0 && !slices.Contains(days, start.Weekday()) {
+			continue
+		}
+		if slices.Contains(w.BlackoutDates, start.Format(MaintenanceWindowDateFormat)) {
+			continue
+		}
+		if end.After(t) {
+			return start.UTC(), end.UTC(), true
+		}
+	}
+
+	return time.Time{}, time.Time{}, false
+}
+
+// Contains returns whether the window is open at `t`.
+func (w *MaintenanceWindow) Contains(t time.Time) bool {
+	start, _, ok := w.Next(t)
+	return ok && !t.Before(start)
+}
+
+func (w *MaintenanceWindow) location() (*time.Location, error) {
+	if w.TimeZone == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid time zone '%s'", w.TimeZone)
+	}
+	return loc, nil
+}
+
+func parseWeekday(s string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(d.String(), s) {
+			return d, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,137 @@
+package api
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		w       *MaintenanceWindow
+		wantErr string
+	}{
+		{
+			name: "valid",
+			w: &MaintenanceWindow{
+				DaysOfWeek:    []string{"Saturday", "sunday"},
+				StartHour:     22,
+				DurationHours: 4,
+				TimeZone:      "Europe/London",
+				BlackoutDates: []string{"2026-12-26"},
+			},
+		},
+		{
+			name: "invalid day of week",
+			w: &MaintenanceWindow{
+				DaysOfWeek:    []string{"Caturday"},
+				DurationHours: 4,
+			},
+			wantErr: "invalid day of week 'Caturday'",
+		},
+		{
+			name: "invalid start hour",
+			w: &MaintenanceWindow{
+				StartHour:     24,
+				DurationHours: 4,
+			},
+			wantErr: "startHour must be between 0 and 23",
+		},
+		{
+			name:    "missing duration",
+			w:       &MaintenanceWindow{},
+			wantErr: "durationHours must be between 1 and 24",
+		},
+		{
+			name: "invalid time zone",
+			w: &MaintenanceWindow{
+				DurationHours: 4,
+				TimeZone:      "Mars/Olympus_Mons",
+			},
+			wantErr: "invalid time zone 'Mars/Olympus_Mons'",
+		},
+		{
+			name: "invalid blackout date",
+			w: &MaintenanceWindow{
+				DurationHours: 4,
+				BlackoutDates: []string{"26/12/2026"},
+			},
+			wantErr: "invalid blackout date '26/12/2026'",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.w.Validate()
+			if err == nil && tt.wantErr != "" || err != nil && err.Error() != tt.wantErr {
+				t.Errorf("got %v, wanted %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindowNext(t *testing.T) {
+	// Saturdays from 22:00 to 02:00 in London, which is UTC+1 in summer
+	w := &MaintenanceWindow{
+		DaysOfWeek:    []string{"Saturday"},
+		StartHour:     22,
+		DurationHours: 4,
+		TimeZone:      "Europe/London",
+		BlackoutDates: []string{"2026-07-11"},
+	}
+
+	for _, tt := range []struct {
+		name         string
+		t            time.Time
+		wantStart    time.Time
+		wantEnd      time.Time
+		wantContains bool
+	}{
+		{
+			name:      "before the window opens",
+			t:         time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 7, 4, 21, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 7, 5, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:         "as the window opens",
+			t:            time.Date(2026, 7, 4, 21, 0, 0, 0, time.UTC),
+			wantStart:    time.Date(2026, 7, 4, 21, 0, 0, 0, time.UTC),
+			wantEnd:      time.Date(2026, 7, 5, 1, 0, 0, 0, time.UTC),
+			wantContains: true,
+		},
+		{
+			name:         "within the window, after midnight",
+			t:            time.Date(2026, 7, 5, 0, 30, 0, 0, time.UTC),
+			wantStart:    time.Date(2026, 7, 4, 21, 0, 0, 0, time.UTC),
+			wantEnd:      time.Date(2026, 7, 5, 1, 0, 0, 0, time.UTC),
+			wantContains: true,
+		},
+		{
+			name:      "as the window closes, skipping a blackout date",
+			t:         time.Date(2026, 7, 5, 1, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 7, 18, 21, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 7, 19, 1, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "in winter",
+			t:         time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2026, 12, 5, 22, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2026, 12, 6, 2, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, ok := w.Next(tt.t)
+			if !ok {
+				t.Fatal("window never opens")
+			}
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("got %s to %s, wanted %s to %s", start, end, tt.wantStart, tt.wantEnd)
+			}
+			if w.Contains(tt.t) != tt.wantContains {
+				t.Errorf("got contains %t, wanted %t", !tt.wantContains, tt.wantContains)
+			}
+		})
+	}
+}
@@ -22,12 +22,28 @@ const (
 const (
 	MaintenanceScheduleStateEnabled  MaintenanceScheduleState = "Enabled"
 	MaintenanceScheduleStateDisabled MaintenanceScheduleState = "Disabled"
+	// MaintenanceScheduleStatePaused is set by the scheduler when a
+	// progressive rollout exceeds its failure threshold
+	MaintenanceScheduleStatePaused MaintenanceScheduleState = "Paused"
 )
 
 const (
 	MaintenanceScheduleSelectorOperatorEq    MaintenanceScheduleSelectorOperator = "eq"
 	MaintenanceScheduleSelectorOperatorIn    MaintenanceScheduleSelectorOperator = "in"
 	MaintenanceScheduleSelectorOperatorNotIn MaintenanceScheduleSelectorOperator = "notin"
+
+	// Comparison operators, for version and date keys
+	MaintenanceScheduleSelectorOperatorLt MaintenanceScheduleSelectorOperator = "lt"
+	MaintenanceScheduleSelectorOperatorLe MaintenanceScheduleSelectorOperator = "le"
+	MaintenanceScheduleSelectorOperatorGt MaintenanceScheduleSelectorOperator = "gt"
+	MaintenanceScheduleSelectorOperatorGe MaintenanceScheduleSelectorOperator = "ge"
+
+	// Regular expression match
+	MaintenanceScheduleSelectorOperatorMatches MaintenanceScheduleSelectorOperator = "matches"
+
+	// Groups of nested selectors, matching if any or all of them match
+	MaintenanceScheduleSelectorOperatorAny MaintenanceScheduleSelectorOperator = "any"
+	MaintenanceScheduleSelectorOperatorAll MaintenanceScheduleSelectorOperator = "all"
 )
 
 type (
@@ -51,6 +67,10 @@ type MaintenanceManifest struct {
 	RunAfter int64 `json:"runAfter,omitempty"`
 	// RunBefore defines the latest that this manifest should start running
 	RunBefore int64 `json:"runBefore,omitempty"`
+
+	// Prerequisites are the IDs of manifests on the same cluster which must
+	// have Completed before this manifest will start running
+	Prerequisites []string `json:"prerequisites,omitempty"`
 }
 
 type MaintenanceSchedule struct {
@@ -64,6 +84,18 @@ type MaintenanceSchedule struct {
 	ScheduleAcross   string `json:"scheduleAcross,omitempty"`
 
 	Selectors []*MaintenanceScheduleSelector `json:"selectors,omitempty"`
+
+	// Waves are ascending, cumulative percentages of the matched clusters
+	// (ending in 100) which are rolled out to in turn within each scheduled
+	// period. Clusters in a wave only have manifests created once every
+	// manifest in the earlier waves has finished.
+	Waves []int `json:"waves,omitempty"`
+	// WaveFailureThresholdPercent is the percentage of failed manifests in
+	// the earlier waves above which the schedule is paused.
+	WaveFailureThresholdPercent int `json:"waveFailureThresholdPercent,omitempty"`
+
+	// PausedReason describes why the scheduler paused the schedule.
+	PausedReason string `json:"pausedReason,omitempty"`
 }
 
 type MaintenanceScheduleSelector struct {
@@ -71,4 +103,7 @@ type MaintenanceScheduleSelector struct {
 	Operator MaintenanceScheduleSelectorOperator `json:"operator,omitempty"`
 	Value    string                              `json:"value,omitempty"`
 	Values   []string                            `json:"values,omitempty"`
+
+	// Selectors are the nested selectors of an any/all group
+	Selectors []*MaintenanceScheduleSelector `json:"selectors,omitempty"`
 }
@@ -198,11 +198,26 @@ type OpenShiftClusterProperties struct {
 	// UserAdminKubeconfig is derived admin kubeConfig with shorter live span
 	UserAdminKubeconfig SecureBytes `json:"userAdminKubeconfig,omitempty"`
 
+	// EtcdBackupEncryptionKey is the key the ARO operator's etcd backups are
+	// encrypted with. It is kept here, and deployed to the cluster, so that
+	// the backups can still be decrypted once the cluster is lost.
+	EtcdBackupEncryptionKey SecureBytes `json:"etcdBackupEncryptionKey,omitempty"`
+
 	RegistryProfiles []*RegistryProfile `json:"registryProfiles,omitempty"`
 
 	HiveProfile HiveProfile `json:"hiveProfile,omitempty"`
 
 	MaintenanceState MaintenanceState `json:"maintenanceState,omitempty"`
+
+	// MaintenanceStateSetBySchedule records that the MIMO scheduler set
+	// MaintenanceState to Pending ahead of scheduled maintenance, so that
+	// the state is only cleared once that maintenance is over if the
+	// scheduler owns it. It is reset whenever the state is set otherwise.
+	MaintenanceStateSetBySchedule bool `json:"maintenanceStateSetBySchedule,omitempty"`
+
+	// MaintenanceWindow restricts when planned maintenance may start on the
+	// cluster. Planned maintenance may start at any time if nil.
+	MaintenanceWindow *MaintenanceWindow `json:"maintenanceWindow,omitempty"`
 }
 
 // ProvisioningState represents a provisioning state
@@ -56,11 +56,23 @@ type OpenShiftClusterDocument struct {
 
 	AsyncOperationID string `json:"asyncOperationId,omitempty" deep:"-"`
 
+	// StepCheckpoint records progress through the steps of the current
+	// asynchronous operation so that it can be resumed if the lease is lost.
+	StepCheckpoint *StepCheckpoint `json:"stepCheckpoint,omitempty" deep:"-"`
+
 	OpenShiftCluster *OpenShiftCluster `json:"openShiftCluster,omitempty"`
 
 	CorrelationData *CorrelationData `json:"correlationData,omitempty" deep:"-"`
 }
 
+// StepCheckpoint records the last step completed by the backend for a given
+// asynchronous operation.
+type StepCheckpoint struct {
+	AsyncOperationID  string `json:"asyncOperationId,omitempty"`
+	Operation         string `json:"operation,omitempty"`
+	LastCompletedStep string `json:"lastCompletedStep,omitempty"`
+}
+
 func (c *OpenShiftClusterDocument) String() string {
 	return encodeJSON(c)
 }
@@ -64,6 +64,10 @@ type MaintenanceScheduleStaticValidator interface {
 	Static(interface{}, *MaintenanceScheduleDocument) error
 }
 
+type ClusterHealthSnapshotConverter interface {
+	ToExternal(doc *ClusterHealthSnapshotDocument) interface{}
+}
+
 type BillingDocumentConverter interface {
 	ToExternal(doc *BillingDocument) interface{}
 	ToExternalList(docs []*BillingDocument, nextLink string) interface{}
@@ -85,6 +89,7 @@ type Version struct {
 	MaintenanceScheduleConverter                   MaintenanceScheduleConverter
 	MaintenanceScheduleStaticValidator             MaintenanceScheduleStaticValidator
 	BillingDocumentConverter                       BillingDocumentConverter
+	ClusterHealthSnapshotConverter                 ClusterHealthSnapshotConverter
 }
 
 // APIs is the map of registered API versions
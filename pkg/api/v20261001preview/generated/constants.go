@@ -0,0 +1,180 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+// Code generated by Microsoft (R) Go Code Generator. DO NOT EDIT.
+
+package generated
+
+const (
+	version20261001Preview string = "2026-10-01-preview"
+)
+
+// CreatedByType - The kind of entity that created the resource.
+type CreatedByType string
+
+const (
+	// CreatedByTypeApplication - The entity was created by an application.
+	CreatedByTypeApplication CreatedByType = "Application"
+	// CreatedByTypeKey - The entity was created by a key.
+	CreatedByTypeKey CreatedByType = "Key"
+	// CreatedByTypeManagedIdentity - The entity was created by a managed identity.
+	CreatedByTypeManagedIdentity CreatedByType = "ManagedIdentity"
+	// CreatedByTypeUser - The entity was created by a user.
+	CreatedByTypeUser CreatedByType = "User"
+)
+
+// PossibleCreatedByTypeValues returns the possible values for the CreatedByType const type.
+func PossibleCreatedByTypeValues() []CreatedByType {
+	return []CreatedByType{
+		CreatedByTypeApplication,
+		CreatedByTypeKey,
+		CreatedByTypeManagedIdentity,
+		CreatedByTypeUser,
+	}
+}
+
+// EncryptionAtHost - EncryptionAtHost represents encryption at host state
+type EncryptionAtHost string
+
+const (
+	// EncryptionAtHostDisabled - Disabled
+	EncryptionAtHostDisabled EncryptionAtHost = "Disabled"
+	// EncryptionAtHostEnabled - Enabled
+	EncryptionAtHostEnabled EncryptionAtHost = "Enabled"
+)
+
+// PossibleEncryptionAtHostValues returns the possible values for the EncryptionAtHost const type.
+func PossibleEncryptionAtHostValues() []EncryptionAtHost {
+	return []EncryptionAtHost{
+		EncryptionAtHostDisabled,
+		EncryptionAtHostEnabled,
+	}
+}
+
+// FipsValidatedModules - FipsValidatedModules determines if FIPS is used.
+type FipsValidatedModules string
+
+const (
+	// FipsValidatedModulesDisabled - Disabled
+	FipsValidatedModulesDisabled FipsValidatedModules = "Disabled"
+	// FipsValidatedModulesEnabled - Enabled
+	FipsValidatedModulesEnabled FipsValidatedModules = "Enabled"
+)
+
+// PossibleFipsValidatedModulesValues returns the possible values for the FipsValidatedModules const type.
+func PossibleFipsValidatedModulesValues() []FipsValidatedModules {
+	return []FipsValidatedModules{
+		FipsValidatedModulesDisabled,
+		FipsValidatedModulesEnabled,
+	}
+}
+
+// ManagedServiceIdentityType - Type of managed service identity (where both SystemAssigned and UserAssigned types are allowed).
+type ManagedServiceIdentityType string
+
+const (
+	// ManagedServiceIdentityTypeNone - No managed identity.
+	ManagedServiceIdentityTypeNone ManagedServiceIdentityType = "None"
+	// ManagedServiceIdentityTypeSystemAssigned - System assigned managed identity.
+	ManagedServiceIdentityTypeSystemAssigned ManagedServiceIdentityType = "SystemAssigned"
+	// ManagedServiceIdentityTypeSystemAssignedUserAssigned - System and user assigned managed identity.
+	ManagedServiceIdentityTypeSystemAssignedUserAssigned ManagedServiceIdentityType = "SystemAssigned,UserAssigned"
+	// ManagedServiceIdentityTypeUserAssigned - User assigned managed identity.
+	ManagedServiceIdentityTypeUserAssigned ManagedServiceIdentityType = "UserAssigned"
+)
+
+// PossibleManagedServiceIdentityTypeValues returns the possible values for the ManagedServiceIdentityType const type.
+func PossibleManagedServiceIdentityTypeValues() []ManagedServiceIdentityType {
+	return []ManagedServiceIdentityType{
+		ManagedServiceIdentityTypeNone,
+		ManagedServiceIdentityTypeSystemAssigned,
+		ManagedServiceIdentityTypeSystemAssignedUserAssigned,
+		ManagedServiceIdentityTypeUserAssigned,
+	}
+}
+
+// OutboundType - The outbound routing strategy used to provide your cluster egress to the internet.
+type OutboundType string
+
+const (
+	// OutboundTypeLoadbalancer - Loadbalancer
+	OutboundTypeLoadbalancer OutboundType = "Loadbalancer"
+	// OutboundTypeUserDefinedRouting - UserDefinedRouting
+	OutboundTypeUserDefinedRouting OutboundType = "UserDefinedRouting"
+)
+
+// PossibleOutboundTypeValues returns the possible values for the OutboundType const type.
+func PossibleOutboundTypeValues() []OutboundType {
+	return []OutboundType{
+		OutboundTypeLoadbalancer,
+		OutboundTypeUserDefinedRouting,
+	}
+}
+
+// PreconfiguredNSG - PreconfiguredNSG represents whether customers want to use their own NSG attached to the subnets
+type PreconfiguredNSG string
+
+const (
+	// PreconfiguredNSGDisabled - Disabled
+	PreconfiguredNSGDisabled PreconfiguredNSG = "Disabled"
+	// PreconfiguredNSGEnabled - Enabled
+	PreconfiguredNSGEnabled PreconfiguredNSG = "Enabled"
+)
+
+// PossiblePreconfiguredNSGValues returns the possible values for the PreconfiguredNSG const type.
+func PossiblePreconfiguredNSGValues() []PreconfiguredNSG {
+	return []PreconfiguredNSG{
+		PreconfiguredNSGDisabled,
+		PreconfiguredNSGEnabled,
+	}
+}
+
+// ProvisioningState - ProvisioningState represents a provisioning state.
+type ProvisioningState string
+
+const (
+	// ProvisioningStateAdminUpdating - AdminUpdating
+	ProvisioningStateAdminUpdating ProvisioningState = "AdminUpdating"
+	// ProvisioningStateCanceled - Canceled
+	ProvisioningStateCanceled ProvisioningState = "Canceled"
+	// ProvisioningStateCreating - Creating
+	ProvisioningStateCreating ProvisioningState = "Creating"
+	// ProvisioningStateDeleting - Deleting
+	ProvisioningStateDeleting ProvisioningState = "Deleting"
+	// ProvisioningStateFailed - Failed
+	ProvisioningStateFailed ProvisioningState = "Failed"
+	// ProvisioningStateSucceeded - Succeeded
+	ProvisioningStateSucceeded ProvisioningState = "Succeeded"
+	// ProvisioningStateUpdating - Updating
+	ProvisioningStateUpdating ProvisioningState = "Updating"
+)
+
+// PossibleProvisioningStateValues returns the possible values for the ProvisioningState const type.
+func PossibleProvisioningStateValues() []ProvisioningState {
+	return []ProvisioningState{
+		ProvisioningStateAdminUpdating,
+		ProvisioningStateCanceled,
+		ProvisioningStateCreating,
+		ProvisioningStateDeleting,
+		ProvisioningStateFailed,
+		ProvisioningStateSucceeded,
+		ProvisioningStateUpdating,
+	}
+}
+
+// Visibility - Visibility represents visibility.
+type Visibility string
+
+const (
+	// VisibilityPrivate - Private
+	VisibilityPrivate Visibility = "Private"
+	// VisibilityPublic - Public
+	VisibilityPublic Visibility = "Public"
+)
+
+// PossibleVisibilityValues returns the possible values for the Visibility const type.
+func PossibleVisibilityValues() []Visibility {
+	return []Visibility{
+		VisibilityPrivate,
+		VisibilityPublic,
+	}
+}
@@ -0,0 +1,452 @@
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+// Code generated by Microsoft (R) Go Code Generator. DO NOT EDIT.
+
+package generated
+
+import "time"
+
+// APIServerProfile represents an API server profile.
+type APIServerProfile struct {
+	// API server visibility.
+	Visibility *Visibility
+
+	// READ-ONLY; The IP of the cluster API server.
+	IP *string
+
+	// READ-ONLY; The URL to access the cluster API server.
+	URL *string
+}
+
+// ClusterProfile represents a cluster profile.
+type ClusterProfile struct {
+	// The domain for the cluster.
+	Domain *string
+
+	// If FIPS validated crypto modules are used
+	FipsValidatedModules *FipsValidatedModules
+
+	// The pull secret for the cluster.
+	PullSecret *string
+
+	// The ID of the cluster resource group.
+	ResourceGroupID *string
+
+	// The version of the cluster.
+	Version *string
+
+	// READ-ONLY; The URL of the managed OIDC issuer in a workload identity cluster.
+	OidcIssuer *string
+}
+
+// ConsoleProfile represents a console profile.
+type ConsoleProfile struct {
+	// READ-ONLY; The URL to access the cluster console.
+	URL *string
+}
+
+// Display represents the display details of an operation.
+type Display struct {
+	// Friendly name of the operation.
+	Description *string
+
+	// Operation type: read, write, delete, listKeys/action, etc.
+	Operation *string
+
+	// Friendly name of the resource provider.
+	Provider *string
+
+	// Resource type on which the operation is performed.
+	Resource *string
+}
+
+// EffectiveOutboundIP represents an effective outbound IP resource of the cluster public load balancer.
+type EffectiveOutboundIP struct {
+	// The fully qualified Azure resource id of an IP address resource.
+	ID *string
+}
+
+// IngressProfile represents an ingress profile.
+type IngressProfile struct {
+	// The ingress profile name.
+	Name *string
+
+	// Ingress visibility.
+	Visibility *Visibility
+
+	// READ-ONLY; The IP of the ingress.
+	IP *string
+}
+
+// LoadBalancerProfile represents the profile of the cluster public load balancer.
+type LoadBalancerProfile struct {
+	// The desired managed outbound IPs for the cluster public load balancer.
+	ManagedOutboundIPs *ManagedOutboundIPs
+
+	// READ-ONLY; The list of effective outbound IP addresses of the public load balancer.
+	EffectiveOutboundIPs []*EffectiveOutboundIP
+}
+
+// MaintenanceWindow restricts when planned maintenance may start on a cluster.
+type MaintenanceWindow struct {
+	// Dates, formatted as YYYY-MM-DD in the time zone of the window, on which the window does not open.
+	BlackoutDates []*string
+
+	// The days on which the window opens, e.g. "Saturday". The window opens every day if not set.
+	DaysOfWeek []*string
+
+	// How many hours the window stays open for, from 1 to 24.
+	DurationHours *int32
+
+	// The hour of the day, from 0 to 23, at which the window opens.
+	StartHour *int32
+
+	// The IANA name of the time zone of the window, e.g. "Europe/London". UTC is used if not set.
+	TimeZone *string
+}
+
+// ManagedOutboundIPs represents the desired managed outbound IPs for the cluster public load balancer.
+type ManagedOutboundIPs struct {
+	// Count represents the desired number of IPv4 outbound IPs created and managed by Azure for the cluster public load balancer.
+	// Allowed values are in the range of 1 - 20. The default value is 1.
+	Count *int32
+}
+
+// ManagedServiceIdentity - Managed service identity (system assigned and/or user assigned identities)
+type ManagedServiceIdentity struct {
+	// REQUIRED; The type of managed identity assigned to this resource.
+	Type *ManagedServiceIdentityType
+
+	// The identities assigned to this resource by the user.
+	UserAssignedIdentities map[string]*UserAssignedIdentity
+
+	// READ-ONLY; The service principal ID of the system assigned identity. This property will only be provided for a system assigned
+	// identity.
+	PrincipalID *string
+
+	// READ-ONLY; The tenant ID of the system assigned identity. This property will only be provided for a system assigned identity.
+	TenantID *string
+}
+
+// MasterProfile represents a master profile.
+type MasterProfile struct {
+	// The resource ID of an associated DiskEncryptionSet, if applicable.
+	DiskEncryptionSetID *string
+
+	// Whether master virtual machines are encrypted at host.
+	EncryptionAtHost *EncryptionAtHost
+
+	// The Azure resource ID of the master subnet.
+	SubnetID *string
+
+	// The size of the master VMs.
+	VMSize *string
+}
+
+// NetworkProfile represents a network profile.
+type NetworkProfile struct {
+	// The cluster load balancer profile.
+	LoadBalancerProfile *LoadBalancerProfile
+
+	// The OutboundType used for egress traffic.
+	OutboundType *OutboundType
+
+	// The CIDR used for OpenShift/Kubernetes Pods.
+	PodCidr *string
+
+	// Specifies whether subnets are pre-attached with an NSG
+	PreconfiguredNSG *PreconfiguredNSG
+
+	// The CIDR used for OpenShift/Kubernetes Services.
+	ServiceCidr *string
+}
+
+// OpenShiftCluster represents an Azure Red Hat OpenShift cluster.
+type OpenShiftCluster struct {
+	// REQUIRED; The geo-location where the resource lives
+	Location *string
+
+	// The managed service identities assigned to this resource.
+	Identity *ManagedServiceIdentity
+
+	// The cluster properties.
+	Properties *OpenShiftClusterProperties
+
+	// Resource tags.
+	Tags map[string]*string
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// OpenShiftClusterAdminKubeconfig represents an OpenShift cluster's admin kubeconfig.
+type OpenShiftClusterAdminKubeconfig struct {
+	// The base64-encoded kubeconfig file.
+	Kubeconfig *string
+}
+
+// OpenShiftClusterCredentials represents an OpenShift cluster's credentials.
+type OpenShiftClusterCredentials struct {
+	// The password for the kubeadmin user.
+	KubeadminPassword *string
+
+	// The username for the kubeadmin user.
+	KubeadminUsername *string
+}
+
+// OpenShiftClusterList represents a list of OpenShift clusters.
+type OpenShiftClusterList struct {
+	// REQUIRED; The OpenShiftCluster items on this page
+	Value []*OpenShiftCluster
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// OpenShiftClusterProperties represents an OpenShift cluster's properties.
+type OpenShiftClusterProperties struct {
+	// The cluster API server profile.
+	ApiserverProfile *APIServerProfile
+
+	// The cluster profile.
+	ClusterProfile *ClusterProfile
+
+	// The console profile.
+	ConsoleProfile *ConsoleProfile
+
+	// The cluster ingress profiles.
+	IngressProfiles []*IngressProfile
+
+	// The window in which planned maintenance may start on the cluster.
+	MaintenanceWindow *MaintenanceWindow
+
+	// The cluster master profile.
+	MasterProfile *MasterProfile
+
+	// The cluster network profile.
+	NetworkProfile *NetworkProfile
+
+	// The workload identity profile.
+	PlatformWorkloadIdentityProfile *PlatformWorkloadIdentityProfile
+
+	// The cluster provisioning state.
+	ProvisioningState *ProvisioningState
+
+	// The cluster service principal profile.
+	ServicePrincipalProfile *ServicePrincipalProfile
+
+	// The cluster worker profiles.
+	WorkerProfiles []*WorkerProfile
+
+	// READ-ONLY; The cluster worker profiles status.
+	WorkerProfilesStatus []*WorkerProfile
+}
+
+// OpenShiftClusterUpdate - OpenShiftCluster represents an Azure Red Hat OpenShift cluster.
+type OpenShiftClusterUpdate struct {
+	// Identity stores information about the cluster MSI(s) in a workload identity cluster.
+	Identity *ManagedServiceIdentity
+
+	// The cluster properties.
+	Properties *OpenShiftClusterProperties
+
+	// The resource tags.
+	Tags map[string]*string
+}
+
+// OpenShiftVersion represents an OpenShift version that can be installed.
+type OpenShiftVersion struct {
+	// The properties for the OpenShiftVersion resource.
+	Properties *OpenShiftVersionProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// OpenShiftVersionList represents a List of available versions.
+type OpenShiftVersionList struct {
+	// REQUIRED; The OpenShiftVersion items on this page
+	Value []*OpenShiftVersion
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// OpenShiftVersionProperties represents the properties of an OpenShiftVersion.
+type OpenShiftVersionProperties struct {
+	// Version represents the version to create the cluster at.
+	Version *string
+}
+
+// Operation represents an RP operation.
+type Operation struct {
+	// The object that describes the operation.
+	Display *Display
+
+	// Operation name: {provider}/{resource}/{operation}.
+	Name *string
+
+	// Sources of requests to this operation. Comma separated list with valid values user or system, e.g. "user,system".
+	Origin *string
+}
+
+// OperationList represents an RP operation list.
+type OperationList struct {
+	// REQUIRED; The Operation items on this page
+	Value []*Operation
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// PlatformWorkloadIdentity stores information representing a single workload identity.
+type PlatformWorkloadIdentity struct {
+	// The resource ID of the PlatformWorkloadIdentity resource
+	ResourceID *string
+
+	// READ-ONLY; The ClientID of the PlatformWorkloadIdentity resource
+	ClientID *string
+
+	// READ-ONLY; The ObjectID of the PlatformWorkloadIdentity resource
+	ObjectID *string
+}
+
+// PlatformWorkloadIdentityProfile encapsulates all information that is specific to workload identity clusters.
+type PlatformWorkloadIdentityProfile struct {
+	// Dictionary of <PlatformWorkloadIdentity>
+	PlatformWorkloadIdentities map[string]*PlatformWorkloadIdentity
+
+	// UpgradeableTo stores a single OpenShift version a workload identity cluster can be upgraded to
+	UpgradeableTo *string
+}
+
+// PlatformWorkloadIdentityRole represents a mapping from a particular OCP operator to the built-in role that should be assigned
+// to that operator's corresponding managed identity.
+type PlatformWorkloadIdentityRole struct {
+	// OperatorName represents the name of the operator that this role is for.
+	OperatorName *string
+
+	// RoleDefinitionID represents the resource ID of the role definition.
+	RoleDefinitionID *string
+
+	// RoleDefinitionName represents the name of the role.
+	RoleDefinitionName *string
+}
+
+// PlatformWorkloadIdentityRoleSet represents a mapping from the names of OCP operators to the built-in roles that should
+// be assigned to those operator's corresponding managed identities for a particular OCP version.
+type PlatformWorkloadIdentityRoleSet struct {
+	// The properties for the PlatformWorkloadIdentityRoleSet resource.
+	Properties *PlatformWorkloadIdentityRoleSetProperties
+
+	// READ-ONLY; Fully qualified resource ID for the resource. Ex - /subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}
+	ID *string
+
+	// READ-ONLY; The name of the resource
+	Name *string
+
+	// READ-ONLY; Azure Resource Manager metadata containing createdBy and modifiedBy information.
+	SystemData *SystemData
+
+	// READ-ONLY; The type of the resource. E.g. "Microsoft.Compute/virtualMachines" or "Microsoft.Storage/storageAccounts"
+	Type *string
+}
+
+// PlatformWorkloadIdentityRoleSetList represents a List of role sets.
+type PlatformWorkloadIdentityRoleSetList struct {
+	// REQUIRED; The PlatformWorkloadIdentityRoleSet items on this page
+	Value []*PlatformWorkloadIdentityRoleSet
+
+	// The link to the next page of items
+	NextLink *string
+}
+
+// PlatformWorkloadIdentityRoleSetProperties represents the properties of a PlatformWorkloadIdentityRoleSet resource.
+type PlatformWorkloadIdentityRoleSetProperties struct {
+	// OpenShiftVersion represents the version associated with this set of roles.
+	OpenShiftVersion *string
+
+	// PlatformWorkloadIdentityRoles represents the set of roles associated with this version.
+	PlatformWorkloadIdentityRoles []*PlatformWorkloadIdentityRole
+}
+
+// ServicePrincipalProfile represents a service principal profile.
+type ServicePrincipalProfile struct {
+	// The client ID used for the cluster.
+	ClientID *string
+
+	// The client secret used for the cluster.
+	ClientSecret *string
+}
+
+// SystemData - Metadata pertaining to creation and last modification of the resource.
+type SystemData struct {
+	// The timestamp of resource creation (UTC).
+	CreatedAt *time.Time
+
+	// The identity that created the resource.
+	CreatedBy *string
+
+	// The type of identity that created the resource.
+	CreatedByType *CreatedByType
+
+	// The timestamp of resource last modification (UTC)
+	LastModifiedAt *time.Time
+
+	// The identity that last modified the resource.
+	LastModifiedBy *string
+
+	// The type of identity that last modified the resource.
+	LastModifiedByType *CreatedByType
+}
+
+// UserAssignedIdentity - User assigned identity properties
+type UserAssignedIdentity struct {
+	// READ-ONLY; The client ID of the assigned identity.
+	ClientID *string
+
+	// READ-ONLY; The principal ID of the assigned identity.
+	PrincipalID *string
+}
+
+// WorkerProfile represents a worker profile.
+type WorkerProfile struct {
+	// The number of worker VMs.
+	Count *int32
+
+	// The resource ID of an associated DiskEncryptionSet, if applicable.
+	DiskEncryptionSetID *string
+
+	// The disk size of the worker VMs.
+	DiskSizeGB *int32
+
+	// Whether master virtual machines are encrypted at host.
+	EncryptionAtHost *EncryptionAtHost
+
+	// The worker profile name.
+	Name *string
+
+	// The Azure resource ID of the worker subnet.
+	SubnetID *string
+
+	// The size of the worker VMs.
+	VMSize *string
+}
@@ -0,0 +1,1997 @@
+package v20261001preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/test/validate"
+	"github.com/Azure/ARO-RP/pkg/api/util/pointerutils"
+	"github.com/Azure/ARO-RP/pkg/api/util/uuid"
+	"github.com/Azure/ARO-RP/pkg/api/v20261001preview/generated"
+)
+
+type validateTest struct {
+	name                string
+	clusterName         *string
+	location            *string
+	clusterVersion      *string
+	current             func(oc *OpenShiftCluster)
+	modify              func(oc *OpenShiftCluster)
+	requireD2sWorkers   bool
+	architectureVersion *api.ArchitectureVersion
+	wantErr             string
+}
+
+type testMode string
+
+const (
+	testModeCreate testMode = "Create"
+	testModeUpdate testMode = "Update"
+
+	consoleProfileUrl   = "https://console-openshift-console.apps.cluster.location.aroapp.io/"
+	apiserverProfileUrl = "https://api.cluster.location.aroapp.io:6443/"
+	apiserverProfileIp  = "1.2.3.4"
+	ingressProfileIp    = "1.2.3.4"
+)
+
+var (
+	subscriptionID    = "00000000-0000-0000-0000-000000000000"
+	platformIdentity1 = &generated.PlatformWorkloadIdentity{
+		ResourceID: pointerutils.ToPtr("/subscriptions/12345678-1234-1234-1234-123456789012/resourceGroups/a-fake-group/providers/Microsoft.RedHatOpenShift/userAssignedIdentities/fake-cluster-name"),
+	}
+	platformIdentity2 = &generated.PlatformWorkloadIdentity{
+		ResourceID: pointerutils.ToPtr("/subscriptions/12345678-1234-1234-1234-123456789012/resourceGroups/a-fake-group/providers/Microsoft.RedHatOpenShift/userAssignedIdentities/fake-cluster-name-two"),
+	}
+	clusterIdentity1 = &generated.UserAssignedIdentity{}
+)
+
+func getResourceID(clusterName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/microsoft.redhatopenshift/openshiftclusters/%s", subscriptionID, clusterName)
+}
+
+func validSystemData() *generated.SystemData {
+	timestamp, err := time.Parse(time.RFC3339, "2021-01-23T12:34:54.0000000Z")
+	if err != nil {
+		panic(err)
+	}
+
+	return &generated.SystemData{
+		CreatedBy:          pointerutils.ToPtr("00000000-0000-0000-0000-000000000000"),
+		CreatedByType:      pointerutils.ToPtr(generated.CreatedByTypeApplication),
+		CreatedAt:          &timestamp,
+		LastModifiedBy:     pointerutils.ToPtr("00000000-0000-0000-0000-000000000000"),
+		LastModifiedByType: pointerutils.ToPtr(generated.CreatedByTypeApplication),
+		LastModifiedAt:     &timestamp,
+	}
+}
+
+func validOpenShiftCluster(name, location, version string) *OpenShiftCluster {
+	oc := &OpenShiftCluster{
+		OpenShiftCluster: generated.OpenShiftCluster{
+			ID:       pointerutils.ToPtr(getResourceID(name)),
+			Name:     pointerutils.ToPtr(name),
+			Type:     pointerutils.ToPtr("Microsoft.RedHatOpenShift/OpenShiftClusters"),
+			Location: pointerutils.ToPtr(location),
+			Tags: map[string]*string{
+				"key": pointerutils.ToPtr("value"),
+			},
+			Properties: &generated.OpenShiftClusterProperties{
+				ProvisioningState: pointerutils.ToPtr(generated.ProvisioningStateSucceeded),
+				ClusterProfile: &generated.ClusterProfile{
+					PullSecret:           pointerutils.ToPtr(`{"auths":{"registry.connect.redhat.com":{"auth":""},"registry.redhat.io":{"auth":""}}}`),
+					Domain:               pointerutils.ToPtr("cluster.location.aroapp.io"),
+					Version:              pointerutils.ToPtr(version),
+					ResourceGroupID:      pointerutils.ToPtr(fmt.Sprintf("/subscriptions/%s/resourceGroups/test-cluster", subscriptionID)),
+					FipsValidatedModules: pointerutils.ToPtr(generated.FipsValidatedModulesDisabled),
+				},
+				ConsoleProfile: &generated.ConsoleProfile{
+					URL: pointerutils.ToPtr(""),
+				},
+				ServicePrincipalProfile: &generated.ServicePrincipalProfile{
+					ClientSecret: pointerutils.ToPtr("clientSecret"),
+					ClientID:     pointerutils.ToPtr("11111111-1111-1111-1111-111111111111"),
+				},
+				NetworkProfile: &generated.NetworkProfile{
+					PodCidr:      pointerutils.ToPtr("10.128.0.0/14"),
+					ServiceCidr:  pointerutils.ToPtr("172.30.0.0/16"),
+					OutboundType: pointerutils.ToPtr(generated.OutboundTypeLoadbalancer),
+					LoadBalancerProfile: &generated.LoadBalancerProfile{
+						ManagedOutboundIPs: &generated.ManagedOutboundIPs{
+							Count: pointerutils.ToPtr(int32(1)),
+						},
+					},
+				},
+				MasterProfile: &generated.MasterProfile{
+					VMSize:           pointerutils.ToPtr("Standard_D8s_v3"),
+					EncryptionAtHost: pointerutils.ToPtr(generated.EncryptionAtHostDisabled),
+					SubnetID:         pointerutils.ToPtr(fmt.Sprintf("/subscriptions/%s/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/master", subscriptionID)),
+				},
+				WorkerProfiles: []*generated.WorkerProfile{
+					{
+						Name:             pointerutils.ToPtr("worker"),
+						VMSize:           pointerutils.ToPtr("Standard_D4s_v3"),
+						EncryptionAtHost: pointerutils.ToPtr(generated.EncryptionAtHostDisabled),
+						DiskSizeGB:       pointerutils.ToPtr(int32(128)),
+						SubnetID:         pointerutils.ToPtr(fmt.Sprintf("/subscriptions/%s/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/worker", subscriptionID)),
+						Count:            pointerutils.ToPtr(int32(3)),
+					},
+				},
+				ApiserverProfile: &generated.APIServerProfile{
+					Visibility: pointerutils.ToPtr(generated.VisibilityPublic),
+					URL:        pointerutils.ToPtr(""),
+					IP:         pointerutils.ToPtr(""),
+				},
+				IngressProfiles: []*generated.IngressProfile{
+					{
+						Name:       pointerutils.ToPtr("default"),
+						Visibility: pointerutils.ToPtr(generated.VisibilityPublic),
+						IP:         pointerutils.ToPtr(""),
+					},
+				},
+			},
+		},
+	}
+
+	return oc
+}
+
+func runTests(t *testing.T, mode testMode, tests []*validateTest) {
+	t.Run(string(mode), func(t *testing.T) {
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// default values if not set
+				if tt.architectureVersion == nil {
+					tt.architectureVersion = pointerutils.ToPtr(api.ArchitectureVersionV2)
+				}
+
+				if tt.location == nil {
+					tt.location = pointerutils.ToPtr("location")
+				}
+
+				if tt.clusterName == nil {
+					tt.clusterName = pointerutils.ToPtr("resourceName")
+				}
+
+				if tt.clusterVersion == nil {
+					tt.clusterVersion = pointerutils.ToPtr("4.10.0")
+				}
+
+				v := &openShiftClusterStaticValidator{
+					location:          *tt.location,
+					domain:            "location.aroapp.io",
+					requireD2sWorkers: tt.requireD2sWorkers,
+					resourceID:        getResourceID(*tt.clusterName),
+					r: azure.Resource{
+						SubscriptionID: subscriptionID,
+						ResourceGroup:  "resourceGroup",
+						Provider:       "Microsoft.RedHatOpenShift",
+						ResourceType:   "openshiftClusters",
+						ResourceName:   *tt.clusterName,
+					},
+				}
+
+				validOCForTest := func() *OpenShiftCluster {
+					oc := validOpenShiftCluster(*tt.clusterName, *tt.location, *tt.clusterVersion)
+					if tt.current != nil {
+						tt.current(oc)
+					}
+					return oc
+				}
+
+				oc := validOCForTest()
+				if tt.modify != nil {
+					tt.modify(oc)
+				}
+
+				var current *api.OpenShiftCluster
+				if mode == testModeUpdate {
+					current = &api.OpenShiftCluster{}
+
+					ext := validOCForTest()
+					ext.SystemData = validSystemData()
+					*ext.Properties.ConsoleProfile.URL = consoleProfileUrl
+					*ext.Properties.ApiserverProfile.URL = apiserverProfileUrl
+					*ext.Properties.ApiserverProfile.IP = apiserverProfileIp
+					*ext.Properties.IngressProfiles[0].IP = ingressProfileIp
+					current.Properties.ArchitectureVersion = *tt.architectureVersion
+
+					(&openShiftClusterConverter{}).ToInternal(ext, current)
+				}
+
+				err := v.Static(oc, current, v.location, v.domain, tt.requireD2sWorkers, api.ArchitectureVersionV2, v.resourceID)
+				if err == nil {
+					if tt.wantErr != "" {
+						t.Errorf("Expected error %s, got nil", tt.wantErr)
+					}
+				} else {
+					if err.Error() != tt.wantErr {
+						t.Errorf("got %s, wanted %s", err, tt.wantErr)
+					}
+
+					cloudErr := err.(*api.CloudError)
+
+					if cloudErr.StatusCode != http.StatusBadRequest {
+						t.Error(cloudErr.StatusCode)
+					}
+					if cloudErr.Target == "" {
+						t.Error("target is required")
+					}
+
+					validate.CloudError(t, err)
+				}
+			})
+		}
+	})
+}
+
+func TestOpenShiftClusterStaticValidate(t *testing.T) {
+	commonTests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "id wrong",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.ID = "wrong"
+			},
+			wantErr: "400: MismatchingResourceID: id: The provided resource ID 'wrong' did not match the name in the Url '/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/microsoft.redhatopenshift/openshiftclusters/resourceName'.",
+		},
+		{
+			name: "name wrong",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Name = "wrong"
+			},
+			wantErr: "400: MismatchingResourceName: name: The provided resource name 'wrong' did not match the name in the Url 'resourceName'.",
+		},
+		{
+			name: "type wrong",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Type = "wrong"
+			},
+			wantErr: "400: MismatchingResourceType: type: The provided resource type 'wrong' did not match the name in the Url 'Microsoft.RedHatOpenShift/openShiftClusters'.",
+		},
+		{
+			name: "location invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Location = "invalid"
+			},
+			wantErr: "400: InvalidParameter: location: The provided location 'invalid' is invalid.",
+		},
+	}
+
+	runTests(t, testModeCreate, commonTests)
+	runTests(t, testModeUpdate, commonTests)
+}
+
+func TestOpenShiftClusterStaticValidateNilInputs(t *testing.T) {
+	validator := &openShiftClusterStaticValidator{
+		location:   "location",
+		domain:     "location.aroapp.io",
+		resourceID: getResourceID("resourceName"),
+		r: azure.Resource{
+			SubscriptionID: subscriptionID,
+			ResourceGroup:  "resourceGroup",
+			Provider:       "Microsoft.RedHatOpenShift",
+			ResourceType:   "openshiftClusters",
+			ResourceName:   "resourceName",
+		},
+	}
+
+	tests := []struct {
+		name       string
+		validate   func() error
+		wantTarget string
+	}{
+		{
+			name: "nil properties",
+			validate: func() error {
+				oc := validOpenShiftCluster("resourceName", validator.location, "4.10.0")
+				oc.Properties = nil
+				return validator.validate(oc, true, api.ArchitectureVersionV2)
+			},
+			wantTarget: "properties",
+		},
+		{
+			name: "nil cluster profile",
+			validate: func() error {
+				oc := validOpenShiftCluster("resourceName", validator.location, "4.10.0")
+				oc.Properties.ClusterProfile = nil
+				return validator.validateProperties("properties", oc.Properties, true, api.ArchitectureVersionV2)
+			},
+			wantTarget: "properties.clusterProfile",
+		},
+		{
+			name: "nil network profile",
+			validate: func() error {
+				oc := validOpenShiftCluster("resourceName", validator.location, "4.10.0")
+				oc.Properties.NetworkProfile = nil
+				return validator.validateProperties("properties", oc.Properties, true, api.ArchitectureVersionV2)
+			},
+			wantTarget: "properties.networkProfile",
+		},
+		{
+			name: "nil master profile",
+			validate: func() error {
+				oc := validOpenShiftCluster("resourceName", validator.location, "4.10.0")
+				oc.Properties.MasterProfile = nil
+				return validator.validateProperties("properties", oc.Properties, true, api.ArchitectureVersionV2)
+			},
+			wantTarget: "properties.masterProfile",
+		},
+		{
+			name: "nil API server profile",
+			validate: func() error {
+				oc := validOpenShiftCluster("resourceName", validator.location, "4.10.0")
+				oc.Properties.ApiserverProfile = nil
+				return validator.validateProperties("properties", oc.Properties, true, api.ArchitectureVersionV2)
+			},
+			wantTarget: "properties.apiserverProfile",
+		},
+		{
+			name: "nil worker profile",
+			validate: func() error {
+				oc := validOpenShiftCluster("resourceName", validator.location, "4.10.0")
+				oc.Properties.WorkerProfiles[0] = nil
+				return validator.validateProperties("properties", oc.Properties, true, api.ArchitectureVersionV2)
+			},
+			wantTarget: "properties.workerProfiles[0]",
+		},
+		{
+			name: "nil ingress profile",
+			validate: func() error {
+				oc := validOpenShiftCluster("resourceName", validator.location, "4.10.0")
+				oc.Properties.IngressProfiles[0] = nil
+				return validator.validateProperties("properties", oc.Properties, true, api.ArchitectureVersionV2)
+			},
+			wantTarget: "properties.ingressProfiles[0]",
+		},
+		{
+			name: "nil platform workload identity",
+			validate: func() error {
+				return validator.validatePlatformWorkloadIdentityProfile("properties.platformWorkloadIdentityProfile", &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{"operator": nil},
+				})
+			},
+			wantTarget: "properties.platformWorkloadIdentityProfile.PlatformWorkloadIdentities[operator]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.validate()
+			if err == nil {
+				t.Fatal("expected an error")
+			}
+
+			cloudErr, ok := err.(*api.CloudError)
+			if !ok {
+				t.Fatalf("expected *api.CloudError, got %T", err)
+			}
+			if cloudErr.Target != tt.wantTarget {
+				t.Errorf("got target %q, want %q", cloudErr.Target, tt.wantTarget)
+			}
+		})
+	}
+}
+
+func TestOpenShiftClusterStaticValidateProperties(t *testing.T) {
+	commonTests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "provisioningState invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ProvisioningState = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.provisioningState: The provided provisioning state 'invalid' is invalid.",
+		},
+	}
+	createTests := []*validateTest{
+		{
+			name: "no workerProfiles invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles = nil
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles: There should be exactly one worker profile.",
+		},
+		{
+			name: "multiple workerProfiles invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles = []*generated.WorkerProfile{{}, {}}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles: There should be exactly one worker profile.",
+		},
+		{
+			name: "workerProfileStatus nonNil",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfilesStatus = []*generated.WorkerProfile{
+					{
+						Name:             pointerutils.ToPtr("worker"),
+						VMSize:           pointerutils.ToPtr("Standard_D4s_v3"),
+						EncryptionAtHost: pointerutils.ToPtr(generated.EncryptionAtHostDisabled),
+						DiskSizeGB:       pointerutils.ToPtr(int32(128)),
+						SubnetID:         pointerutils.ToPtr(fmt.Sprintf("/subscriptions/%s/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/worker", subscriptionID)),
+						Count:            pointerutils.ToPtr(int32(3)),
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfilesStatus: Worker Profile Status must be set to nil.",
+		},
+	}
+
+	runTests(t, testModeCreate, createTests)
+	runTests(t, testModeCreate, commonTests)
+	runTests(t, testModeUpdate, commonTests)
+}
+
+func TestOpenShiftClusterStaticValidateClusterProfile(t *testing.T) {
+	commonTests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "pull secret not a map",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.PullSecret = "1"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.pullSecret: The provided pull secret is invalid.",
+		},
+		{
+			name: "pull secret invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.PullSecret = "{"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.pullSecret: The provided pull secret is invalid.",
+		},
+		{
+			name: "empty domain invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.Domain = ""
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '' is invalid.",
+		},
+		{
+			name: "upper case domain invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.Domain = "BAD"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'BAD' is invalid.",
+		},
+		{
+			name: "domain invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.Domain = "!"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '!' is invalid.",
+		},
+		{
+			name: "wrong location managed domain invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.Domain = "cluster.wronglocation.aroapp.io"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'cluster.wronglocation.aroapp.io' is invalid.",
+		},
+		{
+			name: "double part managed domain invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.Domain = "foo.bar.location.aroapp.io"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain 'foo.bar.location.aroapp.io' is invalid.",
+		},
+		{
+			name: "resourceGroupId invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.ResourceGroupID = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group 'invalid' is invalid.",
+		},
+		{
+			name: "cluster resource group subscriptionId not matching cluster subscriptionId",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.ResourceGroupID = "/subscriptions/7a3036d1-60a1-4605-8a41-44955e050804/resourcegroups/test-cluster"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group '/subscriptions/7a3036d1-60a1-4605-8a41-44955e050804/resourcegroups/test-cluster' is invalid: must be in same subscription as cluster.",
+		},
+		{
+			name: "cluster resourceGroup and external resourceGroup equal",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.ResourceGroupID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.resourceGroupId: The provided resource group '/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup' is invalid: must be different from resourceGroup of the OpenShift cluster object.",
+		},
+		{
+			name: "fips validated modules invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.FipsValidatedModules = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.fipsValidatedModules: The provided value 'invalid' is invalid.",
+		},
+		{
+			name: "fips validated modules empty",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.FipsValidatedModules = ""
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.fipsValidatedModules: The provided value '' is invalid.",
+		},
+	}
+
+	createTests := []*validateTest{
+		{
+			name: "empty pull secret valid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.PullSecret = ""
+			},
+		},
+		{
+			name: "leading digit domain invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.Domain = "4k7f9clk"
+			},
+			wantErr: "400: InvalidParameter: properties.clusterProfile.domain: The provided domain '4k7f9clk' is invalid.",
+		},
+	}
+
+	updateTests := []*validateTest{
+		{
+			name: "leading digit domain valid",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.Domain = "4k7f9clk"
+			},
+		},
+	}
+
+	runTests(t, testModeCreate, createTests)
+	runTests(t, testModeCreate, commonTests)
+	runTests(t, testModeUpdate, updateTests)
+	runTests(t, testModeUpdate, commonTests)
+}
+
+func TestOpenShiftClusterStaticValidateConsoleProfile(t *testing.T) {
+	commonTests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "console url invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ConsoleProfile.URL = "\x00"
+			},
+			wantErr: "400: InvalidParameter: properties.consoleProfile.url: The provided console URL '\x00' is invalid.",
+		},
+	}
+
+	createTests := []*validateTest{
+		{
+			name: "empty console url valid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ConsoleProfile.URL = ""
+			},
+		},
+	}
+
+	runTests(t, testModeCreate, createTests)
+	runTests(t, testModeCreate, commonTests)
+	runTests(t, testModeUpdate, commonTests)
+}
+
+func TestOpenShiftClusterStaticValidateServicePrincipalProfile(t *testing.T) {
+	tests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "clientID invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ServicePrincipalProfile.ClientID = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.servicePrincipalProfile.clientId: The provided client ID 'invalid' is invalid.",
+		},
+		{
+			name: "empty clientSecret invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ServicePrincipalProfile.ClientSecret = ""
+			},
+			wantErr: "400: InvalidParameter: properties.servicePrincipalProfile.clientSecret: The provided client secret is invalid.",
+		},
+	}
+
+	runTests(t, testModeCreate, tests)
+	runTests(t, testModeUpdate, tests)
+}
+
+func TestOpenShiftClusterStaticValidateNetworkProfile(t *testing.T) {
+	tests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "podCidr invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.podCidr: The provided pod CIDR 'invalid' is invalid: 'invalid CIDR address: invalid'.",
+		},
+		{
+			name: "ipv6 podCidr invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "::0/0"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.podCidr: The provided pod CIDR '::0/0' is invalid: must be IPv4.",
+		},
+		{
+			name: "serviceCidr invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.serviceCidr: The provided service CIDR 'invalid' is invalid: 'invalid CIDR address: invalid'.",
+		},
+		{
+			name: "ipv6 serviceCidr invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "::0/0"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.serviceCidr: The provided service CIDR '::0/0' is invalid: must be IPv4.",
+		},
+		{
+			name: "podCidr too small",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "10.0.0.0/19"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.podCidr: The provided vnet CIDR '10.0.0.0/19' is invalid: must be /18 or larger.",
+		},
+		{
+			name: "serviceCidr too small",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "10.0.0.0/23"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.serviceCidr: The provided vnet CIDR '10.0.0.0/23' is invalid: must be /22 or larger.",
+		},
+		{
+			name: "OutboundType is empty",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.OutboundType = ""
+			},
+			wantErr: "",
+		},
+		{
+			name: "OutboundType is invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.OutboundType = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.outboundType: The provided outboundType 'invalid' is invalid: must be UserDefinedRouting or Loadbalancer.",
+		},
+		{
+			name: "OutboundType is invalid with UserDefinedRouting and public ingress",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.OutboundType = generated.OutboundTypeUserDefinedRouting
+				*oc.Properties.IngressProfiles[0].Visibility = generated.VisibilityPublic
+				*oc.Properties.ApiserverProfile.Visibility = generated.VisibilityPrivate
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.outboundType: The provided outboundType 'UserDefinedRouting' is invalid: cannot use UserDefinedRouting if either API Server Visibility or Ingress Visibility is public.",
+		},
+		{
+			name: "OutboundType Loadbalancer is valid",
+			modify: func(oc *OpenShiftCluster) {
+			},
+			wantErr: "",
+		},
+		{
+			name: "LoadBalancerProfile invalid when used with UserDefinedRouting",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.OutboundType = generated.OutboundTypeUserDefinedRouting
+				*oc.Properties.IngressProfiles[0].Visibility = generated.VisibilityPrivate
+				*oc.Properties.ApiserverProfile.Visibility = generated.VisibilityPrivate
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &generated.LoadBalancerProfile{
+					ManagedOutboundIPs: &generated.ManagedOutboundIPs{
+						Count: pointerutils.ToPtr(int32(3)),
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.loadBalancerProfile: The provided loadBalancerProfile is invalid: cannot use a loadBalancerProfile if outboundType is UserDefinedRouting.",
+		},
+		{
+			name: "Not passing in a LoadBalancerProfile is valid.",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.OutboundType = generated.OutboundTypeLoadbalancer
+				oc.Properties.NetworkProfile.LoadBalancerProfile = nil
+			},
+			wantErr: "",
+		},
+		{
+			name: "podCidr invalid network",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "10.254.0.0/14"
+			},
+			wantErr: "400: InvalidNetworkAddress: properties.networkProfile.podCidr: The provided pod CIDR '10.254.0.0/14' is invalid, expecting: '10.252.0.0/14'.",
+		},
+		{
+			name: "serviceCidr invalid network",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "10.0.150.0/16"
+			},
+			wantErr: "400: InvalidNetworkAddress: properties.networkProfile.serviceCidr: The provided service CIDR '10.0.150.0/16' is invalid, expecting: '10.0.0.0/16'.",
+		},
+		{
+			name: "podCidr invalid CIDR-1",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "100.64.0.0/18"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '100.64.0.0/18' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "podCidr invalid CIDR-2",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "169.254.169.0/29"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '169.254.169.0/29' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "podCidr invalid CIDR-3",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "100.88.0.0/16"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '100.88.0.0/16' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "serviceCidr invalid CIDR-1",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "100.64.0.0/16"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '100.64.0.0/16' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "serviceCidr invalid CIDR-2",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "169.254.169.1/29"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '169.254.169.1/29' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "serviceCidr invalid CIDR-3",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "100.88.0.0/32"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '100.88.0.0/32' IP address range in any other CIDR definitions in your cluster.",
+		},
+	}
+
+	// OVN CIDR validation should only block during creation, allow during updates to support SDN->OVN migrations
+	createOnlyCIDRTests := []*validateTest{
+		{
+			name: "podCidr invalid CIDR-1",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "100.64.0.0/18"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '100.64.0.0/18' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "podCidr invalid CIDR-2",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "169.254.169.0/29"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '169.254.169.0/29' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "podCidr invalid CIDR-3",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "100.88.0.0/16"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '100.88.0.0/16' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "serviceCidr invalid CIDR-1",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "100.64.0.0/16"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '100.64.0.0/16' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "serviceCidr invalid CIDR-2",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "169.254.169.1/29"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '169.254.169.1/29' IP address range in any other CIDR definitions in your cluster.",
+		},
+		{
+			name: "serviceCidr invalid CIDR-3",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "100.88.0.0/32"
+			},
+			wantErr: "400: InvalidCIDRRange: properties.networkProfile: Azure Red Hat OpenShift uses 100.64.0.0/16, 169.254.169.0/29, and 100.88.0.0/16 IP address ranges internally. Do not include this '100.88.0.0/32' IP address range in any other CIDR definitions in your cluster.",
+		},
+	}
+
+	// OVN CIDR ranges should be allowed during updates to support existing clusters with overlapping ranges
+	updateOnlyCIDRTests := []*validateTest{
+		{
+			name: "existing cluster with overlapping podCidr allowed on update-1",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "100.64.0.0/15" // Overlaps with 100.64.0.0/16
+			},
+			wantErr: "", // Should be allowed on updates - existing overlap tolerated
+		},
+		{
+			name: "existing cluster with overlapping podCidr allowed on update-2",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "100.88.0.0/15" // Overlaps with 100.88.0.0/16
+			},
+			wantErr: "", // Should be allowed on updates - existing overlap tolerated
+		},
+		{
+			name: "existing cluster with overlapping serviceCidr allowed on update-1",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "100.64.0.0/15" // Overlaps with 100.64.0.0/16
+			},
+			wantErr: "", // Should be allowed on updates - existing overlap tolerated
+		},
+		{
+			name: "existing cluster with overlapping serviceCidr allowed on update-2",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.ServiceCidr = "100.88.0.0/15" // Overlaps with 100.88.0.0/16
+			},
+			wantErr: "", // Should be allowed on updates - existing overlap tolerated
+		},
+		{
+			name: "existing cluster with small overlapping range allowed on update",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "169.254.128.0/18" // Properly aligned, overlaps with 169.254.169.0/29
+			},
+			wantErr: "", // Should be allowed on updates - existing overlap tolerated
+		},
+		{
+			name: "existing cluster with service update - no network changes",
+			current: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.PodCidr = "100.64.0.0/15" // Existing overlapping range
+			},
+			modify: func(oc *OpenShiftCluster) {
+				// Simulate service principal update - network profile unchanged
+				*oc.Properties.ServicePrincipalProfile.ClientSecret = "new-secret"
+			},
+			wantErr: "", // Should be allowed - updating other fields with existing overlapping CIDR
+		},
+	}
+
+	// Filter out the OVN CIDR tests from the common tests since they now have different behavior for create vs update
+	commonTests := make([]*validateTest, 0)
+	for _, test := range tests {
+		// Skip the OVN CIDR range tests - they're now handled separately
+		if !strings.Contains(test.name, "invalid CIDR-") {
+			commonTests = append(commonTests, test)
+		}
+	}
+
+	runTests(t, testModeCreate, createOnlyCIDRTests)
+	runTests(t, testModeCreate, commonTests)
+	runTests(t, testModeUpdate, updateOnlyCIDRTests)
+	runTests(t, testModeUpdate, commonTests)
+}
+
+func TestOpenShiftClusterStaticValidateLoadBalancerProfile(t *testing.T) {
+	tests := []*validateTest{
+		{
+			name:    "LoadBalancerProfile is valid",
+			wantErr: "",
+		},
+
+		{
+			name: "LoadBalancerProfile.ManagedOutboundIPs is valid with 20 managed IPs",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &generated.LoadBalancerProfile{
+					ManagedOutboundIPs: &generated.ManagedOutboundIPs{
+						Count: pointerutils.ToPtr(int32(20)),
+					},
+				}
+			},
+			wantErr: "",
+		},
+		{
+			name: "LoadBalancerProfile.ManagedOutboundIPs is invalid with greater than 20 managed IPs",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &generated.LoadBalancerProfile{
+					ManagedOutboundIPs: &generated.ManagedOutboundIPs{
+						Count: pointerutils.ToPtr(int32(21)),
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.loadBalancerProfile.managedOutboundIps.count: The provided managedOutboundIps.count 21 is invalid: managedOutboundIps.count must be in the range of 1 to 20 (inclusive).",
+		},
+		{
+			name: "LoadBalancerProfile.ManagedOutboundIPs is invalid with less than 1 managed IP",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &generated.LoadBalancerProfile{
+					ManagedOutboundIPs: &generated.ManagedOutboundIPs{
+						Count: pointerutils.ToPtr(int32(0)),
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.loadBalancerProfile.managedOutboundIps.count: The provided managedOutboundIps.count 0 is invalid: managedOutboundIps.count must be in the range of 1 to 20 (inclusive).",
+		},
+	}
+
+	createTests := []*validateTest{
+		{
+			name: "LoadBalancerProfile.EffectiveOutboundIPs is read only",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &generated.LoadBalancerProfile{
+					ManagedOutboundIPs: &generated.ManagedOutboundIPs{
+						Count: pointerutils.ToPtr(int32(1)),
+					},
+					EffectiveOutboundIPs: []*generated.EffectiveOutboundIP{
+						{
+							ID: pointerutils.ToPtr("someId"),
+						},
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.loadBalancerProfile.effectiveOutboundIps: The field effectiveOutboundIps is read only.",
+		},
+	}
+
+	updateOnlyTests := []*validateTest{
+		{
+			name: "LoadBalancerProfile.ManagedOutboundIPs is invalid with multiple managed IPs and architecture v1",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &generated.LoadBalancerProfile{
+					ManagedOutboundIPs: &generated.ManagedOutboundIPs{
+						Count: pointerutils.ToPtr(int32(20)),
+					},
+				}
+			},
+			architectureVersion: (*api.ArchitectureVersion)(pointerutils.ToPtr(int(api.ArchitectureVersionV1))),
+			wantErr:             "400: InvalidParameter: properties.networkProfile.loadBalancerProfile.managedOutboundIps.count: The provided managedOutboundIps.count 20 is invalid: managedOutboundIps.count must be 1, multiple IPs are not supported for this cluster's network architecture.",
+		},
+	}
+
+	runTests(t, testModeCreate, createTests)
+	runTests(t, testModeCreate, tests)
+	runTests(t, testModeUpdate, tests)
+	runTests(t, testModeUpdate, updateOnlyTests)
+}
+
+func TestOpenShiftClusterStaticValidateMasterProfile(t *testing.T) {
+	tests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "vmSize unsupported",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.MasterProfile.VMSize = "Standard_D2s_v3"
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.vmSize: The provided VM size 'Standard_D2s_v3' is invalid for the 'master' role.",
+		},
+		{
+			name: "subnetId invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.MasterProfile.SubnetID = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.subnetId: The provided master VM subnet 'invalid' is invalid.",
+		},
+		{
+			name: "subnet subscriptionId not matching cluster subscriptionId",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.MasterProfile.SubnetID = "/subscriptions/7a3036d1-60a1-4605-8a41-44955e050804/resourcegroups/test-vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/master"
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.subnetId: The provided master VM subnet '/subscriptions/7a3036d1-60a1-4605-8a41-44955e050804/resourcegroups/test-vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/master' is invalid: must be in same subscription as cluster.",
+		},
+		{
+			name: "disk encryption set is invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MasterProfile.DiskEncryptionSetID = pointerutils.ToPtr("invalid")
+				oc.Properties.WorkerProfiles[0].DiskEncryptionSetID = pointerutils.ToPtr("invalid")
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.diskEncryptionSetId: The provided master disk encryption set 'invalid' is invalid.",
+		},
+		{
+			name: "disk encryption set not matching cluster subscriptionId",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MasterProfile.DiskEncryptionSetID = pointerutils.ToPtr("/subscriptions/7a3036d1-60a1-4605-8a41-44955e050804/resourceGroups/fakeRG/providers/Microsoft.Compute/diskEncryptionSets/fakeDES1")
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.diskEncryptionSetId: The provided master disk encryption set '/subscriptions/7a3036d1-60a1-4605-8a41-44955e050804/resourceGroups/fakeRG/providers/Microsoft.Compute/diskEncryptionSets/fakeDES1' is invalid: must be in same subscription as cluster.",
+		},
+		{
+			name: "encryption at host invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.MasterProfile.EncryptionAtHost = "Banana"
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.encryptionAtHost: The provided value 'Banana' is invalid.",
+		},
+		{
+			name: "encryption at host empty",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.MasterProfile.EncryptionAtHost = ""
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.encryptionAtHost: The provided value '' is invalid.",
+		},
+	}
+
+	createTests := []*validateTest{
+		{
+			name: "disk encryption set is valid",
+			modify: func(oc *OpenShiftCluster) {
+				desID := fmt.Sprintf("/subscriptions/%s/resourceGroups/test-cluster/providers/Microsoft.Compute/diskEncryptionSets/test-disk-encryption-set", subscriptionID)
+				oc.Properties.MasterProfile.DiskEncryptionSetID = pointerutils.ToPtr(desID)
+				oc.Properties.WorkerProfiles[0].DiskEncryptionSetID = pointerutils.ToPtr(desID)
+			},
+		},
+		{
+			name:           "vmSize invalid for version",
+			clusterVersion: pointerutils.ToPtr("4.10.0"),
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.MasterProfile.VMSize = "Standard_D8s_v6"
+			},
+			wantErr: "400: InvalidParameter: properties.masterProfile.vmSize: The provided master VM size 'Standard_D8s_v6' is invalid for the chosen OpenShift version.",
+		},
+		{
+			name:           "vmSize valid for version",
+			clusterVersion: pointerutils.ToPtr("4.20.0"),
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.MasterProfile.VMSize = "Standard_D8s_v6"
+			},
+		},
+	}
+
+	runTests(t, testModeCreate, createTests)
+	runTests(t, testModeCreate, tests)
+	runTests(t, testModeUpdate, tests)
+}
+
+func TestOpenShiftClusterStaticValidateWorkerProfile(t *testing.T) {
+	tests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "name invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].Name = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['invalid'].name: The provided worker name 'invalid' is invalid.",
+		},
+		{
+			name: "vmSize invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].VMSize = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].vmSize: The provided VM size 'invalid' is invalid for the 'worker' role.",
+		},
+		{
+			name:           "vmSize invalid for version",
+			clusterVersion: pointerutils.ToPtr("4.10.0"),
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].VMSize = "Standard_D8s_v6"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].vmSize: The provided worker VM size 'Standard_D8s_v6' is invalid for the chosen OpenShift version.",
+		},
+		{
+			name:           "vmSize valid for version",
+			clusterVersion: pointerutils.ToPtr("4.20.0"),
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].VMSize = "Standard_D8s_v6"
+			},
+		},
+		{
+			name: "vmSize too small (prod)",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].VMSize = "Standard_D2s_v3"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].vmSize: The provided VM size 'Standard_D2s_v3' is invalid for the 'worker' role.",
+		},
+		{
+			name: "vmSize too big (dev)",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].VMSize = "Standard_D4s_v3"
+			},
+			requireD2sWorkers: true,
+			wantErr:           "400: InvalidParameter: properties.workerProfiles['worker'].vmSize: The provided VM size 'Standard_D4s_v3' is invalid for the 'worker' role.",
+		},
+		{
+			name: "disk too small",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].DiskSizeGB = 127
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].diskSizeGB: The provided worker disk size '127' is invalid.",
+		},
+		{
+			name: "subnetId invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].SubnetID = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].subnetId: The provided worker VM subnet 'invalid' is invalid.",
+		},
+		{
+			name: "master and worker subnets not in same vnet",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].SubnetID = fmt.Sprintf("/subscriptions/%s/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/different-vnet/subnets/worker", subscriptionID)
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].subnetId: The provided worker VM subnet '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/different-vnet/subnets/worker' is invalid: must be in the same vnet as master VM subnet '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/master'.",
+		},
+		{
+			name: "master and worker subnets not different",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].SubnetID = *oc.Properties.MasterProfile.SubnetID
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].subnetId: The provided worker VM subnet '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/master' is invalid: must be different to master VM subnet '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/vnet/providers/Microsoft.Network/virtualNetworks/test-vnet/subnets/master'.",
+		},
+		{
+			name: "count too small",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].Count = 1
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].count: The provided worker count '1' is invalid.",
+		},
+		{
+			name: "count too big",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].Count = 51
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].count: The provided worker count '51' is invalid.",
+		},
+		{
+			name: "disk encryption set not matching master disk encryption set",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MasterProfile.DiskEncryptionSetID = pointerutils.ToPtr(fmt.Sprintf("/subscriptions/%s/resourceGroups/test-cluster/providers/Microsoft.Compute/diskEncryptionSets/test-disk-encryption-set", subscriptionID))
+				oc.Properties.WorkerProfiles[0].DiskEncryptionSetID = pointerutils.ToPtr("/subscriptions/7a3036d1-60a1-4605-8a41-44955e050804/resourceGroups/fakeRG/providers/Microsoft.Compute/diskEncryptionSets/fakeDES1")
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].subnetId: The provided worker disk encryption set '/subscriptions/7a3036d1-60a1-4605-8a41-44955e050804/resourceGroups/fakeRG/providers/Microsoft.Compute/diskEncryptionSets/fakeDES1' is invalid: must be the same as master disk encryption set '/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/test-cluster/providers/Microsoft.Compute/diskEncryptionSets/test-disk-encryption-set'.",
+		},
+		{
+			name: "encryption at host invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].EncryptionAtHost = "Banana"
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].encryptionAtHost: The provided value 'Banana' is invalid.",
+		},
+		{
+			name: "encryption at host empty",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].EncryptionAtHost = ""
+			},
+			wantErr: "400: InvalidParameter: properties.workerProfiles['worker'].encryptionAtHost: The provided value '' is invalid.",
+		},
+	}
+
+	// We do not perform this validation on update
+	runTests(t, testModeCreate, tests)
+}
+
+func TestOpenShiftClusterStaticValidateAPIServerProfile(t *testing.T) {
+	commonTests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "visibility invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ApiserverProfile.Visibility = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.apiserverProfile.visibility: The provided visibility 'invalid' is invalid.",
+		},
+		{
+			name: "url invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ApiserverProfile.URL = "\x00"
+			},
+			wantErr: "400: InvalidParameter: properties.apiserverProfile.url: The provided URL '\x00' is invalid.",
+		},
+		{
+			name: "ip invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ApiserverProfile.IP = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.apiserverProfile.ip: The provided IP 'invalid' is invalid.",
+		},
+		{
+			name: "ipv6 ip invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ApiserverProfile.IP = "::"
+			},
+			wantErr: "400: InvalidParameter: properties.apiserverProfile.ip: The provided IP '::' is invalid: must be IPv4.",
+		},
+	}
+
+	createTests := []*validateTest{
+		{
+			name: "empty url valid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ApiserverProfile.URL = ""
+			},
+		},
+		{
+			name: "empty ip valid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ApiserverProfile.IP = ""
+			},
+		},
+	}
+
+	runTests(t, testModeCreate, createTests)
+	runTests(t, testModeCreate, commonTests)
+	runTests(t, testModeUpdate, commonTests)
+}
+
+func TestOpenShiftClusterStaticValidateIngressProfile(t *testing.T) {
+	tests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name: "missing profiles invalid",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.IngressProfiles = nil
+			},
+			wantErr: "400: InvalidParameter: properties.ingressProfiles: There should be exactly one ingress profile.",
+		},
+		{
+			name: "no profiles invalid",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.IngressProfiles = []*generated.IngressProfile{}
+			},
+			wantErr: "400: InvalidParameter: properties.ingressProfiles: There should be exactly one ingress profile.",
+		},
+		{
+			name: "name invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.IngressProfiles[0].Name = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.ingressProfiles['invalid'].name: The provided ingress name 'invalid' is invalid.",
+		},
+		{
+			name: "visibility invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.IngressProfiles[0].Visibility = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.ingressProfiles['default'].visibility: The provided visibility 'invalid' is invalid.",
+		},
+		{
+			name: "ip invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.IngressProfiles[0].IP = "invalid"
+			},
+			wantErr: "400: InvalidParameter: properties.ingressProfiles['default'].ip: The provided IP 'invalid' is invalid.",
+		},
+		{
+			name: "ipv6 ip invalid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.IngressProfiles[0].IP = "::"
+			},
+			wantErr: "400: InvalidParameter: properties.ingressProfiles['default'].ip: The provided IP '::' is invalid: must be IPv4.",
+		},
+		{
+			name: "empty ip valid",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.IngressProfiles[0].IP = ""
+			},
+		},
+	}
+
+	// we don't validate this on update as all fields are immutable and will
+	// be validated with "mutable" flag
+	runTests(t, testModeCreate, tests)
+}
+
+func TestOpenShiftClusterStaticValidateMaintenanceWindow(t *testing.T) {
+	window := func() *generated.MaintenanceWindow {
+		return &generated.MaintenanceWindow{
+			DaysOfWeek:    []*string{pointerutils.ToPtr("Saturday")},
+			StartHour:     pointerutils.ToPtr(int32(22)),
+			DurationHours: pointerutils.ToPtr(int32(6)),
+			TimeZone:      pointerutils.ToPtr("Europe/London"),
+			BlackoutDates: []*string{pointerutils.ToPtr("2026-12-26")},
+		}
+	}
+
+	tests := []*validateTest{
+		{
+			name: "valid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceWindow = window()
+			},
+		},
+		{
+			name: "day of week invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceWindow = window()
+				*oc.Properties.MaintenanceWindow.DaysOfWeek[0] = "Caturday"
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceWindow: The provided maintenance window is invalid: invalid day of week 'Caturday'.",
+		},
+		{
+			name: "startHour invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceWindow = window()
+				*oc.Properties.MaintenanceWindow.StartHour = 24
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceWindow: The provided maintenance window is invalid: startHour must be between 0 and 23.",
+		},
+		{
+			name: "durationHours missing",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceWindow = window()
+				oc.Properties.MaintenanceWindow.DurationHours = nil
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceWindow: The provided maintenance window is invalid: durationHours must be between 1 and 24.",
+		},
+		{
+			name: "blackout date invalid",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceWindow = window()
+				*oc.Properties.MaintenanceWindow.BlackoutDates[0] = "26/12/2026"
+			},
+			wantErr: "400: InvalidParameter: properties.maintenanceWindow: The provided maintenance window is invalid: invalid blackout date '26/12/2026'.",
+		},
+	}
+
+	runTests(t, testModeCreate, tests)
+	runTests(t, testModeUpdate, tests)
+}
+
+func TestOpenShiftClusterStaticValidateDelta(t *testing.T) {
+	tests := []*validateTest{
+		{
+			name: "valid",
+		},
+		{
+			name:   "valid id case change",
+			modify: func(oc *OpenShiftCluster) { *oc.ID = strings.ToUpper(*oc.ID) },
+		},
+		{
+			name:   "valid name case change",
+			modify: func(oc *OpenShiftCluster) { *oc.Name = strings.ToUpper(*oc.Name) },
+		},
+		{
+			name:   "valid type case change",
+			modify: func(oc *OpenShiftCluster) { *oc.Type = strings.ToUpper(*oc.Type) },
+		},
+		{
+			name: "valid maintenanceWindow change",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.MaintenanceWindow = &generated.MaintenanceWindow{
+					StartHour:     pointerutils.ToPtr(int32(1)),
+					DurationHours: pointerutils.ToPtr(int32(2)),
+				}
+			},
+		},
+		{
+			name:    "location change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Location = strings.ToUpper(*oc.Location) },
+			wantErr: "400: PropertyChangeNotAllowed: location: Changing property 'location' is not allowed.",
+		},
+		{
+			name:   "valid tags change",
+			modify: func(oc *OpenShiftCluster) { oc.Tags = map[string]*string{"new": pointerutils.ToPtr("value")} },
+		},
+		{
+			name:    "provisioningState change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.ProvisioningState = generated.ProvisioningStateFailed },
+			wantErr: "400: PropertyChangeNotAllowed: properties.provisioningState: Changing property 'properties.provisioningState' is not allowed.",
+		},
+		{
+			name:    "console url change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.ConsoleProfile.URL = "invalid" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.consoleProfile.url: Changing property 'properties.consoleProfile.url' is not allowed.",
+		},
+		{
+			name:    "pull secret change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.ClusterProfile.PullSecret = `{"auths":{}}` },
+			wantErr: "400: PropertyChangeNotAllowed: properties.clusterProfile.pullSecret: Changing property 'properties.clusterProfile.pullSecret' is not allowed.",
+		},
+		{
+			name:    "domain change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.ClusterProfile.Domain = "invalid" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.clusterProfile.domain: Changing property 'properties.clusterProfile.domain' is not allowed.",
+		},
+		{
+			name:    "version change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.ClusterProfile.Version = "4.3.999" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.clusterProfile.version: Changing property 'properties.clusterProfile.version' is not allowed.",
+		},
+		{
+			name: "resource group change",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ClusterProfile.ResourceGroupID = (*oc.Properties.ClusterProfile.ResourceGroupID)[:strings.LastIndexByte(*oc.Properties.ClusterProfile.ResourceGroupID, '/')] + "/changed"
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.clusterProfile.resourceGroupId: Changing property 'properties.clusterProfile.resourceGroupId' is not allowed.",
+		},
+		{
+			name: "apiServer private change",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ApiserverProfile.Visibility = generated.VisibilityPrivate
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.apiserverProfile.visibility: Changing property 'properties.apiserverProfile.visibility' is not allowed.",
+		},
+		{
+			name:    "apiServer url change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.ApiserverProfile.URL = "invalid" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.apiserverProfile.url: Changing property 'properties.apiserverProfile.url' is not allowed.",
+		},
+		{
+			name:    "apiServer ip change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.ApiserverProfile.IP = "2.3.4.5" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.apiserverProfile.ip: Changing property 'properties.apiserverProfile.ip' is not allowed.",
+		},
+		{
+			name: "ingress private change",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.IngressProfiles[0].Visibility = generated.VisibilityPrivate
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.ingressProfiles['default'].visibility: Changing property 'properties.ingressProfiles['default'].visibility' is not allowed.",
+		},
+		{
+			name:    "ingress ip change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.IngressProfiles[0].IP = "2.3.4.5" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.ingressProfiles['default'].ip: Changing property 'properties.ingressProfiles['default'].ip' is not allowed.",
+		},
+		{
+			name: "clientId change",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.ServicePrincipalProfile.ClientID = uuid.DefaultGenerator.Generate()
+			},
+		},
+		{
+			name:   "clientSecret change",
+			modify: func(oc *OpenShiftCluster) { *oc.Properties.ServicePrincipalProfile.ClientSecret = "invalid" },
+		},
+		{
+			name:    "podCidr change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.NetworkProfile.PodCidr = "10.0.0.0/8" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.networkProfile.podCidr: Changing property 'properties.networkProfile.podCidr' is not allowed.",
+		},
+		{
+			name:    "serviceCidr change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.NetworkProfile.ServiceCidr = "10.0.0.0/8" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.networkProfile.serviceCidr: Changing property 'properties.networkProfile.serviceCidr' is not allowed.",
+		},
+		{
+			name: "outboundType change",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.NetworkProfile.OutboundType = generated.OutboundTypeUserDefinedRouting
+			},
+			wantErr: "400: InvalidParameter: properties.networkProfile.outboundType: The provided outboundType 'UserDefinedRouting' is invalid: cannot use UserDefinedRouting if either API Server Visibility or Ingress Visibility is public.",
+		},
+		{
+			name: "master subnetId change",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.MasterProfile.SubnetID = (*oc.Properties.MasterProfile.SubnetID)[:strings.LastIndexByte(*oc.Properties.MasterProfile.SubnetID, '/')] + "/changed"
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.masterProfile.subnetId: Changing property 'properties.masterProfile.subnetId' is not allowed.",
+		},
+		{
+			name:    "worker name change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.WorkerProfiles[0].Name = "new-name" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles['new-name'].name: Changing property 'properties.workerProfiles['new-name'].name' is not allowed.",
+		},
+		{
+			name:    "worker vmSize change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.WorkerProfiles[0].VMSize = "Standard_D8s_v3" },
+			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles['worker'].vmSize: Changing property 'properties.workerProfiles['worker'].vmSize' is not allowed.",
+		},
+		{
+			name:    "worker diskSizeGB change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.WorkerProfiles[0].DiskSizeGB++ },
+			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles['worker'].diskSizeGB: Changing property 'properties.workerProfiles['worker'].diskSizeGB' is not allowed.",
+		},
+		{
+			name: "worker subnetId change",
+			modify: func(oc *OpenShiftCluster) {
+				*oc.Properties.WorkerProfiles[0].SubnetID = (*oc.Properties.WorkerProfiles[0].SubnetID)[:strings.LastIndexByte(*oc.Properties.WorkerProfiles[0].SubnetID, '/')] + "/changed"
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles['worker'].subnetId: Changing property 'properties.workerProfiles['worker'].subnetId' is not allowed.",
+		},
+		{
+			name:    "workerProfiles count change",
+			modify:  func(oc *OpenShiftCluster) { *oc.Properties.WorkerProfiles[0].Count++ },
+			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles['worker'].count: Changing property 'properties.workerProfiles['worker'].count' is not allowed.",
+		},
+		{
+			name: "number of workerProfiles changes",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles = []*generated.WorkerProfile{{}, {}}
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles: Changing property 'properties.workerProfiles' is not allowed.",
+		},
+		{
+			name: "workerProfiles set to nil",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.WorkerProfiles = nil
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.workerProfiles: Changing property 'properties.workerProfiles' is not allowed.",
+		},
+		{
+			name: "systemData set to empty",
+			modify: func(oc *OpenShiftCluster) {
+				oc.SystemData = &generated.SystemData{}
+			},
+			wantErr: "400: PropertyChangeNotAllowed: systemData: Changing property 'systemData' is not allowed.",
+		},
+		{
+			name: "systemData LastUpdated changed",
+			modify: func(oc *OpenShiftCluster) {
+				oc.SystemData = &generated.SystemData{}
+				oc.SystemData.LastModifiedBy = pointerutils.ToPtr("Bob")
+			},
+			wantErr: "400: PropertyChangeNotAllowed: systemData: Changing property 'systemData' is not allowed.",
+		},
+		{
+			name: "update LoadBalancerProfile.ManagedOutboundIPs.Count",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &generated.LoadBalancerProfile{
+					ManagedOutboundIPs: &generated.ManagedOutboundIPs{
+						Count: pointerutils.ToPtr(int32(5)),
+					},
+				}
+			},
+			wantErr: "",
+		},
+		{
+			name: "update LoadBalancerProfile.EffectiveOutboundIPs",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile.EffectiveOutboundIPs = []*generated.EffectiveOutboundIP{
+					{ID: pointerutils.ToPtr("resourceId")},
+				}
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.NetworkProfile.LoadBalancerProfile = &generated.LoadBalancerProfile{
+					ManagedOutboundIPs: &generated.ManagedOutboundIPs{
+						Count: pointerutils.ToPtr(int32(5)),
+					},
+					EffectiveOutboundIPs: []*generated.EffectiveOutboundIP{
+						{
+							ID: pointerutils.ToPtr("BadResourceId"),
+						},
+					},
+				}
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.networkProfile.loadBalancerProfile.effectiveOutboundIps: Changing property 'properties.networkProfile.loadBalancerProfile.effectiveOutboundIps' is not allowed.",
+		},
+	}
+
+	runTests(t, testModeUpdate, tests)
+}
+
+func TestOpenShiftClusterStaticValidatePlatformWorkloadIdentityProfile(t *testing.T) {
+	validUpgradeableToValue := string("4.14.29")
+	invalidUpgradeableToValue := string("16.107.invalid")
+
+	createTests := []*validateTest{
+		{
+			name: "valid empty workloadIdentityProfile",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = nil
+			},
+		},
+		{
+			name: "valid workloadIdentityProfile",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"name": platformIdentity1,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": {
+							ClientID:    pointerutils.ToPtr("11111111-1111-1111-1111-111111111111"),
+							PrincipalID: pointerutils.ToPtr("SOMETHING"),
+						},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+		},
+		{
+			name: "invalid resourceID",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": {
+							ClientID:    pointerutils.ToPtr("11111111-1111-1111-1111-111111111111"),
+							PrincipalID: pointerutils.ToPtr("SOMETHING"),
+						},
+					},
+				}
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR": {
+							ResourceID: pointerutils.ToPtr("BAD"),
+						},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			wantErr: "400: InvalidParameter: properties.platformWorkloadIdentityProfile.PlatformWorkloadIdentities[FAKE-OPERATOR].resourceID: ResourceID BAD formatted incorrectly.",
+		},
+		{
+			name: "wrong resource type",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR": {
+							ResourceID: pointerutils.ToPtr("/subscriptions/12345678-1234-1234-1234-123456789012/resourceGroups/a-fake-group/providers/Microsoft.RedHatOpenShift/otherThing/fake-cluster-name"),
+						},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.platformWorkloadIdentityProfile.PlatformWorkloadIdentities[FAKE-OPERATOR].resourceID: Resource must be a user assigned identity.",
+		},
+		{
+			name: "no credentials with identities",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"name": platformIdentity1,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = &generated.ServicePrincipalProfile{
+					ClientID:     pointerutils.ToPtr("11111111-1111-1111-1111-111111111111"),
+					ClientSecret: pointerutils.ToPtr("BAD"),
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.servicePrincipalProfile: Cannot use identities and service principal credentials at the same time.",
+		},
+		{
+			name: "cluster identity missing platform workload identity",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+			},
+			wantErr: "400: InvalidParameter: identity: Cluster identity and platform workload identities require each other.",
+		},
+		{
+			name: "platform workload identity missing cluster identity",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"operator_name": {},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			wantErr: "400: InvalidParameter: identity: Cluster identity and platform workload identities require each other.",
+		},
+		{
+			name: "platform workload identity - cluster identity map is empty",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"operator_name": {},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+				oc.Identity = &generated.ManagedServiceIdentity{}
+			},
+			wantErr: "400: InvalidParameter: identity: The provided cluster identity is invalid; there should be exactly one.",
+		},
+		{
+			name: "operator name missing",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"": {
+							ResourceID: pointerutils.ToPtr("/subscriptions/12345678-1234-1234-1234-123456789012/resourceGroups/a-fake-group/providers/Microsoft.RedHatOpenShift/userAssignedIdentities/fake-cluster-name"),
+						},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			wantErr: "400: InvalidParameter: properties.platformWorkloadIdentityProfile.PlatformWorkloadIdentities[].resourceID: Operator name is empty.",
+		},
+		{
+			name: "identity and service principal missing",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = nil
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			wantErr: "400: InvalidParameter: properties.servicePrincipalProfile: Must provide either an identity or service principal credentials.",
+		},
+		{
+			name: "duplicate operator identities",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR":         platformIdentity1,
+						"ANOTHER-FAKE-OPERATOR": platformIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			wantErr: "400: InvalidParameter: properties.platformWorkloadIdentityProfile.PlatformWorkloadIdentities: ResourceID /subscriptions/12345678-1234-1234-1234-123456789012/resourcegroups/a-fake-group/providers/microsoft.redhatopenshift/userassignedidentities/fake-cluster-name used by multiple identities.",
+		},
+		{
+			name: "duplicate operator identities, different cases",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR": platformIdentity1,
+						"ANOTHER-FAKE-OPERATOR": {
+							ResourceID: pointerutils.ToPtr("/subscriptions/12345678-1234-1234-1234-123456789012/resourceGroups/a-fake-group/providers/Microsoft.RedHatOpenShift/userAssignedIdentities/FAKE-CLUSTER-NAME"),
+						},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			wantErr: "400: InvalidParameter: properties.platformWorkloadIdentityProfile.PlatformWorkloadIdentities: ResourceID /subscriptions/12345678-1234-1234-1234-123456789012/resourcegroups/a-fake-group/providers/microsoft.redhatopenshift/userassignedidentities/fake-cluster-name used by multiple identities.",
+		},
+		{
+			name: "valid UpgradeableTo value",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"Dummy": {},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR": platformIdentity1,
+					},
+					UpgradeableTo: &validUpgradeableToValue,
+				}
+			},
+		},
+		{
+			name: "invalid UpgradeableTo value",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"Dummy": {},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR": platformIdentity1,
+					},
+					UpgradeableTo: &invalidUpgradeableToValue,
+				}
+			},
+			wantErr: `400: InvalidParameter: properties.platformWorkloadIdentityProfile.UpgradeableTo[16.107.invalid]: UpgradeableTo must be a valid OpenShift version in the format 'x.y.z'.`,
+		},
+		{
+			name: "No platform identities provided in PlatformWorkloadIdentityProfile - nil",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"Dummy": {},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					UpgradeableTo: &invalidUpgradeableToValue,
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.platformWorkloadIdentityProfile.platformWorkloadIdentities: The set of platform workload identities cannot be empty.",
+		},
+		{
+			name: "No platform identities provided in PlatformWorkloadIdentityProfile - empty map",
+			modify: func(oc *OpenShiftCluster) {
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"Dummy": {},
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{},
+					UpgradeableTo:              &invalidUpgradeableToValue,
+				}
+			},
+			wantErr: "400: InvalidParameter: properties.platformWorkloadIdentityProfile.platformWorkloadIdentities: The set of platform workload identities cannot be empty.",
+		},
+	}
+
+	updateTests := []*validateTest{
+		{
+			name: "addition of operator identity",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR": platformIdentity1,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile.PlatformWorkloadIdentities["ANOTHER-FAKE-OPERATOR"] = platformIdentity2
+			},
+		},
+		{
+			name: "invalid change of operator identity name",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR": platformIdentity1,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			modify: func(oc *OpenShiftCluster) {
+				pwi := map[string]*generated.PlatformWorkloadIdentity{
+					"FAKE-OPERATOR-OTHER": platformIdentity1,
+				}
+				oc.Properties.PlatformWorkloadIdentityProfile.PlatformWorkloadIdentities = pwi
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.platformWorkloadIdentityProfile.platformWorkloadIdentities: Operator identity cannot be removed or have its name changed.",
+		},
+		{
+			name: "valid change of operator identity resource ID",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR": platformIdentity1,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile.PlatformWorkloadIdentities["FAKE-OPERATOR"] = platformIdentity2
+			},
+		},
+		{
+			name: "change of operator identity order",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"OPERATOR-1": platformIdentity1,
+						"OPERATOR-2": platformIdentity2,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile.PlatformWorkloadIdentities = map[string]*generated.PlatformWorkloadIdentity{
+					"OPERATOR-1": platformIdentity1,
+					"OPERATOR-2": platformIdentity2,
+				}
+			},
+		},
+		{
+			name: "invalid change of operator identity name and resource ID",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"FAKE-OPERATOR": platformIdentity1,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			modify: func(oc *OpenShiftCluster) {
+				pwi := map[string]*generated.PlatformWorkloadIdentity{
+					"FAKE-OPERATOR-OTHER": platformIdentity2,
+				}
+				oc.Properties.PlatformWorkloadIdentityProfile.PlatformWorkloadIdentities = pwi
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.platformWorkloadIdentityProfile.platformWorkloadIdentities: Operator identity cannot be removed or have its name changed.",
+		},
+		{
+			name: "invalid removal of identity",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"operator1": platformIdentity1,
+						"operator2": platformIdentity2,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile.PlatformWorkloadIdentities = map[string]*generated.PlatformWorkloadIdentity{
+					"operator1": platformIdentity1,
+				}
+			},
+			wantErr: "400: PropertyChangeNotAllowed: properties.platformWorkloadIdentityProfile.platformWorkloadIdentities: Operator identity cannot be removed or have its name changed.",
+		},
+		{
+			name: "No platform identities provided in PlatformWorkloadIdentityProfile - empty map",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"operator1": platformIdentity1,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile.PlatformWorkloadIdentities = map[string]*generated.PlatformWorkloadIdentity{}
+			},
+			wantErr: "400: InvalidParameter: properties.platformWorkloadIdentityProfile.platformWorkloadIdentities: The set of platform workload identities cannot be empty.",
+		},
+		{
+			name: "No platform identities provided in PlatformWorkloadIdentityProfile - nil",
+			current: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile = &generated.PlatformWorkloadIdentityProfile{
+					PlatformWorkloadIdentities: map[string]*generated.PlatformWorkloadIdentity{
+						"operator1": platformIdentity1,
+					},
+				}
+				oc.Identity = &generated.ManagedServiceIdentity{
+					UserAssignedIdentities: map[string]*generated.UserAssignedIdentity{
+						"first": clusterIdentity1,
+					},
+				}
+				oc.Properties.ServicePrincipalProfile = nil
+			},
+			modify: func(oc *OpenShiftCluster) {
+				oc.Properties.PlatformWorkloadIdentityProfile.PlatformWorkloadIdentities = nil
+			},
+			wantErr: "400: InvalidParameter: properties.platformWorkloadIdentityProfile.platformWorkloadIdentities: The set of platform workload identities cannot be empty.",
+		},
+	}
+
+	runTests(t, testModeCreate, createTests)
+	runTests(t, testModeUpdate, updateTests)
+}
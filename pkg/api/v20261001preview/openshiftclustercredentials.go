@@ -0,0 +1,13 @@
+package v20261001preview
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/Azure/ARO-RP/pkg/api/v20261001preview/generated"
+)
+
+// OpenShiftClusterCredentials represents an OpenShift cluster's credentials.
+type OpenShiftClusterCredentials struct {
+	generated.OpenShiftClusterCredentials
+}
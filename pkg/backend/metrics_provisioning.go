@@ -9,6 +9,15 @@ import (
 // Copyright (c) Microsoft Corporation.
 // Licensed under the Apache License 2.0.
 
+// provisioningDurationBuckets are histogram buckets, in milliseconds, for the
+// time from a request being accepted to its provisioning state changing, which
+// ranges from seconds for deletes to hours for installs
+var provisioningDurationBuckets = []float64{
+	10e3, 30e3, // 10s, 30s
+	60e3, 300e3, 600e3, 1200e3, 1800e3, 2700e3, // 1m, 5m, 10m, 20m, 30m, 45m
+	3600e3, 5400e3, 7200e3, // 1h, 1.5h, 2h
+}
+
 func (ocb *openShiftClusterBackend) emitProvisioningMetrics(doc *api.OpenShiftClusterDocument, provisioningState api.ProvisioningState) {
 	if doc.CorrelationData == nil {
 		return
@@ -16,13 +25,16 @@ func (ocb *openShiftClusterBackend) emitProvisioningMetrics(doc *api.OpenShiftCl
 
 	duration := time.Since(doc.CorrelationData.RequestTime).Milliseconds()
 
-	ocb.m.EmitGauge("backend.openshiftcluster.duration", duration, map[string]string{
+	dims := map[string]string{
 		"oldProvisioningState": string(doc.OpenShiftCluster.Properties.ProvisioningState),
 		"newProvisioningState": string(provisioningState),
-	})
+	}
 
-	ocb.m.EmitGauge("backend.openshiftcluster.count", 1, map[string]string{
-		"oldProvisioningState": string(doc.OpenShiftCluster.Properties.ProvisioningState),
-		"newProvisioningState": string(provisioningState),
-	})
+	// backend.openshiftcluster.duration and backend.openshiftcluster.count are
+	// kept as gauges for the existing Geneva dashboards and alerts
+	ocb.m.EmitGauge("backend.openshiftcluster.duration", duration, dims)
+	ocb.m.EmitGauge("backend.openshiftcluster.count", 1, dims)
+
+	ocb.m.EmitHistogram("backend.openshiftcluster.transition.duration.milliseconds", float64(duration), provisioningDurationBuckets, dims)
+	ocb.m.EmitCounter("backend.openshiftcluster.transitions.total", 1, dims)
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/hive"
 	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/otel/tracing"
 	"github.com/Azure/ARO-RP/pkg/util/billing"
 	"github.com/Azure/ARO-RP/pkg/util/encryption"
 	utillog "github.com/Azure/ARO-RP/pkg/util/log"
@@ -92,10 +93,18 @@ func (ocb *openShiftClusterBackend) try(ctx context.Context, monitorDeleteWaitTi
 }
 
 // handle is responsible for handling backend operation and lease
-func (ocb *openShiftClusterBackend) handle(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument, monitorDeleteWaitTimeSec int) error {
+func (ocb *openShiftClusterBackend) handle(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument, monitorDeleteWaitTimeSec int) (err error) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	ctx = tracing.ContextWithAttributes(ctx, tracing.AttributeResourceID.String(doc.OpenShiftCluster.ID))
+	if doc.CorrelationData != nil {
+		ctx = tracing.ContextWithAttributes(ctx, tracing.AttributeCorrelationID.String(doc.CorrelationData.CorrelationID))
+	}
+	ctx, span := tracing.Start(ctx, "backend.openshiftcluster.handle",
+		tracing.AttributeOperation.String(string(doc.OpenShiftCluster.Properties.ProvisioningState)))
+	defer func() { tracing.End(span, err) }()
+
 	stop := ocb.heartbeat(ctx, cancel, log, doc)
 	defer stop()
 
@@ -423,6 +432,7 @@ func (ocb *openShiftClusterBackend) asyncOperationResultLog(log *logrus.Entry, d
 func (ocb *openShiftClusterBackend) setNoMaintenanceState(ctx context.Context, doc *api.OpenShiftClusterDocument) (*api.OpenShiftClusterDocument, error) {
 	return ocb.dbOpenShiftClusters.Patch(ctx, doc.Key, func(doc *api.OpenShiftClusterDocument) error {
 		doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStateNone
+		doc.OpenShiftCluster.Properties.MaintenanceStateSetBySchedule = false
 		return nil
 	})
 }
@@ -55,8 +55,8 @@ func TestAdminUpdateSteps(t *testing.T) {
 
 	generalFixesSteps := []string{
 		"[Action ensureResourceGroup]",
-		"[Action createOrUpdateDenyAssignment]",
-		"[Action ensureServiceEndpoints]",
+		"[RetryingAction createOrUpdateDenyAssignment]",
+		"[RetryingAction ensureServiceEndpoints]",
 		"[Action populateRegistryStorageAccountName]",
 		"[Action migrateStorageAccounts]",
 		"[Action fixSSH]",
@@ -92,6 +92,7 @@ func TestAdminUpdateSteps(t *testing.T) {
 	operatorUpdateSteps := []string{
 		"[Action startVMs]",
 		"[Condition apiServersReady, timeout 30m0s]",
+		"[Action ensureEtcdBackupEncryptionKey]",
 		"[Action initializeOperatorDeployer]",
 		"[Action ensureAROOperator]",
 		"[Condition aroDeploymentReady, timeout 20m0s]",
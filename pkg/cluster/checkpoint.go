@@ -0,0 +1,62 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// stepCheckpoint implements steps.Checkpoint by recording progress in the
+// cluster document. A checkpoint is only honoured for the asynchronous
+// operation and operation type which recorded it.
+type stepCheckpoint struct {
+	m         *manager
+	operation string
+}
+
+func (m *manager) newStepCheckpoint(operation string) *stepCheckpoint {
+	return &stepCheckpoint{
+		m:         m,
+		operation: operation,
+	}
+}
+
+func (c *stepCheckpoint) LastCompletedStep() string {
+	cp := c.m.doc.StepCheckpoint
+	if cp == nil ||
+		cp.AsyncOperationID != c.m.doc.AsyncOperationID ||
+		cp.Operation != c.operation {
+		return ""
+	}
+	return cp.LastCompletedStep
+}
+
+func (c *stepCheckpoint) SetLastCompletedStep(ctx context.Context, name string) error {
+	var err error
+	c.m.doc, err = c.m.db.PatchWithLease(ctx, c.m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.StepCheckpoint = &api.StepCheckpoint{
+			AsyncOperationID:  doc.AsyncOperationID,
+			Operation:         c.operation,
+			LastCompletedStep: name,
+		}
+		return nil
+	})
+	return err
+}
+
+// clear removes the checkpoint once the operation has run to completion.
+func (c *stepCheckpoint) clear(ctx context.Context) error {
+	if c.m.doc.StepCheckpoint == nil {
+		return nil
+	}
+
+	var err error
+	c.m.doc, err = c.m.db.PatchWithLease(ctx, c.m.doc.Key, func(doc *api.OpenShiftClusterDocument) error {
+		doc.StepCheckpoint = nil
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,163 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+type checkpointTestSteps struct {
+	ran []string
+}
+
+func (s *checkpointTestSteps) first(context.Context) error {
+	s.ran = append(s.ran, "first")
+	return nil
+}
+
+func (s *checkpointTestSteps) second(context.Context) error {
+	s.ran = append(s.ran, "second")
+	return nil
+}
+
+func (s *checkpointTestSteps) fails(context.Context) error {
+	s.ran = append(s.ran, "fails")
+	return failingFunc(context.Background())
+}
+
+func TestRunResumableSteps(t *testing.T) {
+	const key = "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName1"
+
+	for _, tt := range []struct {
+		name        string
+		checkpoint  *api.StepCheckpoint
+		withFailure bool
+		wantRan     []string
+		wantErr     string
+	}{
+		{
+			name:    "no checkpoint runs all steps and clears checkpoint",
+			wantRan: []string{"first", "second"},
+		},
+		{
+			name: "matching checkpoint resumes after last completed step",
+			checkpoint: &api.StepCheckpoint{
+				AsyncOperationID:  "operation",
+				Operation:         "adminUpdate",
+				LastCompletedStep: "action.first",
+			},
+			wantRan: []string{"second"},
+		},
+		{
+			name: "checkpoint for a different operation is ignored",
+			checkpoint: &api.StepCheckpoint{
+				AsyncOperationID:  "previous-operation",
+				Operation:         "adminUpdate",
+				LastCompletedStep: "action.first",
+			},
+			wantRan: []string{"first", "second"},
+		},
+		{
+			name: "checkpoint for a different operation type is ignored",
+			checkpoint: &api.StepCheckpoint{
+				AsyncOperationID:  "operation",
+				Operation:         "update",
+				LastCompletedStep: "action.first",
+			},
+			wantRan: []string{"first", "second"},
+		},
+		{
+			name:        "failed step clears checkpoint",
+			withFailure: true,
+			wantRan:     []string{"first", "fails"},
+			wantErr:     "oh no!",
+		},
+		{
+			name: "failed step after resuming clears checkpoint",
+			checkpoint: &api.StepCheckpoint{
+				AsyncOperationID:  "operation",
+				Operation:         "adminUpdate",
+				LastCompletedStep: "action.first",
+			},
+			withFailure: true,
+			wantRan:     []string{"fails"},
+			wantErr:     "oh no!",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			_, log := testlog.New()
+			controller := gomock.NewController(t)
+
+			mockEnv := mock_env.NewMockInterface(controller)
+			mockEnv.EXPECT().Now().AnyTimes().DoAndReturn(time.Now)
+
+			openShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key:              strings.ToLower(key),
+				AsyncOperationID: "operation",
+				StepCheckpoint:   tt.checkpoint,
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: key,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: api.ProvisioningStateAdminUpdating,
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := openShiftClustersDatabase.Dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := &manager{
+				env:            mockEnv,
+				log:            log,
+				metricsEmitter: newfakeMetricsEmitter(),
+				doc:            doc,
+				db:             openShiftClustersDatabase,
+			}
+
+			s := &checkpointTestSteps{}
+			toRun := []steps.Step{steps.Action(s.first)}
+			if tt.withFailure {
+				toRun = append(toRun, steps.Action(s.fails))
+			}
+			toRun = append(toRun, steps.Action(s.second))
+
+			err = m.runResumableSteps(ctx, toRun, "adminUpdate")
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			for _, diff := range deep.Equal(s.ran, tt.wantRan) {
+				t.Errorf("steps run: %s", diff)
+			}
+
+			updated, err := openShiftClustersDatabase.Get(ctx, strings.ToLower(key))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if updated.StepCheckpoint != nil {
+				t.Errorf("checkpoint was not cleared: %#v", updated.StepCheckpoint)
+			}
+		})
+	}
+}
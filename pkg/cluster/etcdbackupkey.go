@@ -0,0 +1,67 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rand"
+
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	pkgoperator "github.com/Azure/ARO-RP/pkg/operator"
+)
+
+// etcdBackupEncryptionKeySize is the size of the AES-256 key the storage
+// service encrypts the backups with
+const etcdBackupEncryptionKeySize = 32
+
+// mutateEtcdBackupEncryptionKey records key as the key etcd backups are
+// encrypted with, or a new one if key is not valid, unless the cluster
+// document already has one
+func mutateEtcdBackupEncryptionKey(key []byte) func(*api.OpenShiftClusterDocument) error {
+	return func(doc *api.OpenShiftClusterDocument) error {
+		if len(doc.OpenShiftCluster.Properties.EtcdBackupEncryptionKey) != 0 {
+			return nil
+		}
+
+		if len(key) != etcdBackupEncryptionKeySize {
+			key = make([]byte, etcdBackupEncryptionKeySize)
+			_, err := rand.Read(key)
+			if err != nil {
+				return err
+			}
+		}
+
+		doc.OpenShiftCluster.Properties.EtcdBackupEncryptionKey = key
+
+		return nil
+	}
+}
+
+// ensureEtcdBackupEncryptionKey generates the key etcd backups are encrypted
+// with. It must run before the operator deployer is initialised, which deploys
+// the key to the cluster.
+func (m *manager) ensureEtcdBackupEncryptionKey(ctx context.Context) error {
+	if len(m.doc.OpenShiftCluster.Properties.EtcdBackupEncryptionKey) != 0 {
+		return nil
+	}
+
+	// operators which predate the key being kept in the cluster document
+	// generated it in the cluster, so keep it to be able to decrypt the
+	// backups they uploaded
+	var key []byte
+	secret, err := m.kubernetescli.CoreV1().Secrets(pkgoperator.Namespace).Get(ctx, pkgoperator.EtcdBackupKeySecretName, metav1.GetOptions{})
+	if err == nil {
+		key = secret.Data[pkgoperator.EtcdBackupKeySecretKey]
+	} else if !kerrors.IsNotFound(err) {
+		return err
+	}
+
+	updatedDoc, err := m.db.PatchWithLease(ctx, m.doc.Key, mutateEtcdBackupEncryptionKey(key))
+	m.doc = updatedDoc
+
+	return err
+}
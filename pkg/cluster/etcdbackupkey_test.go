@@ -0,0 +1,119 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	pkgoperator "github.com/Azure/ARO-RP/pkg/operator"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestEnsureEtcdBackupEncryptionKey(t *testing.T) {
+	ctx := context.Background()
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroup/providers/microsoft.redhatopenshift/openshiftclusters/resourceName"
+
+	docKey := []byte("0123456789abcdef0123456789abcdef")
+	clusterKey := []byte("fedcba9876543210fedcba9876543210")
+
+	clusterSecret := func(key []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: pkgoperator.Namespace, Name: pkgoperator.EtcdBackupKeySecretName},
+			Data:       map[string][]byte{pkgoperator.EtcdBackupKeySecretKey: key},
+		}
+	}
+
+	for _, tt := range []struct {
+		name       string
+		key        api.SecureBytes
+		objects    []kruntime.Object
+		wantKey    []byte
+		wantNewKey bool
+	}{
+		{
+			name:    "keeps the key in the cluster document",
+			key:     docKey,
+			objects: []kruntime.Object{clusterSecret(clusterKey)},
+			wantKey: docKey,
+		},
+		{
+			name:    "adopts the key an older operator generated",
+			objects: []kruntime.Object{clusterSecret(clusterKey)},
+			wantKey: clusterKey,
+		},
+		{
+			name:       "generates a key when the cluster's is invalid",
+			objects:    []kruntime.Object{clusterSecret([]byte("short"))},
+			wantNewKey: true,
+		},
+		{
+			name:       "generates a key",
+			wantNewKey: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeOpenShiftClustersDatabase, _ := testdatabase.NewFakeOpenShiftClusters()
+			fixture := testdatabase.NewFixture().WithOpenShiftClusters(fakeOpenShiftClustersDatabase)
+			fixture.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+				Key: strings.ToLower(resourceID),
+				OpenShiftCluster: &api.OpenShiftCluster{
+					ID: resourceID,
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState:       api.ProvisioningStateAdminUpdating,
+						EtcdBackupEncryptionKey: tt.key,
+					},
+				},
+			})
+			err := fixture.Create()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			clusterdoc, err := fakeOpenShiftClustersDatabase.Dequeue(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			m := &manager{
+				log:           logrus.NewEntry(logrus.StandardLogger()),
+				doc:           clusterdoc,
+				db:            fakeOpenShiftClustersDatabase,
+				kubernetescli: fake.NewSimpleClientset(tt.objects...),
+			}
+
+			err = m.ensureEtcdBackupEncryptionKey(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			doc, err := fakeOpenShiftClustersDatabase.Get(ctx, strings.ToLower(resourceID))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := doc.OpenShiftCluster.Properties.EtcdBackupEncryptionKey
+			if !bytes.Equal(got, m.doc.OpenShiftCluster.Properties.EtcdBackupEncryptionKey) {
+				t.Error("manager's document was not updated")
+			}
+
+			if tt.wantNewKey {
+				if len(got) != etcdBackupEncryptionKeySize || bytes.Equal(got, clusterKey) || bytes.Equal(got, docKey) {
+					t.Errorf("got key %q", got)
+				}
+			} else if !bytes.Equal(got, tt.wantKey) {
+				t.Errorf("got key %q, wanted %q", got, tt.wantKey)
+			}
+		})
+	}
+}
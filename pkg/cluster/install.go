@@ -44,7 +44,18 @@ const (
 // AdminUpdate performs an admin update of an ARO cluster
 func (m *manager) AdminUpdate(ctx context.Context) error {
 	toRun := m.adminUpdate()
-	return m.runSteps(ctx, toRun, "adminUpdate")
+	return m.runResumableSteps(ctx, toRun, "adminUpdate")
+}
+
+// AdminUpdateSteps returns the steps which AdminUpdate would run for the given
+// cluster document, without running them. The returned steps are bound to an
+// uninitialised manager and must only be inspected, never run.
+func AdminUpdateSteps(doc *api.OpenShiftClusterDocument, adoptViaHive bool) []steps.Step {
+	m := &manager{
+		doc:          doc,
+		adoptViaHive: adoptViaHive,
+	}
+	return m.adminUpdate()
 }
 
 func (m *manager) adminUpdate() []steps.Step {
@@ -83,8 +94,8 @@ func (m *manager) adminUpdate() []steps.Step {
 
 func (m *manager) getZerothSteps() []steps.Step {
 	bootstrap := []steps.Step{
-		steps.Action(m.initializeKubernetesClients), // must be first
-		steps.Action(m.ensureBillingRecord),         // belt and braces
+		steps.AlwaysRun(steps.Action(m.initializeKubernetesClients)), // must be first
+		steps.Action(m.ensureBillingRecord),                          // belt and braces
 		steps.Action(m.ensureDefaults),
 	}
 
@@ -94,9 +105,9 @@ func (m *manager) getZerothSteps() []steps.Step {
 		managedIdentitySteps := []steps.Step{
 			steps.Action(m.fixupClusterMsiTenantID),
 			steps.Action(m.ensureClusterMsiCertificate),
-			steps.Action(m.initializeClusterMsiClients),
+			steps.AlwaysRun(steps.Action(m.initializeClusterMsiClients)),
 			steps.AuthorizationRetryingAction(m.fpAuthorizer, m.clusterIdentityIDs, m.managedResourceGroupName()),
-			steps.AuthorizationRetryingAction(m.fpAuthorizer, m.platformWorkloadIdentityIDs, m.managedResourceGroupName()),
+			steps.AlwaysRun(steps.AuthorizationRetryingAction(m.fpAuthorizer, m.platformWorkloadIdentityIDs, m.managedResourceGroupName())),
 			steps.AuthorizationRetryingAction(m.fpAuthorizer, m.persistPlatformWorkloadIdentityIDs, m.managedResourceGroupName()),
 		}
 
@@ -115,16 +126,16 @@ func (m *manager) getZerothSteps() []steps.Step {
 
 func (m *manager) getEnsureAPIServerReadySteps() []steps.Step {
 	return []steps.Step{
-		steps.Action(m.startVMs),
-		steps.Condition(m.apiServersReady, 30*time.Minute, true),
+		steps.AlwaysRun(steps.Action(m.startVMs)),
+		steps.AlwaysRun(steps.Condition(m.apiServersReady, 30*time.Minute, true)),
 	}
 }
 
 func (m *manager) getGeneralFixesSteps() []steps.Step {
 	stepsThatDontNeedAPIServer := []steps.Step{
 		steps.Action(m.ensureResourceGroup), // re-create RP RBAC if needed after tenant migration
-		steps.Action(m.createOrUpdateDenyAssignment),
-		steps.Action(m.ensureServiceEndpoints),
+		steps.RetryingAction(steps.DefaultRetryPolicy, m.metricsEmitter, m.createOrUpdateDenyAssignment),
+		steps.RetryingAction(steps.DefaultRetryPolicy, m.metricsEmitter, m.ensureServiceEndpoints),
 		steps.Action(m.populateRegistryStorageAccountName), // must go before migrateStorageAccounts
 		steps.Action(m.migrateStorageAccounts),
 		steps.Action(m.fixSSH),
@@ -157,7 +168,7 @@ func (m *manager) getCertificateRenewalSteps() []steps.Step {
 		steps.Action(m.configureAPIServerCertificate),
 		steps.Action(m.configureIngressCertificate),
 
-		steps.Action(m.initializeOperatorDeployer),
+		steps.AlwaysRun(steps.Action(m.initializeOperatorDeployer)),
 	}
 
 	if m.doc.OpenShiftCluster.UsesWorkloadIdentity() {
@@ -171,7 +182,10 @@ func (m *manager) getCertificateRenewalSteps() []steps.Step {
 
 func (m *manager) getOperatorUpdateSteps() []steps.Step {
 	steps := []steps.Step{
-		steps.Action(m.initializeOperatorDeployer),
+		// The operator deployer deploys the etcd backup encryption key from
+		// the cluster document, so it must be there first.
+		steps.Action(m.ensureEtcdBackupEncryptionKey),
+		steps.AlwaysRun(steps.Action(m.initializeOperatorDeployer)),
 
 		steps.Action(m.ensureAROOperator),
 
@@ -194,7 +208,7 @@ func (m *manager) getOperatorUpdateSteps() []steps.Step {
 
 func (m *manager) getSyncClusterObjectSteps() []steps.Step {
 	steps := []steps.Step{
-		steps.Action(m.initializeOperatorDeployer),
+		steps.AlwaysRun(steps.Action(m.initializeOperatorDeployer)),
 		steps.Action(m.syncClusterObject),
 	}
 	return utilgenerics.ConcatMultipleSlices(m.getEnsureAPIServerReadySteps(), steps)
@@ -243,8 +257,8 @@ func (m *manager) Update(ctx context.Context) error {
 			// in the cluster doc for MSI stuff to work.
 			steps.Action(m.fixupClusterMsiTenantID),
 			steps.Action(m.ensureClusterMsiCertificate),
-			steps.Action(m.initializeClusterMsiClients),
-			steps.Action(m.platformWorkloadIdentityIDs),
+			steps.AlwaysRun(steps.Action(m.initializeClusterMsiClients)),
+			steps.AlwaysRun(steps.Action(m.platformWorkloadIdentityIDs)),
 		)
 	}
 
@@ -268,15 +282,14 @@ func (m *manager) Update(ctx context.Context) error {
 	}
 
 	s = append(s,
-		steps.Action(m.initializeKubernetesClients),
-		steps.Action(m.initializeOperatorDeployer), // depends on kube clients
+		steps.AlwaysRun(steps.Action(m.initializeKubernetesClients)),
+		steps.AlwaysRun(steps.Action(m.initializeOperatorDeployer)), // depends on kube clients
 		steps.Action(m.createOrUpdateDenyAssignment),
-		steps.Action(m.startVMs),
-		steps.Condition(m.apiServersReady, 30*time.Minute, true),
+		steps.AlwaysRun(steps.Action(m.startVMs)),
+		steps.AlwaysRun(steps.Condition(m.apiServersReady, 30*time.Minute, true)),
 		steps.Action(m.rotateACRTokenPassword),
 		steps.Action(m.correctCertificateIssuer),
-		steps.Action(m.configureAPIServerCertificate),
-		steps.Action(m.configureIngressCertificate),
+		steps.Action(m.configureCertificates),
 		steps.Action(m.fixUserAdminKubeconfig),
 		steps.Action(m.reconcileLoadBalancerProfile),
 		steps.Action(m.reconcileSoftwareDefinedNetwork),
@@ -308,7 +321,7 @@ func (m *manager) Update(ctx context.Context) error {
 		)
 	}
 
-	return m.runSteps(ctx, s, "update")
+	return m.runResumableSteps(ctx, s, "update")
 }
 
 func (m *manager) runPodmanInstaller(ctx context.Context) error {
@@ -476,8 +489,9 @@ func (m *manager) bootstrap() []steps.Step {
 	s = append(s,
 		steps.Action(m.ensureBillingRecord),
 		steps.Action(m.initializeKubernetesClients),
-		steps.Action(m.initializeOperatorDeployer), // depends on kube clients
 		steps.Condition(m.apiServersReady, 30*time.Minute, true),
+		steps.Action(m.ensureEtcdBackupEncryptionKey),
+		steps.Action(m.initializeOperatorDeployer), // depends on kube clients and the etcd backup encryption key
 		steps.Action(m.installAROOperator),
 		steps.Action(m.enableOperatorReconciliation),
 		steps.Action(m.incrInstallPhase),
@@ -543,12 +557,43 @@ func (m *manager) managedResourceGroupName() string {
 }
 
 func (m *manager) runSteps(ctx context.Context, s []steps.Step, metricsTopic string) error {
+	return m.runStepsWithCheckpoint(ctx, s, metricsTopic, nil)
+}
+
+// runResumableSteps runs the given steps, recording progress in the cluster
+// document so that a later attempt at the same asynchronous operation resumes
+// after the last completed step rather than starting over. The checkpoint is
+// only kept if the run is interrupted: once the steps have either completed
+// or failed, it is cleared so that a later run starts from the beginning.
+func (m *manager) runResumableSteps(ctx context.Context, s []steps.Step, metricsTopic string) error {
+	checkpoint := m.newStepCheckpoint(metricsTopic)
+
+	err := m.runStepsWithCheckpoint(ctx, s, metricsTopic, checkpoint)
+	if err != nil {
+		clearErr := checkpoint.clear(ctx)
+		if clearErr != nil {
+			m.log.Errorf("failed to clear step checkpoint: %s", clearErr)
+		}
+		return err
+	}
+
+	return checkpoint.clear(ctx)
+}
+
+func (m *manager) runStepsWithCheckpoint(ctx context.Context, s []steps.Step, metricsTopic string, checkpoint steps.Checkpoint) error {
 	managedRGName := m.managedResourceGroupName()
 
+	run := func(now func() time.Time) (map[string]int64, error) {
+		if checkpoint != nil {
+			return steps.RunWithCheckpoint(ctx, m.log, 10*time.Second, s, now, managedRGName, checkpoint)
+		}
+		return steps.Run(ctx, m.log, 10*time.Second, s, now, managedRGName)
+	}
+
 	var err error
 	if metricsTopic != "" {
 		var stepsTimeRun map[string]int64
-		stepsTimeRun, err = steps.Run(ctx, m.log, 10*time.Second, s, m.env.Now, managedRGName)
+		stepsTimeRun, err = run(m.env.Now)
 		if err == nil {
 			var totalInstallTime int64
 			for stepName, duration := range stepsTimeRun {
@@ -561,7 +606,7 @@ func (m *manager) runSteps(ctx context.Context, s []steps.Step, metricsTopic str
 			m.metricsEmitter.EmitGauge(metricName, totalInstallTime, nil)
 		}
 	} else {
-		_, err = steps.Run(ctx, m.log, 10*time.Second, s, nil, managedRGName)
+		_, err = run(nil)
 	}
 	if err != nil {
 		m.gatherFailureLogs(ctx, metricsTopic)
@@ -57,6 +57,12 @@ func (e *fakeMetricsEmitter) EmitGauge(metricName string, metricValue int64, dim
 func (e *fakeMetricsEmitter) EmitFloat(metricName string, metricValue float64, dimensions map[string]string) {
 }
 
+func (e *fakeMetricsEmitter) EmitCounter(metricName string, metricValue int64, dimensions map[string]string) {
+}
+
+func (e *fakeMetricsEmitter) EmitHistogram(metricName string, metricValue float64, buckets []float64, dimensions map[string]string) {
+}
+
 var clusterOperator = &configv1.ClusterOperator{
 	ObjectMeta: metav1.ObjectMeta{
 		Name: "operator",
@@ -18,6 +18,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/azuresdk/azcertificates"
 	"github.com/Azure/ARO-RP/pkg/util/dns"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
 )
 
 const (
@@ -120,6 +121,19 @@ func (m *manager) configureAPIServerCertificate(ctx context.Context) error {
 	})
 }
 
+// configureCertificates configures the API server and ingress certificates
+// concurrently, as neither depends on the other.
+func (m *manager) configureCertificates(ctx context.Context) error {
+	g := steps.NewGraph().
+		Add("apiserver", steps.Action(m.configureAPIServerCertificate)).
+		Add("ingress", steps.Action(m.configureIngressCertificate))
+
+	// The enclosing step runner converts the returned error, so it is not
+	// converted here as well.
+	_, err := steps.RunGraph(ctx, m.log, g, 2, nil, "")
+	return err
+}
+
 func (m *manager) configureIngressCertificate(ctx context.Context) error {
 	if m.env.FeatureIsSet(env.FeatureDisableSignedCertificates) {
 		return nil
@@ -0,0 +1,74 @@
+package database
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+type clusterHealthSnapshots struct {
+	c cosmosdb.ClusterHealthSnapshotDocumentClient
+}
+
+// ClusterHealthSnapshots stores the latest health snapshot of each cluster,
+// keyed by the ID of the cluster's OpenShiftClusterDocument
+type ClusterHealthSnapshots interface {
+	Get(context.Context, string) (*api.ClusterHealthSnapshotDocument, error)
+	Put(context.Context, *api.ClusterHealthSnapshotDocument) (*api.ClusterHealthSnapshotDocument, error)
+	Delete(context.Context, string) error
+}
+
+func NewClusterHealthSnapshots(ctx context.Context, dbc cosmosdb.DatabaseClient, dbName string) (ClusterHealthSnapshots, error) {
+	collc := cosmosdb.NewCollectionClient(dbc, dbName)
+
+	documentClient := cosmosdb.NewClusterHealthSnapshotDocumentClient(collc, collClusterHealthSnapshots)
+	return NewClusterHealthSnapshotsWithProvidedClient(documentClient), nil
+}
+
+func NewClusterHealthSnapshotsWithProvidedClient(client cosmosdb.ClusterHealthSnapshotDocumentClient) ClusterHealthSnapshots {
+	return &clusterHealthSnapshots{
+		c: client,
+	}
+}
+
+func (c *clusterHealthSnapshots) Get(ctx context.Context, id string) (*api.ClusterHealthSnapshotDocument, error) {
+	if id != strings.ToLower(id) {
+		return nil, fmt.Errorf("id %q is not lower case", id)
+	}
+
+	return c.c.Get(ctx, id, id, nil)
+}
+
+// Put replaces the snapshot with the ID of doc, creating it if it does not
+// exist. The snapshot is overwritten regardless of its ETag, as only the
+// latest snapshot is of interest.
+func (c *clusterHealthSnapshots) Put(ctx context.Context, doc *api.ClusterHealthSnapshotDocument) (*api.ClusterHealthSnapshotDocument, error) {
+	if doc.ID != strings.ToLower(doc.ID) {
+		return nil, fmt.Errorf("id %q is not lower case", doc.ID)
+	}
+
+	newDoc, err := c.c.Replace(ctx, doc.ID, doc, &cosmosdb.Options{NoETag: true})
+	if cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+		newDoc, err = c.c.Create(ctx, doc.ID, doc, nil)
+		if cosmosdb.IsErrorStatusCode(err, http.StatusConflict) { // someone else got there first
+			newDoc, err = c.c.Replace(ctx, doc.ID, doc, &cosmosdb.Options{NoETag: true})
+		}
+	}
+
+	return newDoc, err
+}
+
+func (c *clusterHealthSnapshots) Delete(ctx context.Context, id string) error {
+	if id != strings.ToLower(id) {
+		return fmt.Errorf("id %q is not lower case", id)
+	}
+
+	return c.c.Delete(ctx, id, &api.ClusterHealthSnapshotDocument{ID: id}, &cosmosdb.Options{NoETag: true})
+}
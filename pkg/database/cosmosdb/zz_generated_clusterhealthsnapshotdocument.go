@@ -0,0 +1,313 @@
+// Code generated by github.com/bennerv/go-cosmosdb, DO NOT EDIT.
+
+package cosmosdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	pkg "github.com/Azure/ARO-RP/pkg/api"
+)
+
+type clusterHealthSnapshotDocumentClient struct {
+	*databaseClient
+	path string
+}
+
+// ClusterHealthSnapshotDocumentClient is a clusterHealthSnapshotDocument client
+type ClusterHealthSnapshotDocumentClient interface {
+	Create(context.Context, string, *pkg.ClusterHealthSnapshotDocument, *Options) (*pkg.ClusterHealthSnapshotDocument, error)
+	List(*Options) ClusterHealthSnapshotDocumentIterator
+	ListAll(context.Context, *Options) (*pkg.ClusterHealthSnapshotDocuments, error)
+	Get(context.Context, string, string, *Options) (*pkg.ClusterHealthSnapshotDocument, error)
+	Replace(context.Context, string, *pkg.ClusterHealthSnapshotDocument, *Options) (*pkg.ClusterHealthSnapshotDocument, error)
+	Delete(context.Context, string, *pkg.ClusterHealthSnapshotDocument, *Options) error
+	Query(string, *Query, *Options) ClusterHealthSnapshotDocumentRawIterator
+	QueryAll(context.Context, string, *Query, *Options) (*pkg.ClusterHealthSnapshotDocuments, error)
+	ChangeFeed(*Options) ClusterHealthSnapshotDocumentIterator
+}
+
+type clusterHealthSnapshotDocumentChangeFeedIterator struct {
+	*clusterHealthSnapshotDocumentClient
+	continuation string
+	options      *Options
+}
+
+type clusterHealthSnapshotDocumentListIterator struct {
+	*clusterHealthSnapshotDocumentClient
+	continuation string
+	done         bool
+	options      *Options
+}
+
+type clusterHealthSnapshotDocumentQueryIterator struct {
+	*clusterHealthSnapshotDocumentClient
+	partitionkey string
+	query        *Query
+	continuation string
+	done         bool
+	options      *Options
+}
+
+// ClusterHealthSnapshotDocumentIterator is a clusterHealthSnapshotDocument iterator
+type ClusterHealthSnapshotDocumentIterator interface {
+	Next(context.Context, int) (*pkg.ClusterHealthSnapshotDocuments, error)
+	Continuation() string
+}
+
+// ClusterHealthSnapshotDocumentRawIterator is a clusterHealthSnapshotDocument raw iterator
+type ClusterHealthSnapshotDocumentRawIterator interface {
+	ClusterHealthSnapshotDocumentIterator
+	NextRaw(context.Context, int, interface{}) error
+}
+
+// NewClusterHealthSnapshotDocumentClient returns a new clusterHealthSnapshotDocument client
+func NewClusterHealthSnapshotDocumentClient(collc CollectionClient, collid string) ClusterHealthSnapshotDocumentClient {
+	return &clusterHealthSnapshotDocumentClient{
+		databaseClient: collc.(*collectionClient).databaseClient,
+		path:           collc.(*collectionClient).path + "/colls/" + collid,
+	}
+}
+
+func (c *clusterHealthSnapshotDocumentClient) all(ctx context.Context, i ClusterHealthSnapshotDocumentIterator) (*pkg.ClusterHealthSnapshotDocuments, error) {
+	allclusterHealthSnapshotDocuments := &pkg.ClusterHealthSnapshotDocuments{}
+
+	for {
+		clusterHealthSnapshotDocuments, err := i.Next(ctx, -1)
+		if err != nil {
+			return nil, err
+		}
+		if clusterHealthSnapshotDocuments == nil {
+			break
+		}
+
+		allclusterHealthSnapshotDocuments.Count += clusterHealthSnapshotDocuments.Count
+		allclusterHealthSnapshotDocuments.ResourceID = clusterHealthSnapshotDocuments.ResourceID
+		allclusterHealthSnapshotDocuments.ClusterHealthSnapshotDocuments = append(allclusterHealthSnapshotDocuments.ClusterHealthSnapshotDocuments, clusterHealthSnapshotDocuments.ClusterHealthSnapshotDocuments...)
+	}
+
+	return allclusterHealthSnapshotDocuments, nil
+}
+
+func (c *clusterHealthSnapshotDocumentClient) Create(ctx context.Context, partitionkey string, newclusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, options *Options) (clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, err error) {
+	headers := http.Header{}
+	headers.Set("X-Ms-Documentdb-Partitionkey", `["`+partitionkey+`"]`)
+
+	if options == nil {
+		options = &Options{}
+	}
+	options.NoETag = true
+
+	err = c.setOptions(options, newclusterHealthSnapshotDocument, headers)
+	if err != nil {
+		return
+	}
+
+	err = c.do(ctx, http.MethodPost, c.path+"/docs", "docs", c.path, http.StatusCreated, &newclusterHealthSnapshotDocument, &clusterHealthSnapshotDocument, headers)
+	return
+}
+
+func (c *clusterHealthSnapshotDocumentClient) List(options *Options) ClusterHealthSnapshotDocumentIterator {
+	continuation := ""
+	if options != nil {
+		continuation = options.Continuation
+	}
+
+	return &clusterHealthSnapshotDocumentListIterator{clusterHealthSnapshotDocumentClient: c, options: options, continuation: continuation}
+}
+
+func (c *clusterHealthSnapshotDocumentClient) ListAll(ctx context.Context, options *Options) (*pkg.ClusterHealthSnapshotDocuments, error) {
+	return c.all(ctx, c.List(options))
+}
+
+func (c *clusterHealthSnapshotDocumentClient) Get(ctx context.Context, partitionkey, clusterHealthSnapshotDocumentid string, options *Options) (clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, err error) {
+	headers := http.Header{}
+	headers.Set("X-Ms-Documentdb-Partitionkey", `["`+partitionkey+`"]`)
+
+	err = c.setOptions(options, nil, headers)
+	if err != nil {
+		return
+	}
+
+	err = c.do(ctx, http.MethodGet, c.path+"/docs/"+clusterHealthSnapshotDocumentid, "docs", c.path+"/docs/"+clusterHealthSnapshotDocumentid, http.StatusOK, nil, &clusterHealthSnapshotDocument, headers)
+	return
+}
+
+func (c *clusterHealthSnapshotDocumentClient) Replace(ctx context.Context, partitionkey string, newclusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, options *Options) (clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, err error) {
+	headers := http.Header{}
+	headers.Set("X-Ms-Documentdb-Partitionkey", `["`+partitionkey+`"]`)
+
+	err = c.setOptions(options, newclusterHealthSnapshotDocument, headers)
+	if err != nil {
+		return
+	}
+
+	err = c.do(ctx, http.MethodPut, c.path+"/docs/"+newclusterHealthSnapshotDocument.ID, "docs", c.path+"/docs/"+newclusterHealthSnapshotDocument.ID, http.StatusOK, &newclusterHealthSnapshotDocument, &clusterHealthSnapshotDocument, headers)
+	return
+}
+
+func (c *clusterHealthSnapshotDocumentClient) Delete(ctx context.Context, partitionkey string, clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, options *Options) (err error) {
+	headers := http.Header{}
+	headers.Set("X-Ms-Documentdb-Partitionkey", `["`+partitionkey+`"]`)
+
+	err = c.setOptions(options, clusterHealthSnapshotDocument, headers)
+	if err != nil {
+		return
+	}
+
+	err = c.do(ctx, http.MethodDelete, c.path+"/docs/"+clusterHealthSnapshotDocument.ID, "docs", c.path+"/docs/"+clusterHealthSnapshotDocument.ID, http.StatusNoContent, nil, nil, headers)
+	return
+}
+
+func (c *clusterHealthSnapshotDocumentClient) Query(partitionkey string, query *Query, options *Options) ClusterHealthSnapshotDocumentRawIterator {
+	continuation := ""
+	if options != nil {
+		continuation = options.Continuation
+	}
+
+	return &clusterHealthSnapshotDocumentQueryIterator{clusterHealthSnapshotDocumentClient: c, partitionkey: partitionkey, query: query, options: options, continuation: continuation}
+}
+
+func (c *clusterHealthSnapshotDocumentClient) QueryAll(ctx context.Context, partitionkey string, query *Query, options *Options) (*pkg.ClusterHealthSnapshotDocuments, error) {
+	return c.all(ctx, c.Query(partitionkey, query, options))
+}
+
+func (c *clusterHealthSnapshotDocumentClient) ChangeFeed(options *Options) ClusterHealthSnapshotDocumentIterator {
+	continuation := ""
+	if options != nil {
+		continuation = options.Continuation
+	}
+
+	return &clusterHealthSnapshotDocumentChangeFeedIterator{clusterHealthSnapshotDocumentClient: c, options: options, continuation: continuation}
+}
+
+func (c *clusterHealthSnapshotDocumentClient) setOptions(options *Options, clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, headers http.Header) error {
+	if options == nil {
+		return nil
+	}
+
+	if clusterHealthSnapshotDocument != nil && !options.NoETag {
+		if clusterHealthSnapshotDocument.ETag == "" {
+			return ErrETagRequired
+		}
+		headers.Set("If-Match", clusterHealthSnapshotDocument.ETag)
+	}
+	if len(options.PreTriggers) > 0 {
+		headers.Set("X-Ms-Documentdb-Pre-Trigger-Include", strings.Join(options.PreTriggers, ","))
+	}
+	if len(options.PostTriggers) > 0 {
+		headers.Set("X-Ms-Documentdb-Post-Trigger-Include", strings.Join(options.PostTriggers, ","))
+	}
+	if len(options.PartitionKeyRangeID) > 0 {
+		headers.Set("X-Ms-Documentdb-PartitionKeyRangeID", options.PartitionKeyRangeID)
+	}
+
+	return nil
+}
+
+func (i *clusterHealthSnapshotDocumentChangeFeedIterator) Next(ctx context.Context, maxItemCount int) (clusterHealthSnapshotDocuments *pkg.ClusterHealthSnapshotDocuments, err error) {
+	headers := http.Header{}
+	headers.Set("A-IM", "Incremental feed")
+
+	headers.Set("X-Ms-Max-Item-Count", strconv.Itoa(maxItemCount))
+	if i.continuation != "" {
+		headers.Set("If-None-Match", i.continuation)
+	}
+
+	err = i.setOptions(i.options, nil, headers)
+	if err != nil {
+		return
+	}
+
+	err = i.do(ctx, http.MethodGet, i.path+"/docs", "docs", i.path, http.StatusOK, nil, &clusterHealthSnapshotDocuments, headers)
+	if IsErrorStatusCode(err, http.StatusNotModified) {
+		err = nil
+	}
+	if err != nil {
+		return
+	}
+
+	i.continuation = headers.Get("Etag")
+
+	return
+}
+
+func (i *clusterHealthSnapshotDocumentChangeFeedIterator) Continuation() string {
+	return i.continuation
+}
+
+func (i *clusterHealthSnapshotDocumentListIterator) Next(ctx context.Context, maxItemCount int) (clusterHealthSnapshotDocuments *pkg.ClusterHealthSnapshotDocuments, err error) {
+	if i.done {
+		return
+	}
+
+	headers := http.Header{}
+	headers.Set("X-Ms-Max-Item-Count", strconv.Itoa(maxItemCount))
+	if i.continuation != "" {
+		headers.Set("X-Ms-Continuation", i.continuation)
+	}
+
+	err = i.setOptions(i.options, nil, headers)
+	if err != nil {
+		return
+	}
+
+	err = i.do(ctx, http.MethodGet, i.path+"/docs", "docs", i.path, http.StatusOK, nil, &clusterHealthSnapshotDocuments, headers)
+	if err != nil {
+		return
+	}
+
+	i.continuation = headers.Get("X-Ms-Continuation")
+	i.done = i.continuation == ""
+
+	return
+}
+
+func (i *clusterHealthSnapshotDocumentListIterator) Continuation() string {
+	return i.continuation
+}
+
+func (i *clusterHealthSnapshotDocumentQueryIterator) Next(ctx context.Context, maxItemCount int) (clusterHealthSnapshotDocuments *pkg.ClusterHealthSnapshotDocuments, err error) {
+	err = i.NextRaw(ctx, maxItemCount, &clusterHealthSnapshotDocuments)
+	return
+}
+
+func (i *clusterHealthSnapshotDocumentQueryIterator) NextRaw(ctx context.Context, maxItemCount int, raw interface{}) (err error) {
+	if i.done {
+		return
+	}
+
+	headers := http.Header{}
+	headers.Set("X-Ms-Max-Item-Count", strconv.Itoa(maxItemCount))
+	headers.Set("X-Ms-Documentdb-Isquery", "True")
+	headers.Set("Content-Type", "application/query+json")
+	if i.partitionkey != "" {
+		headers.Set("X-Ms-Documentdb-Partitionkey", `["`+i.partitionkey+`"]`)
+	} else {
+		headers.Set("X-Ms-Documentdb-Query-Enablecrosspartition", "True")
+	}
+	if i.continuation != "" {
+		headers.Set("X-Ms-Continuation", i.continuation)
+	}
+
+	err = i.setOptions(i.options, nil, headers)
+	if err != nil {
+		return
+	}
+
+	err = i.do(ctx, http.MethodPost, i.path+"/docs", "docs", i.path, http.StatusOK, &i.query, &raw, headers)
+	if err != nil {
+		return
+	}
+
+	i.continuation = headers.Get("X-Ms-Continuation")
+	i.done = i.continuation == ""
+
+	return
+}
+
+func (i *clusterHealthSnapshotDocumentQueryIterator) Continuation() string {
+	return i.continuation
+}
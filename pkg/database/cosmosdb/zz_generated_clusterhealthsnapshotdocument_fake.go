@@ -0,0 +1,389 @@
+// Code generated by github.com/bennerv/go-cosmosdb, DO NOT EDIT.
+
+package cosmosdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ugorji/go/codec"
+
+	pkg "github.com/Azure/ARO-RP/pkg/api"
+)
+
+type (
+	fakeClusterHealthSnapshotDocumentTriggerHandler func(context.Context, *pkg.ClusterHealthSnapshotDocument) error
+	fakeClusterHealthSnapshotDocumentQueryHandler   func(ClusterHealthSnapshotDocumentClient, *Query, *Options) ClusterHealthSnapshotDocumentRawIterator
+)
+
+var _ ClusterHealthSnapshotDocumentClient = &FakeClusterHealthSnapshotDocumentClient{}
+
+// NewFakeClusterHealthSnapshotDocumentClient returns a FakeClusterHealthSnapshotDocumentClient
+func NewFakeClusterHealthSnapshotDocumentClient(h *codec.JsonHandle) *FakeClusterHealthSnapshotDocumentClient {
+	return &FakeClusterHealthSnapshotDocumentClient{
+		jsonHandle:                     h,
+		clusterHealthSnapshotDocuments: make(map[string]*pkg.ClusterHealthSnapshotDocument),
+		triggerHandlers:                make(map[string]fakeClusterHealthSnapshotDocumentTriggerHandler),
+		queryHandlers:                  make(map[string]fakeClusterHealthSnapshotDocumentQueryHandler),
+	}
+}
+
+// FakeClusterHealthSnapshotDocumentClient is a FakeClusterHealthSnapshotDocumentClient
+type FakeClusterHealthSnapshotDocumentClient struct {
+	lock                           sync.RWMutex
+	jsonHandle                     *codec.JsonHandle
+	clusterHealthSnapshotDocuments map[string]*pkg.ClusterHealthSnapshotDocument
+	triggerHandlers                map[string]fakeClusterHealthSnapshotDocumentTriggerHandler
+	queryHandlers                  map[string]fakeClusterHealthSnapshotDocumentQueryHandler
+	sorter                         func([]*pkg.ClusterHealthSnapshotDocument)
+	etag                           int
+	changeFeedIterators            []*fakeClusterHealthSnapshotDocumentIterator
+
+	// returns true if documents conflict
+	conflictChecker func(*pkg.ClusterHealthSnapshotDocument, *pkg.ClusterHealthSnapshotDocument) bool
+
+	// err, if not nil, is an error to return when attempting to communicate
+	// with this Client
+	err error
+}
+
+// SetError sets or unsets an error that will be returned on any
+// FakeClusterHealthSnapshotDocumentClient method invocation
+func (c *FakeClusterHealthSnapshotDocumentClient) SetError(err error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.err = err
+}
+
+// SetSorter sets or unsets a sorter function which will be used to sort values
+// returned by List() for test stability
+func (c *FakeClusterHealthSnapshotDocumentClient) SetSorter(sorter func([]*pkg.ClusterHealthSnapshotDocument)) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.sorter = sorter
+}
+
+// SetConflictChecker sets or unsets a function which can be used to validate
+// additional unique keys in a ClusterHealthSnapshotDocument
+func (c *FakeClusterHealthSnapshotDocumentClient) SetConflictChecker(conflictChecker func(*pkg.ClusterHealthSnapshotDocument, *pkg.ClusterHealthSnapshotDocument) bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.conflictChecker = conflictChecker
+}
+
+// SetTriggerHandler sets or unsets a trigger handler
+func (c *FakeClusterHealthSnapshotDocumentClient) SetTriggerHandler(triggerName string, trigger fakeClusterHealthSnapshotDocumentTriggerHandler) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.triggerHandlers[triggerName] = trigger
+}
+
+// SetQueryHandler sets or unsets a query handler
+func (c *FakeClusterHealthSnapshotDocumentClient) SetQueryHandler(queryName string, query fakeClusterHealthSnapshotDocumentQueryHandler) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.queryHandlers[queryName] = query
+}
+
+func (c *FakeClusterHealthSnapshotDocumentClient) deepCopy(clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument) (*pkg.ClusterHealthSnapshotDocument, error) {
+	var b []byte
+	err := codec.NewEncoderBytes(&b, c.jsonHandle).Encode(clusterHealthSnapshotDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterHealthSnapshotDocument = nil
+	err = codec.NewDecoderBytes(b, c.jsonHandle).Decode(&clusterHealthSnapshotDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterHealthSnapshotDocument, nil
+}
+
+func (c *FakeClusterHealthSnapshotDocumentClient) apply(ctx context.Context, partitionkey string, clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, options *Options, isCreate bool) (*pkg.ClusterHealthSnapshotDocument, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	clusterHealthSnapshotDocument, err := c.deepCopy(clusterHealthSnapshotDocument) // copy now because pretriggers can mutate clusterHealthSnapshotDocument
+	if err != nil {
+		return nil, err
+	}
+
+	if options != nil {
+		err := c.processPreTriggers(ctx, clusterHealthSnapshotDocument, options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	existingClusterHealthSnapshotDocument, exists := c.clusterHealthSnapshotDocuments[clusterHealthSnapshotDocument.ID]
+	if isCreate && exists {
+		return nil, &Error{
+			StatusCode: http.StatusConflict,
+			Message:    "Entity with the specified id already exists in the system",
+		}
+	}
+	if !isCreate {
+		if !exists {
+			return nil, &Error{StatusCode: http.StatusNotFound}
+		}
+
+		if (options == nil || !options.NoETag) && clusterHealthSnapshotDocument.ETag != existingClusterHealthSnapshotDocument.ETag {
+			return nil, &Error{StatusCode: http.StatusPreconditionFailed}
+		}
+	}
+
+	if c.conflictChecker != nil {
+		for _, clusterHealthSnapshotDocumentToCheck := range c.clusterHealthSnapshotDocuments {
+			if c.conflictChecker(clusterHealthSnapshotDocumentToCheck, clusterHealthSnapshotDocument) {
+				return nil, &Error{
+					StatusCode: http.StatusConflict,
+					Message:    "Entity with the specified id already exists in the system",
+				}
+			}
+		}
+	}
+
+	clusterHealthSnapshotDocument.ETag = fmt.Sprint(c.etag)
+	c.etag++
+
+	c.clusterHealthSnapshotDocuments[clusterHealthSnapshotDocument.ID] = clusterHealthSnapshotDocument
+
+	if err = c.updateChangeFeeds(clusterHealthSnapshotDocument); err != nil {
+		return nil, err
+	}
+
+	return c.deepCopy(clusterHealthSnapshotDocument)
+}
+
+// Create creates a ClusterHealthSnapshotDocument in the database
+func (c *FakeClusterHealthSnapshotDocumentClient) Create(ctx context.Context, partitionkey string, clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, options *Options) (*pkg.ClusterHealthSnapshotDocument, error) {
+	return c.apply(ctx, partitionkey, clusterHealthSnapshotDocument, options, true)
+}
+
+// Replace replaces a ClusterHealthSnapshotDocument in the database
+func (c *FakeClusterHealthSnapshotDocumentClient) Replace(ctx context.Context, partitionkey string, clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, options *Options) (*pkg.ClusterHealthSnapshotDocument, error) {
+	return c.apply(ctx, partitionkey, clusterHealthSnapshotDocument, options, false)
+}
+
+// List returns a ClusterHealthSnapshotDocumentIterator to list all ClusterHealthSnapshotDocuments in the database
+func (c *FakeClusterHealthSnapshotDocumentClient) List(*Options) ClusterHealthSnapshotDocumentIterator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return NewFakeClusterHealthSnapshotDocumentErroringRawIterator(c.err)
+	}
+
+	clusterHealthSnapshotDocuments := make([]*pkg.ClusterHealthSnapshotDocument, 0, len(c.clusterHealthSnapshotDocuments))
+	for _, clusterHealthSnapshotDocument := range c.clusterHealthSnapshotDocuments {
+		clusterHealthSnapshotDocument, err := c.deepCopy(clusterHealthSnapshotDocument)
+		if err != nil {
+			return NewFakeClusterHealthSnapshotDocumentErroringRawIterator(err)
+		}
+		clusterHealthSnapshotDocuments = append(clusterHealthSnapshotDocuments, clusterHealthSnapshotDocument)
+	}
+
+	if c.sorter != nil {
+		c.sorter(clusterHealthSnapshotDocuments)
+	}
+
+	return NewFakeClusterHealthSnapshotDocumentIterator(clusterHealthSnapshotDocuments, 0)
+}
+
+// ListAll lists all ClusterHealthSnapshotDocuments in the database
+func (c *FakeClusterHealthSnapshotDocumentClient) ListAll(ctx context.Context, options *Options) (*pkg.ClusterHealthSnapshotDocuments, error) {
+	iter := c.List(options)
+	return iter.Next(ctx, -1)
+}
+
+// Get gets a ClusterHealthSnapshotDocument from the database
+func (c *FakeClusterHealthSnapshotDocumentClient) Get(ctx context.Context, partitionkey string, id string, options *Options) (*pkg.ClusterHealthSnapshotDocument, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	clusterHealthSnapshotDocument, exists := c.clusterHealthSnapshotDocuments[id]
+	if !exists {
+		return nil, &Error{StatusCode: http.StatusNotFound}
+	}
+
+	return c.deepCopy(clusterHealthSnapshotDocument)
+}
+
+// Delete deletes a ClusterHealthSnapshotDocument from the database
+func (c *FakeClusterHealthSnapshotDocumentClient) Delete(ctx context.Context, partitionKey string, clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, options *Options) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.err != nil {
+		return c.err
+	}
+
+	_, exists := c.clusterHealthSnapshotDocuments[clusterHealthSnapshotDocument.ID]
+	if !exists {
+		return &Error{StatusCode: http.StatusNotFound}
+	}
+
+	delete(c.clusterHealthSnapshotDocuments, clusterHealthSnapshotDocument.ID)
+	return nil
+}
+
+// ChangeFeed is a basic implementation of cosmosDB Changefeeds. Compared to the real changefeeds, its implementation is much more simplistic:
+// - Deleting a ClusterHealthSnapshotDocument does not remove it from the existing change feeds
+// - when a ClusterHealthSnapshotDocument is pushed into the changefeed, older versions that have not been retrieved won't be removed, meaning there's no guarantee that a clusterHealthSnapshotDocument from the changefeed is actually the most recent version.
+func (c *FakeClusterHealthSnapshotDocumentClient) ChangeFeed(*Options) ClusterHealthSnapshotDocumentIterator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return NewFakeClusterHealthSnapshotDocumentErroringRawIterator(c.err)
+	}
+
+	newIter, ok := c.List(nil).(*fakeClusterHealthSnapshotDocumentIterator)
+	if !ok {
+		return NewFakeClusterHealthSnapshotDocumentErroringRawIterator(fmt.Errorf("internal error"))
+	}
+
+	c.changeFeedIterators = append(c.changeFeedIterators, newIter)
+	return newIter
+}
+
+func (c *FakeClusterHealthSnapshotDocumentClient) updateChangeFeeds(clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument) error {
+	for _, currentIterator := range c.changeFeedIterators {
+		newTpl, err := c.deepCopy(clusterHealthSnapshotDocument)
+		if err != nil {
+			return err
+		}
+
+		currentIterator.clusterHealthSnapshotDocuments = append(currentIterator.clusterHealthSnapshotDocuments, newTpl)
+		currentIterator.done = false
+	}
+	return nil
+}
+
+func (c *FakeClusterHealthSnapshotDocumentClient) processPreTriggers(ctx context.Context, clusterHealthSnapshotDocument *pkg.ClusterHealthSnapshotDocument, options *Options) error {
+	for _, triggerName := range options.PreTriggers {
+		if triggerHandler := c.triggerHandlers[triggerName]; triggerHandler != nil {
+			c.lock.Unlock()
+			err := triggerHandler(ctx, clusterHealthSnapshotDocument)
+			c.lock.Lock()
+			if err != nil {
+				return err
+			}
+		} else {
+			return ErrNotImplemented
+		}
+	}
+
+	return nil
+}
+
+// Query calls a query handler to implement database querying
+func (c *FakeClusterHealthSnapshotDocumentClient) Query(name string, query *Query, options *Options) ClusterHealthSnapshotDocumentRawIterator {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	if c.err != nil {
+		return NewFakeClusterHealthSnapshotDocumentErroringRawIterator(c.err)
+	}
+
+	if queryHandler := c.queryHandlers[query.Query]; queryHandler != nil {
+		c.lock.RUnlock()
+		i := queryHandler(c, query, options)
+		c.lock.RLock()
+		return i
+	}
+
+	return NewFakeClusterHealthSnapshotDocumentErroringRawIterator(ErrNotImplemented)
+}
+
+// QueryAll calls a query handler to implement database querying
+func (c *FakeClusterHealthSnapshotDocumentClient) QueryAll(ctx context.Context, partitionkey string, query *Query, options *Options) (*pkg.ClusterHealthSnapshotDocuments, error) {
+	iter := c.Query("", query, options)
+	return iter.Next(ctx, -1)
+}
+
+func NewFakeClusterHealthSnapshotDocumentIterator(clusterHealthSnapshotDocuments []*pkg.ClusterHealthSnapshotDocument, continuation int) ClusterHealthSnapshotDocumentRawIterator {
+	return &fakeClusterHealthSnapshotDocumentIterator{clusterHealthSnapshotDocuments: clusterHealthSnapshotDocuments, continuation: continuation}
+}
+
+type fakeClusterHealthSnapshotDocumentIterator struct {
+	clusterHealthSnapshotDocuments []*pkg.ClusterHealthSnapshotDocument
+	continuation                   int
+	done                           bool
+}
+
+func (i *fakeClusterHealthSnapshotDocumentIterator) NextRaw(ctx context.Context, maxItemCount int, out interface{}) error {
+	return ErrNotImplemented
+}
+
+func (i *fakeClusterHealthSnapshotDocumentIterator) Next(ctx context.Context, maxItemCount int) (*pkg.ClusterHealthSnapshotDocuments, error) {
+	if i.done {
+		return nil, nil
+	}
+
+	var clusterHealthSnapshotDocuments []*pkg.ClusterHealthSnapshotDocument
+	if maxItemCount == -1 {
+		clusterHealthSnapshotDocuments = i.clusterHealthSnapshotDocuments[i.continuation:]
+		i.continuation = len(i.clusterHealthSnapshotDocuments)
+		i.done = true
+	} else {
+		max := i.continuation + maxItemCount
+		if max > len(i.clusterHealthSnapshotDocuments) {
+			max = len(i.clusterHealthSnapshotDocuments)
+		}
+		clusterHealthSnapshotDocuments = i.clusterHealthSnapshotDocuments[i.continuation:max]
+		i.continuation = max
+		i.done = i.Continuation() == ""
+	}
+
+	return &pkg.ClusterHealthSnapshotDocuments{
+		ClusterHealthSnapshotDocuments: clusterHealthSnapshotDocuments,
+		Count:                          len(clusterHealthSnapshotDocuments),
+	}, nil
+}
+
+func (i *fakeClusterHealthSnapshotDocumentIterator) Continuation() string {
+	if i.continuation >= len(i.clusterHealthSnapshotDocuments) {
+		return ""
+	}
+	return fmt.Sprintf("%d", i.continuation)
+}
+
+// NewFakeClusterHealthSnapshotDocumentErroringRawIterator returns a ClusterHealthSnapshotDocumentRawIterator which
+// whose methods return the given error
+func NewFakeClusterHealthSnapshotDocumentErroringRawIterator(err error) ClusterHealthSnapshotDocumentRawIterator {
+	return &fakeClusterHealthSnapshotDocumentErroringRawIterator{err: err}
+}
+
+type fakeClusterHealthSnapshotDocumentErroringRawIterator struct {
+	err error
+}
+
+func (i *fakeClusterHealthSnapshotDocumentErroringRawIterator) Next(ctx context.Context, maxItemCount int) (*pkg.ClusterHealthSnapshotDocuments, error) {
+	return nil, i.err
+}
+
+func (i *fakeClusterHealthSnapshotDocumentErroringRawIterator) NextRaw(context.Context, int, interface{}) error {
+	return i.err
+}
+
+func (i *fakeClusterHealthSnapshotDocumentErroringRawIterator) Continuation() string {
+	return ""
+}
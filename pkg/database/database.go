@@ -29,6 +29,7 @@ import (
 const (
 	collAsyncOperations                 = "AsyncOperations"
 	collBilling                         = "Billing"
+	collClusterHealthSnapshots          = "ClusterHealthSnapshots"
 	collGateway                         = "Gateway"
 	collOpenShiftClusters               = "OpenShiftClusters"
 	collOpenShiftVersion                = "OpenShiftVersions"
@@ -45,6 +45,10 @@ type DatabaseGroupWithPoolWorkers interface {
 	PoolWorkers() (PoolWorkers, error)
 }
 
+type DatabaseGroupWithClusterHealthSnapshots interface {
+	ClusterHealthSnapshots() (ClusterHealthSnapshots, error)
+}
+
 type DatabaseGroup interface {
 	DatabaseGroupWithOpenShiftClusters
 	DatabaseGroupWithSubscriptions
@@ -56,6 +60,7 @@ type DatabaseGroup interface {
 	DatabaseGroupWithMaintenanceManifests
 	DatabaseGroupWithMaintenanceSchedules
 	DatabaseGroupWithPoolWorkers
+	DatabaseGroupWithClusterHealthSnapshots
 
 	WithOpenShiftClusters(db OpenShiftClusters) DatabaseGroup
 	WithSubscriptions(db Subscriptions) DatabaseGroup
@@ -67,6 +72,7 @@ type DatabaseGroup interface {
 	WithMaintenanceManifests(db MaintenanceManifests) DatabaseGroup
 	WithMaintenanceSchedules(db MaintenanceSchedules) DatabaseGroup
 	WithPoolWorkers(db PoolWorkers) DatabaseGroup
+	WithClusterHealthSnapshots(db ClusterHealthSnapshots) DatabaseGroup
 }
 
 type dbGroup struct {
@@ -80,6 +86,7 @@ type dbGroup struct {
 	maintenanceManifests             MaintenanceManifests
 	maintenanceSchedules             MaintenanceSchedules
 	poolWorkers                      PoolWorkers
+	clusterHealthSnapshots           ClusterHealthSnapshots
 }
 
 func (d *dbGroup) OpenShiftClusters() (OpenShiftClusters, error) {
@@ -202,6 +209,18 @@ func (d *dbGroup) WithPoolWorkers(db PoolWorkers) DatabaseGroup {
 	return d
 }
 
+func (d *dbGroup) ClusterHealthSnapshots() (ClusterHealthSnapshots, error) {
+	if d.clusterHealthSnapshots == nil {
+		return nil, errors.New("no ClusterHealthSnapshots database client set")
+	}
+	return d.clusterHealthSnapshots, nil
+}
+
+func (d *dbGroup) WithClusterHealthSnapshots(db ClusterHealthSnapshots) DatabaseGroup {
+	d.clusterHealthSnapshots = db
+	return d
+}
+
 func NewDBGroup() DatabaseGroup {
 	return &dbGroup{}
 }
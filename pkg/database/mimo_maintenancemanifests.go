@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/Azure/ARO-RP/pkg/api"
@@ -33,6 +34,9 @@ const (
 
 	MaintenanceManifestGetFutureForScheduleIDAndCluster = MaintenanceManifestQueryForCluster + " AND " + manifestPendingFragment + " AND " + manifestFetchOnlyByScheduleID + " AND " + manifestFetchOnlyRunAfterInFutureFragment
 	MaintenanceManifestGetFutureForScheduleID           = "SELECT * FROM MaintenanceManifests doc WHERE " + manifestPendingFragment + " AND " + manifestFetchOnlyByScheduleID + " AND " + manifestFetchOnlyRunAfterInFutureFragment
+
+	// fetch all manifests for a schedule with a runAfter inside the given window
+	MaintenanceManifestGetForScheduleIDInWindow = "SELECT * FROM MaintenanceManifests doc WHERE " + manifestFetchOnlyByScheduleID + " AND doc.maintenanceManifest.runAfter >= StringToNumber(@windowStart) AND doc.maintenanceManifest.runAfter < StringToNumber(@windowEnd)"
 )
 
 type MaintenanceManifestDocumentMutator func(*api.MaintenanceManifestDocument) error
@@ -51,6 +55,7 @@ type MaintenanceManifests interface {
 	GetQueuedByClusterResourceID(ctx context.Context, clusterResourceID string, continuation string) (cosmosdb.MaintenanceManifestDocumentIterator, error)
 	GetFutureTasksForScheduleID(ctx context.Context, scheduleID string, continuation string) cosmosdb.MaintenanceManifestDocumentIterator
 	GetFutureTasksForClusterAndScheduleID(ctx context.Context, clusterResourceID string, scheduleID string, continuation string) (cosmosdb.MaintenanceManifestDocumentIterator, error)
+	GetForScheduleIDInWindow(ctx context.Context, scheduleID string, windowStart int64, windowEnd int64, continuation string) cosmosdb.MaintenanceManifestDocumentIterator
 
 	Patch(context.Context, string, string, MaintenanceManifestDocumentMutator) (*api.MaintenanceManifestDocument, error)
 	PatchWithLease(context.Context, string, string, MaintenanceManifestDocumentMutator) (*api.MaintenanceManifestDocument, error)
@@ -247,6 +252,28 @@ func (c *maintenanceManifests) GetFutureTasksForScheduleID(ctx context.Context,
 	}, &cosmosdb.Options{Continuation: continuation})
 }
 
+// GetForScheduleIDInWindow returns the manifests in any state created by the
+// given schedule with a runAfter within [windowStart, windowEnd).
+func (c *maintenanceManifests) GetForScheduleIDInWindow(ctx context.Context, scheduleID string, windowStart, windowEnd int64, continuation string) cosmosdb.MaintenanceManifestDocumentIterator {
+	return c.c.Query("", &cosmosdb.Query{
+		Query: MaintenanceManifestGetForScheduleIDInWindow,
+		Parameters: []cosmosdb.Parameter{
+			{
+				Name:  "@scheduleID",
+				Value: scheduleID,
+			},
+			{
+				Name:  "@windowStart",
+				Value: strconv.FormatInt(windowStart, 10),
+			},
+			{
+				Name:  "@windowEnd",
+				Value: strconv.FormatInt(windowEnd, 10),
+			},
+		},
+	}, &cosmosdb.Options{Continuation: continuation})
+}
+
 func (c *maintenanceManifests) GetQueuedByClusterResourceID(ctx context.Context, clusterResourceID string, continuation string) (cosmosdb.MaintenanceManifestDocumentIterator, error) {
 	if clusterResourceID != strings.ToLower(clusterResourceID) {
 		return nil, fmt.Errorf("clusterResourceID %q is not lower case", clusterResourceID)
@@ -913,6 +913,30 @@ func (g *generator) database(databaseName string, addDependsOn bool) []*arm.Reso
 				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
 			},
 		},
+		{
+			Resource: &sdkcosmos.SQLContainerCreateUpdateParameters{
+				Properties: &sdkcosmos.SQLContainerCreateUpdateProperties{
+					Resource: &sdkcosmos.SQLContainerResource{
+						ID: pointerutils.ToPtr("ClusterHealthSnapshots"),
+						PartitionKey: &sdkcosmos.ContainerPartitionKey{
+							Paths: []*string{
+								pointerutils.ToPtr("/id"),
+							},
+							Kind: &hashPartitionKey,
+						},
+						DefaultTTL: pointerutils.ToPtr(int32(7 * 86400)), // 7 days
+					},
+					Options: &sdkcosmos.CreateUpdateOptions{},
+				},
+				Name:     pointerutils.ToPtr("[concat(parameters('databaseAccountName'), '/', " + databaseName + ", '/ClusterHealthSnapshots')]"),
+				Type:     pointerutils.ToPtr("Microsoft.DocumentDB/databaseAccounts/sqlDatabases/containers"),
+				Location: pointerutils.ToPtr("[resourceGroup().location]"),
+			},
+			APIVersion: azureclient.APIVersion("Microsoft.DocumentDB"),
+			DependsOn: []string{
+				"[resourceId('Microsoft.DocumentDB/databaseAccounts/sqlDatabases', parameters('databaseAccountName'), " + databaseName + ")]",
+			},
+		},
 		{
 			Resource: &sdkcosmos.SQLContainerCreateUpdateParameters{
 				Properties: &sdkcosmos.SQLContainerCreateUpdateProperties{
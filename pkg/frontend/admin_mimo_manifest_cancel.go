@@ -14,15 +14,17 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	utilmimo "github.com/Azure/ARO-RP/pkg/util/mimo"
 )
 
 func (f *frontend) postAdminMaintManifestCancel(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
 	resourceID := resourceIdFromURLParams(r)
-	b, err := f._postAdminMaintManifestCancel(ctx, r, resourceID)
+	b, err := f._postAdminMaintManifestCancel(ctx, log, r, resourceID)
 
 	if cloudErr, ok := err.(*api.CloudError); ok {
 		api.WriteCloudError(w, cloudErr)
@@ -32,7 +34,7 @@ func (f *frontend) postAdminMaintManifestCancel(w http.ResponseWriter, r *http.R
 	adminReply(log, w, nil, b, err)
 }
 
-func (f *frontend) _postAdminMaintManifestCancel(ctx context.Context, r *http.Request, resourceID string) ([]byte, error) {
+func (f *frontend) _postAdminMaintManifestCancel(ctx context.Context, log *logrus.Entry, r *http.Request, resourceID string) ([]byte, error) {
 	manifestId := chi.URLParam(r, "manifestId")
 
 	converter := f.apis[admin.APIVersion].MaintenanceManifestConverter
@@ -75,6 +77,10 @@ func (f *frontend) _postAdminMaintManifestCancel(ctx context.Context, r *http.Re
 		}
 	}
 
+	if modifiedDoc.MaintenanceManifest.CreatedBySchedule != "" {
+		clearMaintenancePending(ctx, log, dbMaintenanceManifests, dbOpenShiftClusters, resourceID)
+	}
+
 	return json.MarshalIndent(converter.ToExternal(modifiedDoc, true), "", "    ")
 }
 
@@ -103,8 +109,14 @@ func (f *frontend) _postAdminMaintManifestCancelBatch(ctx context.Context, log *
 		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
 	}
 
+	dbOpenShiftClusters, err := f.dbGroup.OpenShiftClusters()
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
+	}
+
 	cancelled := 0
 	errors := 0
+	clusters := map[string]bool{}
 
 	i := dbManifests.GetFutureTasksForScheduleID(ctx, scheduleID, "")
 	for {
@@ -131,8 +143,26 @@ func (f *frontend) _postAdminMaintManifestCancelBatch(ctx context.Context, log *
 				errors += 1
 			} else {
 				cancelled += 1
+				clusters[doc.ClusterResourceID] = true
 			}
 		}
 	}
+
+	for clusterResourceID := range clusters {
+		clearMaintenancePending(ctx, log, dbManifests, dbOpenShiftClusters, clusterResourceID)
+	}
+
 	return json.MarshalIndent(map[string]any{"cancelled": cancelled, "errors": errors}, "", "    ")
 }
+
+// clearMaintenancePending clears the pending maintenance state the MIMO
+// Scheduler set on the cluster once its scheduled manifests are cancelled.
+// Failures are only logged, as the manifests have already been cancelled.
+func clearMaintenancePending(ctx context.Context, log *logrus.Entry, dbManifests database.MaintenanceManifests, dbOpenShiftClusters database.OpenShiftClusters, clusterResourceID string) {
+	cleared, err := utilmimo.ClearMaintenancePending(ctx, dbManifests, dbOpenShiftClusters, clusterResourceID)
+	if err != nil {
+		log.Errorf("while clearing pending maintenance state of %s, continuing: %s", clusterResourceID, err.Error())
+	} else if cleared {
+		log.Infof("cleared pending maintenance state of %s", clusterResourceID)
+	}
+}
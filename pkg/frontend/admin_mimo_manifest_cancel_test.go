@@ -30,6 +30,8 @@ func TestMIMOCancelManifest(t *testing.T) {
 		wantResponse   *admin.MaintenanceManifest
 		wantResult     func(f *testdatabase.Checker)
 		wantError      string
+
+		wantMaintenanceState api.MaintenanceState
 	}
 
 	for _, tt := range []*test{
@@ -115,6 +117,115 @@ func TestMIMOCancelManifest(t *testing.T) {
 			},
 			wantStatusCode: http.StatusOK,
 		},
+		{
+			name: "cancel scheduled manifest clears pending maintenance state",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   resourceID,
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							MaintenanceState:              api.MaintenanceStatePending,
+							MaintenanceStateSetBySchedule: true,
+							MaintenanceWindow: &api.MaintenanceWindow{
+								DaysOfWeek:    []string{"Saturday"},
+								DurationHours: 4,
+							},
+						},
+					},
+				})
+				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ClusterResourceID: strings.ToLower(resourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						MaintenanceTaskID: "exampletask",
+						CreatedBySchedule: "testschedule",
+						State:             api.MaintenanceManifestStatePending,
+						RunAfter:          10000,
+						RunBefore:         19999,
+					},
+				})
+			},
+			wantResult: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                "07070707-0707-0707-0707-070707070001",
+					ClusterResourceID: strings.ToLower(resourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						MaintenanceTaskID: "exampletask",
+						CreatedBySchedule: "testschedule",
+						State:             api.MaintenanceManifestStateCancelled,
+						RunAfter:          10000,
+						RunBefore:         19999,
+					},
+				})
+			},
+			wantResponse: &admin.MaintenanceManifest{
+				ID:                "07070707-0707-0707-0707-070707070001",
+				MaintenanceTaskID: "exampletask",
+				CreatedBySchedule: "testschedule",
+				State:             admin.MaintenanceManifestStateCancelled,
+				Priority:          0,
+				RunAfter:          10000,
+				RunBefore:         19999,
+			},
+			wantMaintenanceState: api.MaintenanceStateNone,
+			wantStatusCode:       http.StatusOK,
+		},
+		{
+			name: "cancel scheduled manifest keeps a pending maintenance state set by an SRE",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   resourceID,
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							MaintenanceState: api.MaintenanceStatePending,
+							MaintenanceWindow: &api.MaintenanceWindow{
+								DaysOfWeek:    []string{"Saturday"},
+								DurationHours: 4,
+							},
+						},
+					},
+				})
+				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ClusterResourceID: strings.ToLower(resourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						MaintenanceTaskID: "exampletask",
+						CreatedBySchedule: "testschedule",
+						State:             api.MaintenanceManifestStatePending,
+						RunAfter:          10000,
+						RunBefore:         19999,
+					},
+				})
+			},
+			wantResult: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                "07070707-0707-0707-0707-070707070001",
+					ClusterResourceID: strings.ToLower(resourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						MaintenanceTaskID: "exampletask",
+						CreatedBySchedule: "testschedule",
+						State:             api.MaintenanceManifestStateCancelled,
+						RunAfter:          10000,
+						RunBefore:         19999,
+					},
+				})
+			},
+			wantResponse: &admin.MaintenanceManifest{
+				ID:                "07070707-0707-0707-0707-070707070001",
+				MaintenanceTaskID: "exampletask",
+				CreatedBySchedule: "testschedule",
+				State:             admin.MaintenanceManifestStateCancelled,
+				Priority:          0,
+				RunAfter:          10000,
+				RunBefore:         19999,
+			},
+			wantMaintenanceState: api.MaintenanceStatePending,
+			wantStatusCode:       http.StatusOK,
+		},
 		{
 			name: "cannot cancel failed",
 			fixtures: func(f *testdatabase.Fixture) {
@@ -199,6 +310,16 @@ func TestMIMOCancelManifest(t *testing.T) {
 			for _, err := range ti.checker.CheckMaintenanceManifests(ti.maintenanceManifestsClient) {
 				t.Error(err)
 			}
+
+			if tt.wantMaintenanceState != "" {
+				oc, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(resourceID))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if oc.OpenShiftCluster.Properties.MaintenanceState != tt.wantMaintenanceState {
+					t.Errorf("got maintenance state %s, wanted %s", oc.OpenShiftCluster.Properties.MaintenanceState, tt.wantMaintenanceState)
+				}
+			}
 		})
 	}
 }
@@ -222,6 +343,8 @@ func TestMIMOCancelManifestBatch(t *testing.T) {
 		wantResult     func(f *testdatabase.Checker)
 		wantError      string
 		scheduleID     string
+
+		wantMaintenanceState api.MaintenanceState
 	}
 
 	for _, tt := range []*test{
@@ -235,6 +358,14 @@ func TestMIMOCancelManifestBatch(t *testing.T) {
 						ID:   resourceID,
 						Name: "resourceName",
 						Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+						Properties: api.OpenShiftClusterProperties{
+							MaintenanceState:              api.MaintenanceStatePending,
+							MaintenanceStateSetBySchedule: true,
+							MaintenanceWindow: &api.MaintenanceWindow{
+								DaysOfWeek:    []string{"Saturday"},
+								DurationHours: 4,
+							},
+						},
 					},
 				})
 				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
@@ -284,8 +415,9 @@ func TestMIMOCancelManifestBatch(t *testing.T) {
 					},
 				)
 			},
-			wantResponse:   &cancelResp{Cancelled: 1},
-			wantStatusCode: http.StatusOK,
+			wantResponse:         &cancelResp{Cancelled: 1},
+			wantMaintenanceState: api.MaintenanceStateNone,
+			wantStatusCode:       http.StatusOK,
 		},
 		{
 			name:           "no schedule ID",
@@ -340,6 +472,16 @@ func TestMIMOCancelManifestBatch(t *testing.T) {
 			for _, err := range ti.checker.CheckMaintenanceManifests(ti.maintenanceManifestsClient) {
 				t.Error(err)
 			}
+
+			if tt.wantMaintenanceState != "" {
+				oc, err := ti.openShiftClustersDatabase.Get(ctx, strings.ToLower(resourceID))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if oc.OpenShiftCluster.Properties.MaintenanceState != tt.wantMaintenanceState {
+					t.Errorf("got maintenance state %s, wanted %s", oc.OpenShiftCluster.Properties.MaintenanceState, tt.wantMaintenanceState)
+				}
+			}
 		})
 	}
 }
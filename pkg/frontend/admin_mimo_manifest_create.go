@@ -14,6 +14,7 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
 )
 
@@ -88,6 +89,16 @@ func (f *frontend) _putAdminMaintManifestCreate(ctx context.Context, r *http.Req
 		return nil, err
 	}
 
+	// prerequisites must already exist on this cluster
+	for i, id := range ext.Prerequisites {
+		_, err = dbMaintenanceManifests.Get(ctx, resourceID, id)
+		if cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+			return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, fmt.Sprintf("prerequisites[%d]", i), fmt.Sprintf("The manifest '%s' was not found.", id))
+		} else if err != nil {
+			return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
+		}
+	}
+
 	manifestDoc := &api.MaintenanceManifestDocument{
 		ClusterResourceID: resourceID,
 	}
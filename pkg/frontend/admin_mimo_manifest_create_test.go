@@ -152,6 +152,90 @@ func TestMIMOCreateManifest(t *testing.T) {
 			},
 			wantStatusCode: http.StatusCreated,
 		},
+		{
+			name: "missing prerequisite",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   resourceID,
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+					},
+				})
+			},
+			body: &admin.MaintenanceManifest{
+				MaintenanceTaskID: "exampletask",
+				RunAfter:          1,
+				RunBefore:         1,
+				Prerequisites:     []string{"08080808-0808-0808-0808-080808080001"},
+			},
+			wantError:      "400: InvalidParameter: prerequisites[0]: The manifest '08080808-0808-0808-0808-080808080001' was not found.",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "with prerequisite",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID:   resourceID,
+						Name: "resourceName",
+						Type: "Microsoft.RedHatOpenShift/openshiftClusters",
+					},
+				})
+				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                "08080808-0808-0808-0808-080808080001",
+					ClusterResourceID: strings.ToLower(resourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						MaintenanceTaskID: "othertask",
+						State:             api.MaintenanceManifestStatePending,
+						RunAfter:          1,
+						RunBefore:         1,
+					},
+				})
+			},
+			body: &admin.MaintenanceManifest{
+				MaintenanceTaskID: "exampletask",
+				RunAfter:          1,
+				RunBefore:         1,
+				Prerequisites:     []string{"08080808-0808-0808-0808-080808080001"},
+			},
+			wantResult: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                "07070707-0707-0707-0707-070707070001",
+						ClusterResourceID: strings.ToLower(resourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							MaintenanceTaskID: "exampletask",
+							State:             api.MaintenanceManifestStatePending,
+							RunAfter:          1,
+							RunBefore:         1,
+							Prerequisites:     []string{"08080808-0808-0808-0808-080808080001"},
+						},
+					},
+					&api.MaintenanceManifestDocument{
+						ID:                "08080808-0808-0808-0808-080808080001",
+						ClusterResourceID: strings.ToLower(resourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							MaintenanceTaskID: "othertask",
+							State:             api.MaintenanceManifestStatePending,
+							RunAfter:          1,
+							RunBefore:         1,
+						},
+					},
+				)
+			},
+			wantResponse: &admin.MaintenanceManifest{
+				ID:                "07070707-0707-0707-0707-070707070001",
+				MaintenanceTaskID: "exampletask",
+				State:             admin.MaintenanceManifestStatePending,
+				RunAfter:          1,
+				RunBefore:         1,
+				Prerequisites:     []string{"08080808-0808-0808-0808-080808080001"},
+			},
+			wantStatusCode: http.StatusCreated,
+		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
 			now := func() time.Time { return time.Unix(1000, 0) }
@@ -85,12 +85,23 @@ func (f *frontend) _putAdminMaintSchedulePut(ctx context.Context, r *http.Reques
 		converter.ToInternal(ext, schedDoc)
 	}
 
+	// Resuming a paused schedule clears the reason it was paused
+	if schedDoc.MaintenanceSchedule.State != api.MaintenanceScheduleStatePaused {
+		schedDoc.MaintenanceSchedule.PausedReason = ""
+	}
+
 	// Validate the calendar schedule is valid
 	_, err = scheduler.ParseCalendar(ext.Schedule)
 	if err != nil {
 		return false, nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "schedule", err.Error())
 	}
 
+	// Validate the selectors can be evaluated against the types of their keys
+	err = scheduler.ValidateSelectors(schedDoc.MaintenanceSchedule.Selectors)
+	if err != nil {
+		return false, nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "selectors", err.Error())
+	}
+
 	var savedDoc *api.MaintenanceScheduleDocument
 	if isCreate {
 		savedDoc, err = dbMaintenanceSchedules.Create(ctx, schedDoc)
@@ -57,6 +57,51 @@ func TestMIMOCreateSchedule(t *testing.T) {
 			wantError:      "400: InvalidParameter: schedule: error parsing hour: 90 is out of range",
 			wantStatusCode: http.StatusBadRequest,
 		},
+		{
+			name:     "invalid version comparison",
+			fixtures: func(f *testdatabase.Fixture) {},
+			body: &admin.MaintenanceSchedule{
+				MaintenanceTaskID: "exampletask",
+				State:             admin.MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				LookForwardCount:  1,
+				ScheduleAcross:    "12h",
+				Selectors: []*admin.MaintenanceScheduleSelector{
+					{
+						Operator: admin.MaintenanceScheduleSelectorOperatorAny,
+						Selectors: []*admin.MaintenanceScheduleSelector{
+							{
+								Key:      "version",
+								Operator: admin.MaintenanceScheduleSelectorOperatorLt,
+								Value:    "four",
+							},
+						},
+					},
+				},
+			},
+			wantError:      "400: InvalidParameter: selectors: selector key 'version' requires a version: could not parse version \"four\"",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:     "comparison on a string key",
+			fixtures: func(f *testdatabase.Fixture) {},
+			body: &admin.MaintenanceSchedule{
+				MaintenanceTaskID: "exampletask",
+				State:             admin.MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				LookForwardCount:  1,
+				ScheduleAcross:    "12h",
+				Selectors: []*admin.MaintenanceScheduleSelector{
+					{
+						Key:      "region",
+						Operator: admin.MaintenanceScheduleSelectorOperatorGt,
+						Value:    "eastus",
+					},
+				},
+			},
+			wantError:      "400: InvalidParameter: selectors: selector operator gt is not supported on key 'region' of type 'string'",
+			wantStatusCode: http.StatusBadRequest,
+		},
 		{
 			name:     "good",
 			fixtures: func(f *testdatabase.Fixture) {},
@@ -296,6 +341,90 @@ func TestMIMOPutSchedule(t *testing.T) {
 			},
 			wantStatusCode: http.StatusOK,
 		},
+		{
+			name: "resuming a paused schedule clears the reason",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddMaintenanceScheduleDocuments(&api.MaintenanceScheduleDocument{
+					ID: "08080808-0808-0808-0808-080808080001",
+					MaintenanceSchedule: api.MaintenanceSchedule{
+						MaintenanceTaskID: "exampletask",
+						State:             api.MaintenanceScheduleStatePaused,
+						Schedule:          "*-*-* 00:00:00",
+						ScheduleAcross:    "12h",
+						LookForwardCount:  1,
+						Selectors: []*api.MaintenanceScheduleSelector{
+							{
+								Key:      "foobar",
+								Operator: api.MaintenanceScheduleSelectorOperatorIn,
+								Values:   []string{"baz"},
+							},
+						},
+						Waves:                       []int{10, 100},
+						WaveFailureThresholdPercent: 5,
+						PausedReason:                "too many failures",
+					},
+				})
+			},
+			body: &admin.MaintenanceSchedule{
+				ID:                "08080808-0808-0808-0808-080808080001",
+				MaintenanceTaskID: "exampletask",
+				State:             admin.MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				LookForwardCount:  1,
+				ScheduleAcross:    "12h",
+
+				Selectors: []*admin.MaintenanceScheduleSelector{
+					{
+						Key:      "foobar",
+						Operator: admin.MaintenanceScheduleSelectorOperatorIn,
+						Values:   []string{"baz"},
+					},
+				},
+
+				Waves:                       []int{10, 100},
+				WaveFailureThresholdPercent: 20,
+				PausedReason:                "too many failures",
+			},
+			wantResult: func(c *testdatabase.Checker) {
+				c.AddMaintenanceScheduleDocuments(&api.MaintenanceScheduleDocument{
+					ID: "08080808-0808-0808-0808-080808080001",
+					MaintenanceSchedule: api.MaintenanceSchedule{
+						MaintenanceTaskID: "exampletask",
+						State:             api.MaintenanceScheduleStateEnabled,
+						Schedule:          "*-*-* 00:00:00",
+						LookForwardCount:  1,
+						ScheduleAcross:    "12h",
+						Selectors: []*api.MaintenanceScheduleSelector{
+							{
+								Key:      "foobar",
+								Operator: api.MaintenanceScheduleSelectorOperatorIn,
+								Values:   []string{"baz"},
+							},
+						},
+						Waves:                       []int{10, 100},
+						WaveFailureThresholdPercent: 20,
+					},
+				})
+			},
+			wantResponse: &admin.MaintenanceSchedule{
+				ID:                "08080808-0808-0808-0808-080808080001",
+				MaintenanceTaskID: "exampletask",
+				State:             admin.MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00:00",
+				LookForwardCount:  1,
+				ScheduleAcross:    "12h",
+				Selectors: []*admin.MaintenanceScheduleSelector{
+					{
+						Key:      "foobar",
+						Operator: admin.MaintenanceScheduleSelectorOperatorIn,
+						Values:   []string{"baz"},
+					},
+				},
+				Waves:                       []int{10, 100},
+				WaveFailureThresholdPercent: 20,
+			},
+			wantStatusCode: http.StatusOK,
+		},
 		{
 			name: "can't update maint ID",
 			fixtures: func(f *testdatabase.Fixture) {
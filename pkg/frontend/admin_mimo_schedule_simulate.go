@@ -0,0 +1,87 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/mimo/scheduler"
+)
+
+// postAdminMaintScheduleSimulate returns the manifests which the given
+// schedule would create, without saving the schedule or the manifests.
+func (f *frontend) postAdminMaintScheduleSimulate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	b, err := f._postAdminMaintScheduleSimulate(ctx, r, log)
+
+	if cloudErr, ok := err.(*api.CloudError); ok {
+		api.WriteCloudError(w, cloudErr)
+		return
+	}
+
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _postAdminMaintScheduleSimulate(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	scheduleConverter := f.apis[admin.APIVersion].MaintenanceScheduleConverter
+	manifestConverter := f.apis[admin.APIVersion].MaintenanceManifestConverter
+	validator := f.apis[admin.APIVersion].MaintenanceScheduleStaticValidator
+
+	var ext *admin.MaintenanceSchedule
+	body := r.Context().Value(middleware.ContextKeyBody).([]byte)
+	if len(body) == 0 || !json.Valid(body) {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content was invalid and could not be deserialized.")
+	}
+	err := json.Unmarshal(body, &ext)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidRequestContent, "", "The request content could not be deserialized: "+err.Error())
+	}
+
+	err = validator.Static(ext, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	schedDoc := &api.MaintenanceScheduleDocument{ID: ext.ID}
+	scheduleConverter.ToInternal(ext, schedDoc)
+
+	// Validate the calendar schedule is valid
+	_, err = scheduler.ParseCalendar(ext.Schedule)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "schedule", err.Error())
+	}
+
+	// Validate the selectors can be evaluated against the types of their keys
+	err = scheduler.ValidateSelectors(schedDoc.MaintenanceSchedule.Selectors)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "selectors", err.Error())
+	}
+
+	// Unlike a saved schedule, which may select on keys added in later
+	// releases, a simulated schedule can only select on keys which exist now
+	err = scheduler.ValidateSelectorKeys(schedDoc.MaintenanceSchedule.Selectors)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "selectors", err.Error())
+	}
+
+	f.startMIMOClusters()
+	if !f.mimoClusters.Populated() {
+		return nil, api.NewCloudError(http.StatusServiceUnavailable, api.CloudErrorCodeInternalServerError, "", "The cluster cache is not yet populated, please try again later.")
+	}
+
+	manifests, err := scheduler.Simulate(ctx, log, f.now(), schedDoc, f.mimoClusters.GetClusters, f.dbGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(manifestConverter.ToExternalList(manifests, "", false), "", "    ")
+}
@@ -0,0 +1,204 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestMIMOSimulateSchedule(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	ctx := context.Background()
+
+	clusterDoc := func(name string, location string, ps api.ProvisioningState) *api.OpenShiftClusterDocument {
+		return &api.OpenShiftClusterDocument{
+			Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, name)),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID:       testdatabase.GetResourcePath(mockSubID, name),
+				Location: location,
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: ps,
+				},
+			},
+		}
+	}
+
+	subscriptionDoc := &api.SubscriptionDocument{
+		ID: mockSubID,
+		Subscription: &api.Subscription{
+			State:      api.SubscriptionStateRegistered,
+			Properties: &api.SubscriptionProperties{},
+		},
+	}
+
+	type test struct {
+		name           string
+		fixtures       func(f *testdatabase.Fixture)
+		body           *admin.MaintenanceSchedule
+		wantStatusCode int
+		wantResponse   *admin.MaintenanceManifestList
+		wantError      string
+	}
+
+	for _, tt := range []*test{
+		{
+			name:     "invalid",
+			fixtures: func(f *testdatabase.Fixture) {},
+			body: &admin.MaintenanceSchedule{
+				State: admin.MaintenanceScheduleStateEnabled,
+			},
+			wantError:      "400: InvalidParameter: maintenanceTaskID: Must be provided",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "unknown selector key",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(clusterDoc("cluster1", "eastus", api.ProvisioningStateSucceeded))
+				f.AddSubscriptionDocuments(subscriptionDoc)
+			},
+			body: &admin.MaintenanceSchedule{
+				MaintenanceTaskID: "exampletask",
+				State:             admin.MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00",
+				LookForwardCount:  1,
+				ScheduleAcross:    "0s",
+				Selectors: []*admin.MaintenanceScheduleSelector{
+					{
+						Key:      "foobar",
+						Operator: admin.MaintenanceScheduleSelectorOperatorEq,
+						Value:    "baz",
+					},
+				},
+			},
+			wantError:      "400: InvalidParameter: selectors: unknown selector key 'foobar'",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "health snapshots unavailable",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(clusterDoc("cluster1", "eastus", api.ProvisioningStateSucceeded))
+				f.AddSubscriptionDocuments(subscriptionDoc)
+			},
+			body: &admin.MaintenanceSchedule{
+				MaintenanceTaskID: "exampletask",
+				State:             admin.MaintenanceScheduleStateEnabled,
+				Schedule:          "*-*-* 00:00",
+				LookForwardCount:  1,
+				ScheduleAcross:    "0s",
+				Selectors: []*admin.MaintenanceScheduleSelector{
+					{
+						Key:      "certificatesDaysUntilExpiry",
+						Operator: admin.MaintenanceScheduleSelectorOperatorLt,
+						Value:    "30",
+					},
+				},
+			},
+			wantError:      "500: InternalServerError: : cluster /subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/cluster1: unable to get health snapshot: no ClusterHealthSnapshots database client set",
+			wantStatusCode: http.StatusInternalServerError,
+		},
+		{
+			name: "good",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(
+					clusterDoc("cluster1", "eastus", api.ProvisioningStateSucceeded),
+					clusterDoc("cluster2", "westus", api.ProvisioningStateSucceeded),
+					clusterDoc("cluster3", "eastus", api.ProvisioningStateCreating),
+				)
+				f.AddSubscriptionDocuments(subscriptionDoc)
+			},
+			body: &admin.MaintenanceSchedule{
+				ID:                "08080808-0808-0808-0808-080808080001",
+				MaintenanceTaskID: "exampletask",
+				State:             admin.MaintenanceScheduleStateDisabled,
+				Schedule:          "*-*-* 00:00",
+				LookForwardCount:  2,
+				ScheduleAcross:    "0s",
+				Selectors: []*admin.MaintenanceScheduleSelector{
+					{
+						Key:      "region",
+						Operator: admin.MaintenanceScheduleSelectorOperatorEq,
+						Value:    "eastus",
+					},
+				},
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.MaintenanceManifestList{
+				MaintenanceManifests: []*admin.MaintenanceManifest{
+					{
+						ClusterResourceID: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "cluster1")),
+						State:             admin.MaintenanceManifestStatePending,
+						MaintenanceTaskID: "exampletask",
+						CreatedBySchedule: "08080808-0808-0808-0808-080808080001",
+						RunAfter:          86400,
+						RunBefore:         86400 + 3600,
+					},
+					{
+						ClusterResourceID: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "cluster1")),
+						State:             admin.MaintenanceManifestStatePending,
+						MaintenanceTaskID: "exampletask",
+						CreatedBySchedule: "08080808-0808-0808-0808-080808080001",
+						RunAfter:          2 * 86400,
+						RunBefore:         2*86400 + 3600,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			now := func() time.Time { return time.Unix(1000, 0) }
+
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixtures)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.auditLog, ti.log, ti.otelAudit, ti.env, ti.dbGroup, api.APIs, &noop.Noop{}, &noop.Noop{}, testdatabase.NewFakeAEAD(), nil, nil, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f.now = now
+
+			go f.Run(ctx, nil, nil)
+
+			// the cluster cache is populated once a request needs it
+			f.startMIMOClusters()
+
+			// wait for the changefeeds to populate the cluster cache
+			err = wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 10*time.Second, true, func(context.Context) (bool, error) {
+				return f.mimoClusters.Populated(), nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, b, err := ti.request(http.MethodPost,
+				"https://server/admin/maintenanceschedules/simulate",
+				http.Header{
+					"Content-Type": []string{"application/json"},
+				}, tt.body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/cluster"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+)
+
+type adminUpdatePlan struct {
+	MaintenanceTask api.MaintenanceTask     `json:"maintenanceTask"`
+	Steps           []steps.StepDescription `json:"steps"`
+}
+
+// /admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/adminupdateplan?maintenanceTask=...
+func (f *frontend) getAdminOpenShiftClusterAdminUpdatePlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+	b, err := f._getAdminOpenShiftClusterAdminUpdatePlan(ctx, r, log)
+	adminReply(log, w, nil, b, err)
+}
+
+func (f *frontend) _getAdminOpenShiftClusterAdminUpdatePlan(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	resType, resName, resGroupName := chi.URLParam(r, "resourceType"), chi.URLParam(r, "resourceName"), chi.URLParam(r, "resourceGroupName")
+
+	task := api.MaintenanceTask(r.URL.Query().Get("maintenanceTask"))
+	if task == "" {
+		task = api.MaintenanceTaskEverything
+	}
+	if !task.IsMaintenanceOngoingTask() {
+		return nil, api.NewCloudError(http.StatusBadRequest, api.CloudErrorCodeInvalidParameter, "maintenanceTask", fmt.Sprintf("The provided maintenance task '%s' is invalid.", task))
+	}
+
+	resourceID := strings.TrimPrefix(r.URL.Path, "/admin")
+
+	dbOpenShiftClusters, err := f.dbGroup.OpenShiftClusters()
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
+	}
+
+	doc, err := dbOpenShiftClusters.Get(ctx, resourceID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return nil, api.NewCloudError(http.StatusNotFound, api.CloudErrorCodeResourceNotFound, "",
+			fmt.Sprintf(
+				"The Resource '%s/%s' under resource group '%s' was not found.",
+				resType, resName, resGroupName))
+	case err != nil:
+		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
+	}
+
+	adoptViaHive, err := f.env.LiveConfig().AdoptByHive(ctx)
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
+	}
+
+	// Resolve the steps against the maintenance task as it would be set by
+	// the admin update, without persisting it.
+	doc.OpenShiftCluster.Properties.MaintenanceTask = task
+
+	return json.MarshalIndent(adminUpdatePlan{
+		MaintenanceTask: task,
+		Steps:           steps.Describe(cluster.AdminUpdateSteps(doc, adoptViaHive)),
+	}, "", "    ")
+}
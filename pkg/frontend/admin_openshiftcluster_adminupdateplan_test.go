@@ -0,0 +1,195 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/go-test/deep"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/liveconfig"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	"github.com/Azure/ARO-RP/pkg/util/pointerutils"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestGetAdminUpdatePlan(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+
+	ctx := context.Background()
+
+	zerothSteps := []steps.StepDescription{
+		{
+			Type:        "Action",
+			Name:        "pkg/cluster.(*manager).initializeKubernetesClients",
+			MetricsName: "action.initializeKubernetesClients",
+			AlwaysRun:   true,
+		},
+		{
+			Type:        "Action",
+			Name:        "pkg/cluster.(*manager).ensureBillingRecord",
+			MetricsName: "action.ensureBillingRecord",
+		},
+		{
+			Type:        "Action",
+			Name:        "pkg/cluster.(*manager).ensureDefaults",
+			MetricsName: "action.ensureDefaults",
+		},
+		{
+			Type:        "Action",
+			Name:        "pkg/cluster.(*manager).fixupClusterSPObjectID",
+			MetricsName: "action.fixupClusterSPObjectID",
+		},
+		{
+			Type:        "Action",
+			Name:        "pkg/cluster.(*manager).fixInfraID",
+			MetricsName: "action.fixInfraID",
+		},
+	}
+
+	for _, tt := range []struct {
+		name           string
+		query          string
+		wantStatusCode int
+		wantPlan       *adminUpdatePlan
+		wantError      string
+	}{
+		{
+			name:           "migrate load balancer",
+			query:          "?maintenanceTask=MigrateLoadBalancer",
+			wantStatusCode: http.StatusOK,
+			wantPlan: &adminUpdatePlan{
+				MaintenanceTask: api.MaintenanceTaskMigrateLoadBalancer,
+				Steps: append(append([]steps.StepDescription{}, zerothSteps...),
+					steps.StepDescription{
+						Type:        "Action",
+						Name:        "pkg/cluster.(*manager).migrateInternalLoadBalancerZones",
+						MetricsName: "action.migrateInternalLoadBalancerZones",
+					},
+					steps.StepDescription{
+						Type:        "Action",
+						Name:        "pkg/cluster.(*manager).fixSSH",
+						MetricsName: "action.fixSSH",
+					},
+				),
+			},
+		},
+		{
+			name:           "sync cluster object",
+			query:          "?maintenanceTask=SyncClusterObject",
+			wantStatusCode: http.StatusOK,
+			wantPlan: &adminUpdatePlan{
+				MaintenanceTask: api.MaintenanceTaskSyncClusterObject,
+				Steps: append(append([]steps.StepDescription{}, zerothSteps...),
+					steps.StepDescription{
+						Type:        "Action",
+						Name:        "pkg/cluster.(*manager).startVMs",
+						MetricsName: "action.startVMs",
+						AlwaysRun:   true,
+					},
+					steps.StepDescription{
+						Type:        "Condition",
+						Name:        "pkg/cluster.(*manager).apiServersReady",
+						MetricsName: "condition.apiServersReady",
+						Timeout:     "30m0s",
+						FailOnError: pointerutils.ToPtr(true),
+						AlwaysRun:   true,
+					},
+					steps.StepDescription{
+						Type:        "Action",
+						Name:        "pkg/cluster.(*manager).initializeOperatorDeployer",
+						MetricsName: "action.initializeOperatorDeployer",
+						AlwaysRun:   true,
+					},
+					steps.StepDescription{
+						Type:        "Action",
+						Name:        "pkg/cluster.(*manager).syncClusterObject",
+						MetricsName: "action.syncClusterObject",
+					},
+				),
+			},
+		},
+		{
+			name:           "invalid maintenance task",
+			query:          "?maintenanceTask=None",
+			wantStatusCode: http.StatusBadRequest,
+			wantError:      "400: InvalidParameter: maintenanceTask: The provided maintenance task 'None' is invalid.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			defer ti.done()
+
+			_env := ti.env.(*mock_env.MockInterface)
+			_env.EXPECT().LiveConfig().AnyTimes().Return(liveconfig.NewProd("eastus", nil))
+
+			err := ti.buildFixtures(func(f *testdatabase.Fixture) {
+				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					Key: strings.ToLower(resourceID),
+					OpenShiftCluster: &api.OpenShiftCluster{
+						ID: resourceID,
+						Properties: api.OpenShiftClusterProperties{
+							ProvisioningState: api.ProvisioningStateSucceeded,
+							ClusterProfile: api.ClusterProfile{
+								Version: "4.10.0",
+							},
+						},
+					},
+				})
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.auditLog, ti.log, ti.otelAudit, ti.env, ti.dbGroup, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/adminupdateplan%s", resourceID, tt.query),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if resp.StatusCode != tt.wantStatusCode {
+				t.Errorf("got %d, wanted %d", resp.StatusCode, tt.wantStatusCode)
+			}
+
+			if tt.wantError != "" {
+				cloudErr := &api.CloudError{StatusCode: resp.StatusCode}
+				err = json.Unmarshal(b, &cloudErr)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if cloudErr.Error() != tt.wantError {
+					t.Errorf("got %q, wanted %q", cloudErr.Error(), tt.wantError)
+				}
+				return
+			}
+
+			var plan *adminUpdatePlan
+			err = json.Unmarshal(b, &plan)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, diff := range deep.Equal(plan, tt.wantPlan) {
+				t.Error(diff)
+			}
+		})
+	}
+}
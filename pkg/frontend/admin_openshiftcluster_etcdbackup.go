@@ -0,0 +1,48 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// /admin/subscriptions/{subscriptionId}/resourcegroups/{resourceGroupName}/providers/{resourceProviderNamespace}/{resourceType}/{resourceName}/etcdbackup
+func (f *frontend) getAdminOpenShiftClusterEtcdBackup(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := ctx.Value(middleware.ContextKeyLog).(*logrus.Entry)
+	r.URL.Path = filepath.Dir(r.URL.Path)
+	b, err := f._getAdminOpenShiftClusterEtcdBackup(ctx, r, log)
+	adminReply(log, w, nil, b, err)
+}
+
+// _getAdminOpenShiftClusterEtcdBackup returns the latest etcd backup the
+// operator recorded in the ARO Cluster resource
+func (f *frontend) _getAdminOpenShiftClusterEtcdBackup(ctx context.Context, r *http.Request, log *logrus.Entry) ([]byte, error) {
+	k, _, err := f.fetchClusterKubeActions(ctx, r, log)
+	if err != nil {
+		return nil, err
+	}
+
+	rawCluster, err := k.KubeGet(ctx, "Cluster.aro.openshift.io", "", arov1alpha1.SingletonClusterName)
+	if err != nil {
+		return nil, fmt.Errorf("getting ARO Cluster resource: %w", err)
+	}
+
+	var cluster arov1alpha1.Cluster
+	err = json.Unmarshal(rawCluster, &cluster)
+	if err != nil {
+		return nil, fmt.Errorf("parsing ARO Cluster resource: %w", err)
+	}
+
+	return json.Marshal(cluster.Status.EtcdBackup)
+}
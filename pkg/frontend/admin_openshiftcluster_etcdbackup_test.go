@@ -0,0 +1,122 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/frontend/adminactions"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	mock_adminactions "github.com/Azure/ARO-RP/pkg/util/mocks/adminactions"
+)
+
+func TestAdminGetEtcdBackup(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	method := http.MethodGet
+
+	etcdBackup := arov1alpha1.EtcdBackupStatus{
+		LastBackupTime:  metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)),
+		LastBackupName:  "20260102T030405Z",
+		RetainedBackups: 7,
+	}
+
+	type test struct {
+		name               string
+		noClusterDoc       bool
+		kubeActionsFactory func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error)
+		mocks              func(*mock_adminactions.MockKubeActions)
+		wantStatusCode     int
+		wantError          string
+		wantResponse       *arov1alpha1.EtcdBackupStatus
+	}
+
+	for _, tt := range []*test{
+		{
+			name: "returns the latest backup",
+			mocks: func(k *mock_adminactions.MockKubeActions) {
+				b, err := json.Marshal(&arov1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: arov1alpha1.SingletonClusterName},
+					Status:     arov1alpha1.ClusterStatus{EtcdBackup: etcdBackup},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				k.EXPECT().KubeGet(gomock.Any(), "Cluster.aro.openshift.io", "", arov1alpha1.SingletonClusterName).Return(b, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse:   &etcdBackup,
+		},
+		{
+			name: "returns why etcd is not backed up",
+			mocks: func(k *mock_adminactions.MockKubeActions) {
+				b, err := json.Marshal(&arov1alpha1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: arov1alpha1.SingletonClusterName},
+					Status: arov1alpha1.ClusterStatus{EtcdBackup: arov1alpha1.EtcdBackupStatus{
+						Message: "etcd backups are not supported",
+					}},
+				})
+				if err != nil {
+					t.Fatal(err)
+				}
+				k.EXPECT().KubeGet(gomock.Any(), "Cluster.aro.openshift.io", "", arov1alpha1.SingletonClusterName).Return(b, nil)
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &arov1alpha1.EtcdBackupStatus{
+				Message: "etcd backups are not supported",
+			},
+		},
+		{
+			name: "ARO Cluster resource get error",
+			mocks: func(k *mock_adminactions.MockKubeActions) {
+				k.EXPECT().KubeGet(gomock.Any(), "Cluster.aro.openshift.io", "", arov1alpha1.SingletonClusterName).Return(nil, errors.New("connection refused"))
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      "500: InternalServerError: : getting ARO Cluster resource: connection refused",
+		},
+		{
+			name:           "cluster not found",
+			noClusterDoc:   true,
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup' was not found.",
+		},
+		{
+			name: "kubeActionsFactory error",
+			kubeActionsFactory: func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error) {
+				return nil, errors.New("failed to create kubeactions")
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantError:      "500: InternalServerError: : creating kube actions: failed to create kubeactions",
+		},
+	} {
+		t.Run(fmt.Sprintf("%s: %s", method, tt.name), func(t *testing.T) {
+			resourceID := fmt.Sprintf("/subscriptions/%s/resourcegroups/resourceGroup/providers/Microsoft.RedHatOpenShift/openShiftClusters/resourceName", mockSubID)
+			ti, k := newKubeActionsTestFrontend(t, tt.noClusterDoc, tt.kubeActionsFactory)
+			if tt.mocks != nil {
+				tt.mocks(k)
+			}
+
+			resp, b, err := ti.request(method, fmt.Sprintf("https://server/admin%s/etcdbackup", resourceID), nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package frontend
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestGetAdminOpenShiftClusterHealthSnapshot(t *testing.T) {
+	mockSubID := "00000000-0000-0000-0000-000000000000"
+	resourceID := testdatabase.GetResourcePath(mockSubID, "resourceName")
+	clusterDocID := "00000000-0000-0000-0000-000000000001"
+
+	ctx := context.Background()
+
+	clusterFixture := func(f *testdatabase.Fixture) {
+		f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+			ID:  clusterDocID,
+			Key: strings.ToLower(resourceID),
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: resourceID,
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateSucceeded,
+				},
+			},
+		})
+	}
+
+	for _, tt := range []struct {
+		name           string
+		resourceID     string
+		fixture        func(*testdatabase.Fixture)
+		wantStatusCode int
+		wantResponse   *admin.ClusterHealthSnapshot
+		wantError      string
+	}{
+		{
+			name:       "snapshot",
+			resourceID: resourceID,
+			fixture: func(f *testdatabase.Fixture) {
+				clusterFixture(f)
+				f.AddClusterHealthSnapshotDocuments(&api.ClusterHealthSnapshotDocument{
+					ID:                clusterDocID,
+					ClusterResourceID: resourceID,
+					ClusterHealthSnapshot: api.ClusterHealthSnapshot{
+						CollectedAt: 1000,
+						Collectors: []api.ClusterHealthCollectorResult{
+							{
+								Name:            "emitNodeConditions",
+								CollectedAt:     1000,
+								DurationSeconds: 0.5,
+								Conditions: []api.ClusterHealthCondition{
+									{Name: "worker-1", Type: "Ready", Status: "False", Message: "kubelet stopped posting node status"},
+								},
+							},
+							{
+								Name:        "emitPrometheusAlerts",
+								CollectedAt: 1000,
+								Error:       "unexpected status code 503",
+							},
+						},
+					},
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.ClusterHealthSnapshot{
+				ClusterResourceID: resourceID,
+				CollectedAt:       1000,
+				Collectors: []admin.ClusterHealthCollectorResult{
+					{
+						Name:            "emitNodeConditions",
+						CollectedAt:     1000,
+						DurationSeconds: 0.5,
+						Conditions: []admin.ClusterHealthCondition{
+							{Name: "worker-1", Type: "Ready", Status: "False", Message: "kubelet stopped posting node status"},
+						},
+					},
+					{
+						Name:        "emitPrometheusAlerts",
+						CollectedAt: 1000,
+						Error:       "unexpected status code 503",
+					},
+				},
+			},
+		},
+		{
+			name:           "no snapshot recorded",
+			resourceID:     resourceID,
+			fixture:        clusterFixture,
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: NotFound: : No health snapshot has been recorded for the Resource 'openshiftclusters/resourcename' under resource group 'resourcegroup'.",
+		},
+		{
+			name:           "cluster not found",
+			resourceID:     testdatabase.GetResourcePath(mockSubID, "otherName"),
+			fixture:        clusterFixture,
+			wantStatusCode: http.StatusNotFound,
+			wantError:      "404: ResourceNotFound: : The Resource 'openshiftclusters/othername' under resource group 'resourcegroup' was not found.",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ti := newTestInfra(t).WithOpenShiftClusters().WithClusterHealthSnapshots()
+			defer ti.done()
+
+			err := ti.buildFixtures(tt.fixture)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			f, err := NewFrontend(ctx, ti.auditLog, ti.log, ti.otelAudit, ti.env, ti.dbGroup, api.APIs, &noop.Noop{}, &noop.Noop{}, nil, nil, nil, nil, nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			go f.Run(ctx, nil, nil)
+
+			resp, b, err := ti.request(http.MethodGet,
+				fmt.Sprintf("https://server/admin%s/healthsnapshot", tt.resourceID),
+				nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = validateResponse(resp, b, tt.wantStatusCode, tt.wantError, tt.wantResponse)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -50,6 +50,11 @@ func (f *frontend) _getAdminOpenShiftClusterSelectors(ctx context.Context, r *ht
 		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
 	}
 
+	dbClusterHealthSnapshots, err := f.dbGroup.ClusterHealthSnapshots()
+	if err != nil {
+		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
+	}
+
 	doc, err := dbOpenShiftClusters.Get(ctx, resourceID)
 	switch {
 	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
@@ -77,5 +82,16 @@ func (f *frontend) _getAdminOpenShiftClusterSelectors(ctx context.Context, r *ht
 		return nil, err
 	}
 
+	var snapshot *api.ClusterHealthSnapshot
+	snapshotDoc, err := dbClusterHealthSnapshots.Get(ctx, doc.ID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+	case err != nil:
+		return nil, api.NewCloudError(http.StatusInternalServerError, api.CloudErrorCodeInternalServerError, "", err.Error())
+	default:
+		snapshot = &snapshotDoc.ClusterHealthSnapshot
+	}
+	selectorData.AddHealthSnapshot(snapshot, f.now())
+
 	return json.Marshal(selectorData)
 }
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/metrics/noop"
@@ -20,6 +21,7 @@ import (
 func TestGetMIMOSelectors(t *testing.T) {
 	mockSubID := "00000000-0000-0000-0000-000000000000"
 	mockTenantID := "00000000-0000-0000-0000-000000000000"
+	mockDocID := "00000000-0000-0000-0000-000000000001"
 
 	ctx := context.Background()
 
@@ -37,20 +39,55 @@ func TestGetMIMOSelectors(t *testing.T) {
 			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
 			fixture: func(f *testdatabase.Fixture) {
 				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					ID:  mockDocID,
 					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					OpenShiftCluster: &api.OpenShiftCluster{
-						ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						ID:       testdatabase.GetResourcePath(mockSubID, "resourceName"),
+						Location: "EastUS",
 						Properties: api.OpenShiftClusterProperties{
 							ProvisioningState: api.ProvisioningStateSucceeded,
+							CreatedAt:         time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC),
 							NetworkProfile: api.NetworkProfile{
 								OutboundType: api.OutboundTypeLoadbalancer,
 							},
 							ClusterProfile: api.ClusterProfile{
 								ResourceGroupID: fmt.Sprintf("/subscriptions/%s/resourceGroups/test-cluster", mockSubID),
+								Version:         "4.14.16",
 							},
 							APIServerProfile: api.APIServerProfile{
 								Visibility: api.VisibilityPublic,
 							},
+							MasterProfile: api.MasterProfile{
+								VMSize: api.VMSizeStandardD8sV3,
+							},
+							WorkerProfiles: []api.WorkerProfile{
+								{VMSize: api.VMSizeStandardD4sV3},
+							},
+							WorkerProfilesStatus: []api.WorkerProfile{
+								{VMSize: api.VMSizeStandardD4sV5},
+								{VMSize: api.VMSizeStandardD4sV3},
+								{VMSize: api.VMSizeStandardD4sV5},
+							},
+							OperatorFlags: api.OperatorFlags{
+								"aro.imageconfig.enabled": "true",
+							},
+							MaintenanceWindow: &api.MaintenanceWindow{
+								DaysOfWeek:    []string{"Saturday"},
+								StartHour:     2,
+								DurationHours: 4,
+							},
+						},
+					},
+				})
+
+				f.AddClusterHealthSnapshotDocuments(&api.ClusterHealthSnapshotDocument{
+					ID: mockDocID,
+					ClusterHealthSnapshot: api.ClusterHealthSnapshot{
+						Collectors: []api.ClusterHealthCollectorResult{
+							{
+								Name:                      "emitCertificateInventory",
+								EarliestCertificateExpiry: time.Now().Add(10*24*time.Hour + time.Hour).Unix(),
+							},
 						},
 					},
 				})
@@ -68,16 +105,23 @@ func TestGetMIMOSelectors(t *testing.T) {
 			wantStatusCode: http.StatusOK,
 			wantResponse: func() []byte {
 				r, err := json.Marshal(map[string]string{
-					"subscriptionState":   "Registered",
-					"APIServerVisibility": "Public",
-					"architectureVersion": "0",
-					"bucketID":            "0",
-					"authenticationType":  "ServicePrincipal",
-					"isManagedDomain":     "false",
-					"outboundType":        "Loadbalancer",
-					"provisioningState":   "Succeeded",
-					"resourceID":          "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/resourcename",
-					"subscriptionID":      "00000000-0000-0000-0000-000000000000",
+					"subscriptionState":                     "Registered",
+					"APIServerVisibility":                   "Public",
+					"architectureVersion":                   "0",
+					"bucketID":                              "0",
+					"authenticationType":                    "ServicePrincipal",
+					"isManagedDomain":                       "false",
+					"outboundType":                          "Loadbalancer",
+					"provisioningState":                     "Succeeded",
+					"resourceID":                            "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/resourcename",
+					"subscriptionID":                        "00000000-0000-0000-0000-000000000000",
+					"version":                               "4.14.16",
+					"region":                                "eastus",
+					"createdAt":                             "2024-03-01T12:00:00Z",
+					"masterVMSize":                          "Standard_D8s_v3",
+					"workerVMSizes":                         "Standard_D4s_v3,Standard_D4s_v5",
+					"operatorFlags.aro.imageconfig.enabled": "true",
+					"certificatesDaysUntilExpiry":           "10",
 				})
 				if err != nil {
 					panic(err)
@@ -90,6 +134,7 @@ func TestGetMIMOSelectors(t *testing.T) {
 			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
 			fixture: func(f *testdatabase.Fixture) {
 				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					ID:  mockDocID,
 					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					OpenShiftCluster: &api.OpenShiftCluster{
 						ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
@@ -121,16 +166,22 @@ func TestGetMIMOSelectors(t *testing.T) {
 			wantStatusCode: http.StatusOK,
 			wantResponse: func() []byte {
 				r, err := json.Marshal(map[string]string{
-					"subscriptionState":   "Suspended",
-					"APIServerVisibility": "Public",
-					"architectureVersion": "0",
-					"bucketID":            "0",
-					"authenticationType":  "ServicePrincipal",
-					"isManagedDomain":     "false",
-					"outboundType":        "Loadbalancer",
-					"provisioningState":   "Succeeded",
-					"resourceID":          "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/resourcename",
-					"subscriptionID":      "00000000-0000-0000-0000-000000000000",
+					"subscriptionState":           "Suspended",
+					"APIServerVisibility":         "Public",
+					"architectureVersion":         "0",
+					"bucketID":                    "0",
+					"authenticationType":          "ServicePrincipal",
+					"isManagedDomain":             "false",
+					"outboundType":                "Loadbalancer",
+					"provisioningState":           "Succeeded",
+					"resourceID":                  "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/resourcename",
+					"subscriptionID":              "00000000-0000-0000-0000-000000000000",
+					"version":                     "",
+					"region":                      "",
+					"createdAt":                   "",
+					"masterVMSize":                "",
+					"workerVMSizes":               "",
+					"certificatesDaysUntilExpiry": "",
 				})
 				if err != nil {
 					panic(err)
@@ -140,7 +191,7 @@ func TestGetMIMOSelectors(t *testing.T) {
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
-			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions().WithClusterHealthSnapshots()
 			defer ti.done()
 
 			err := ti.buildFixtures(tt.fixture)
@@ -174,6 +225,7 @@ func TestGetMIMOSelectors(t *testing.T) {
 func TestGetMIMOSelectorsErrors(t *testing.T) {
 	mockSubID := "00000000-0000-0000-0000-000000000000"
 	mockTenantID := "00000000-0000-0000-0000-000000000000"
+	mockDocID := "00000000-0000-0000-0000-000000000001"
 
 	ctx := context.Background()
 
@@ -192,6 +244,7 @@ func TestGetMIMOSelectorsErrors(t *testing.T) {
 			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName2"),
 			fixture: func(f *testdatabase.Fixture) {
 				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					ID:  mockDocID,
 					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					OpenShiftCluster: &api.OpenShiftCluster{
 						ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
@@ -228,6 +281,7 @@ func TestGetMIMOSelectorsErrors(t *testing.T) {
 			resourceID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
 			fixture: func(f *testdatabase.Fixture) {
 				f.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
+					ID:  mockDocID,
 					Key: strings.ToLower(testdatabase.GetResourcePath(mockSubID, "resourceName")),
 					OpenShiftCluster: &api.OpenShiftCluster{
 						ID: testdatabase.GetResourcePath(mockSubID, "resourceName"),
@@ -251,7 +305,7 @@ func TestGetMIMOSelectorsErrors(t *testing.T) {
 		},
 	} {
 		t.Run(tt.name, func(t *testing.T) {
-			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions()
+			ti := newTestInfra(t).WithOpenShiftClusters().WithSubscriptions().WithClusterHealthSnapshots()
 			defer ti.done()
 
 			err := ti.buildFixtures(tt.fixture)
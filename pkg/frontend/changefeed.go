@@ -45,6 +45,32 @@ func (f *frontend) changefeedRoleSets(ctx context.Context) {
 	f.updateFromIteratorRoleSets(ctx, t, roleSetsIterator)
 }
 
+// changefeedMIMOClusters populates the MIMO Scheduler's cluster cache, once
+// startMIMOClusters is first called
+func (f *frontend) changefeedMIMOClusters(ctx context.Context) {
+	defer recover.Panic(f.baseLog)
+
+	select {
+	case <-f.mimoClustersNeeded:
+	case <-ctx.Done():
+		return
+	}
+
+	err := f.mimoClusters.Run(ctx, f.dbGroup, ctx.Done())
+	if err != nil {
+		f.baseLog.Warnf("not populating the MIMO cluster cache: %s", err)
+	}
+}
+
+// startMIMOClusters is called by each request which needs the MIMO
+// Scheduler's cluster cache, so that frontends which serve none do not follow
+// every cluster in the fleet
+func (f *frontend) startMIMOClusters() {
+	f.mimoClustersNeededOnce.Do(func() {
+		close(f.mimoClustersNeeded)
+	})
+}
+
 func (f *frontend) updateFromIteratorOcpVersions(ctx context.Context, ticker *time.Ticker, frontendIterator cosmosdb.OpenShiftVersionDocumentIterator) {
 	for {
 		successful := true
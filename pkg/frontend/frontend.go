@@ -34,6 +34,7 @@ import (
 	"github.com/Azure/ARO-RP/pkg/frontend/middleware"
 	"github.com/Azure/ARO-RP/pkg/hive"
 	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/mimo/scheduler"
 	"github.com/Azure/ARO-RP/pkg/util/azureclient/azuresdk/azsecrets"
 	"github.com/Azure/ARO-RP/pkg/util/bucket"
 	"github.com/Azure/ARO-RP/pkg/util/clusterdata"
@@ -62,6 +63,7 @@ type frontendDBs interface {
 	database.DatabaseGroupWithMaintenanceSchedules
 	database.DatabaseGroupWithBilling
 	database.DatabaseGroupWithPortal
+	database.DatabaseGroupWithClusterHealthSnapshots
 }
 
 type kubeActionsFactory func(*logrus.Entry, env.Interface, *api.OpenShiftCluster) (adminactions.KubeActions, error)
@@ -95,6 +97,13 @@ type frontend struct {
 	ocpVersionsMu                                  sync.RWMutex
 	platformWorkloadIdentityRoleSetsMu             sync.RWMutex
 
+	// mimoClusters is the MIMO Scheduler's cluster cache, against which
+	// maintenance schedules are simulated. As it follows every cluster in the
+	// fleet, it is only populated once startMIMOClusters is first called.
+	mimoClusters           *scheduler.ClusterCache
+	mimoClustersNeeded     chan struct{}
+	mimoClustersNeededOnce sync.Once
+
 	aead encryption.AEAD
 
 	hiveClusterManager    hive.ClusterManager
@@ -208,6 +217,9 @@ func NewFrontend(ctx context.Context,
 		enabledOcpVersions:                        map[string]*api.OpenShiftVersion{},
 		availablePlatformWorkloadIdentityRoleSets: map[string]*api.PlatformWorkloadIdentityRoleSet{},
 
+		mimoClusters:       scheduler.NewClusterCache(baseLog.WithField("component", "mimo-clusters"), m),
+		mimoClustersNeeded: make(chan struct{}),
+
 		bucketAllocator: &bucket.Random{},
 
 		startTime: time.Now(),
@@ -410,6 +422,7 @@ func (f *frontend) chiAuthenticatedRoutes(router chi.Router) {
 		r.Route("/maintenanceschedules", func(r chi.Router) {
 			r.Put("/", f.putAdminMaintScheduleCreate)
 			r.Get("/", f.getAdminMaintSchedules)
+			r.Post("/simulate", f.postAdminMaintScheduleSimulate)
 			r.Route("/{scheduleId}", func(r chi.Router) {
 				r.Get("/", f.getAdminMaintSchedule)
 			})
@@ -498,6 +511,12 @@ func (f *frontend) chiAuthenticatedRoutes(router chi.Router) {
 				})
 				r.Get("/selectors", f.getAdminOpenShiftClusterSelectors)
 
+				r.Get("/healthsnapshot", f.getAdminOpenShiftClusterHealthSnapshot)
+
+				r.Get("/etcdbackup", f.getAdminOpenShiftClusterEtcdBackup)
+
+				r.Get("/adminupdateplan", f.getAdminOpenShiftClusterAdminUpdatePlan)
+
 				r.Post("/investigate", f.postAdminOpenShiftClusterInvestigate)
 
 				// Kubeconfig tokens consumed by the portal binary's
@@ -564,6 +583,7 @@ func (f *frontend) Run(ctx context.Context, stop <-chan struct{}, done chan<- st
 	defer recover.Panic(f.baseLog)
 	go f.changefeedOcpVersions(ctx)
 	go f.changefeedRoleSets(ctx)
+	go f.changefeedMIMOClusters(ctx)
 
 	if stop != nil {
 		go func() {
@@ -32,18 +32,20 @@ func (mm MetricsMiddleware) Metrics(h http.Handler) http.Handler {
 		// get the route pattern that matched
 		rctx := chi.RouteContext(r.Context())
 		routePattern := strings.Join(rctx.RoutePatterns, "")
-		mm.EmitGauge("frontend.count", 1, map[string]string{
+		dims := map[string]string{
 			"verb":        r.Method,
 			"api-version": apiVersion,
 			"code":        strconv.Itoa(w.(*logResponseWriter).statusCode),
 			"route":       routePattern,
-		})
+		}
+		duration := time.Since(t).Milliseconds()
 
-		mm.EmitGauge("frontend.duration", time.Since(t).Milliseconds(), map[string]string{
-			"verb":        r.Method,
-			"api-version": apiVersion,
-			"code":        strconv.Itoa(w.(*logResponseWriter).statusCode),
-			"route":       routePattern,
-		})
+		// frontend.count and frontend.duration are kept as gauges for the
+		// existing Geneva dashboards and alerts
+		mm.EmitGauge("frontend.count", 1, dims)
+		mm.EmitGauge("frontend.duration", duration, dims)
+
+		mm.EmitCounter("frontend.requests.total", 1, dims)
+		mm.EmitHistogram("frontend.request.duration.milliseconds", float64(duration), metrics.DurationMillisecondsBuckets, dims)
 	})
 }
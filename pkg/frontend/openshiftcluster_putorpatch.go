@@ -504,6 +504,7 @@ func adminUpdateProvisioningState(doc *api.OpenShiftClusterDocument) {
 		doc.Dequeues = 0
 
 		// Set the maintenance to ongoing so we emit the appropriate signal to customerss
+		doc.OpenShiftCluster.Properties.MaintenanceStateSetBySchedule = false
 		if doc.OpenShiftCluster.Properties.MaintenanceState == api.MaintenanceStatePending {
 			doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStatePlanned
 		} else {
@@ -514,10 +515,13 @@ func adminUpdateProvisioningState(doc *api.OpenShiftClusterDocument) {
 		switch doc.OpenShiftCluster.Properties.MaintenanceTask {
 		case api.MaintenanceTaskPending:
 			doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStatePending
+			doc.OpenShiftCluster.Properties.MaintenanceStateSetBySchedule = false
 		case api.MaintenanceTaskNone:
 			doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStateNone
+			doc.OpenShiftCluster.Properties.MaintenanceStateSetBySchedule = false
 		case api.MaintenanceTaskCustomerActionNeeded:
 			doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStateCustomerActionNeeded
+			doc.OpenShiftCluster.Properties.MaintenanceStateSetBySchedule = false
 		}
 
 		// This enables future admin update actions with body `{}` to succeed
@@ -101,6 +101,8 @@ type testInfra struct {
 	maintenanceSchedulesDatabase             database.MaintenanceSchedules
 	portalClient                             *cosmosdb.FakePortalDocumentClient
 	portalDatabase                           database.Portal
+	clusterHealthSnapshotsClient             *cosmosdb.FakeClusterHealthSnapshotDocumentClient
+	clusterHealthSnapshotsDatabase           database.ClusterHealthSnapshots
 }
 
 func newTestInfra(t *testing.T) *testInfra {
@@ -246,6 +248,13 @@ func (ti *testInfra) WithPortal() *testInfra {
 	return ti
 }
 
+func (ti *testInfra) WithClusterHealthSnapshots() *testInfra {
+	ti.clusterHealthSnapshotsDatabase, ti.clusterHealthSnapshotsClient = testdatabase.NewFakeClusterHealthSnapshots()
+	ti.fixture.WithClusterHealthSnapshots(ti.clusterHealthSnapshotsDatabase)
+	ti.dbGroup.WithClusterHealthSnapshots(ti.clusterHealthSnapshotsDatabase)
+	return ti
+}
+
 func (ti *testInfra) done() {
 	ti.controller.Finish()
 	ti.cli.CloseIdleConnections()
@@ -183,8 +183,17 @@ func NewGateway(ctx context.Context, env env.Core, baseLog, accessLog *logrus.En
 	chiRouter.Get("/healthz/ready", http.HandlerFunc(g.checkReady))
 	chiRouter.Connect("/*", http.HandlerFunc(g.handleConnect))
 
+	healthRouter := chi.NewMux()
+	healthRouter.Use(panicMiddleware)
+
+	healthRouter.Get("/healthz/ready", http.HandlerFunc(g.checkReady))
+	if h := metrics.Handler(m); h != nil {
+		healthRouter.Handle("/metrics", h)
+	}
+	healthRouter.Connect("/*", http.HandlerFunc(g.handleConnect))
+
 	g.server.Handler = chiRouter
-	g.healthServer.Handler = chiRouter
+	g.healthServer.Handler = healthRouter
 
 	g.ready.Store(true)
 
@@ -0,0 +1,65 @@
+package fanout
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"maps"
+	"net/http"
+
+	"github.com/Azure/ARO-RP/pkg/metrics"
+)
+
+// Fanout is a metrics.Emitter which emits to each of its Emitters, e.g. to
+// both statsd and Prometheus
+type Fanout []metrics.Emitter
+
+var (
+	_ metrics.Emitter   = Fanout{}
+	_ metrics.Scrapable = Fanout{}
+)
+
+// New returns a new metrics.Emitter emitting to each of `emitters`
+func New(emitters ...metrics.Emitter) Fanout {
+	return Fanout(emitters)
+}
+
+// EmitFloat emits to each of the Emitters. Each is given its own copy of the
+// dimensions, as some add their own dimensions to them.
+func (f Fanout) EmitFloat(metricName string, metricValue float64, dimensions map[string]string) {
+	for _, m := range f {
+		m.EmitFloat(metricName, metricValue, maps.Clone(dimensions))
+	}
+}
+
+// EmitGauge emits to each of the Emitters
+func (f Fanout) EmitGauge(metricName string, metricValue int64, dimensions map[string]string) {
+	for _, m := range f {
+		m.EmitGauge(metricName, metricValue, maps.Clone(dimensions))
+	}
+}
+
+// EmitCounter emits to each of the Emitters
+func (f Fanout) EmitCounter(metricName string, metricValue int64, dimensions map[string]string) {
+	for _, m := range f {
+		m.EmitCounter(metricName, metricValue, maps.Clone(dimensions))
+	}
+}
+
+// EmitHistogram emits to each of the Emitters
+func (f Fanout) EmitHistogram(metricName string, metricValue float64, buckets []float64, dimensions map[string]string) {
+	for _, m := range f {
+		m.EmitHistogram(metricName, metricValue, buckets, maps.Clone(dimensions))
+	}
+}
+
+// Handler returns the handler of the first of the Emitters which can be
+// scraped, or nil if none can
+func (f Fanout) Handler() http.Handler {
+	for _, m := range f {
+		if h := metrics.Handler(m); h != nil {
+			return h
+		}
+	}
+	return nil
+}
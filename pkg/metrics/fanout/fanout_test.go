@@ -0,0 +1,41 @@
+package fanout
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testmetrics "github.com/Azure/ARO-RP/test/util/metrics"
+)
+
+func TestFanout(t *testing.T) {
+	m1 := testmetrics.NewFakeMetricsEmitter(t)
+	m2 := testmetrics.NewFakeMetricsEmitter(t)
+
+	f := New(m1, m2, &noop.Noop{})
+
+	dims := map[string]string{"key": "value"}
+	f.EmitGauge("tests.gauge", 42, dims)
+	f.EmitFloat("tests.float", 0.5, dims)
+	f.EmitCounter("tests.counter", 1, dims)
+	f.EmitHistogram("tests.histogram", 0.5, metrics.DurationSecondsBuckets, dims)
+
+	for _, m := range []interface {
+		AssertGauges(...testmetrics.MetricsAssertion[int64])
+		AssertFloats(...testmetrics.MetricsAssertion[float64])
+		AssertCounters(...testmetrics.MetricsAssertion[int64])
+		AssertHistograms(...testmetrics.MetricsAssertion[[]float64])
+	}{m1, m2} {
+		m.AssertGauges(testmetrics.MetricsAssertion[int64]{MetricName: "tests.gauge", Dimensions: dims, Value: 42})
+		m.AssertFloats(testmetrics.MetricsAssertion[float64]{MetricName: "tests.float", Dimensions: dims, Value: 0.5})
+		m.AssertCounters(testmetrics.MetricsAssertion[int64]{MetricName: "tests.counter", Dimensions: dims, Value: 1})
+		m.AssertHistograms(testmetrics.MetricsAssertion[[]float64]{MetricName: "tests.histogram", Dimensions: dims, Value: []float64{0.5}})
+	}
+
+	if metrics.Handler(f) != nil {
+		t.Error("expected no handler, as none of the emitters can be scraped")
+	}
+}
@@ -3,8 +3,44 @@ package metrics
 // Copyright (c) Microsoft Corporation.
 // Licensed under the Apache License 2.0.
 
+import "net/http"
+
+var (
+	// DurationSecondsBuckets are histogram buckets for durations measured in
+	// seconds, from 5ms to a minute
+	DurationSecondsBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10, 30, 60}
+
+	// DurationMillisecondsBuckets are histogram buckets for durations measured
+	// in milliseconds, from 5ms to a minute
+	DurationMillisecondsBuckets = []float64{5, 10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000, 30000, 60000}
+)
+
 // Emitter emits different types of metrics
 type Emitter interface {
 	EmitFloat(metricName string, metricValue float64, dimensions map[string]string)
 	EmitGauge(metricName string, metricValue int64, dimensions map[string]string)
+
+	// EmitCounter increments a counter by metricValue
+	EmitCounter(metricName string, metricValue int64, dimensions map[string]string)
+
+	// EmitHistogram records an observation of metricValue in a distribution.
+	// buckets are the ascending upper bounds of the distribution's buckets,
+	// for Emitters which aggregate observations themselves; the buckets of a
+	// metric must not change between emits.
+	EmitHistogram(metricName string, metricValue float64, buckets []float64, dimensions map[string]string)
+}
+
+// Scrapable is implemented by Emitters whose metrics are scraped over HTTP
+// rather than pushed
+type Scrapable interface {
+	Handler() http.Handler
+}
+
+// Handler returns the handler serving the metrics emitted to m, or nil if m
+// cannot be scraped
+func Handler(m Emitter) http.Handler {
+	if s, ok := m.(Scrapable); ok {
+		return s.Handler()
+	}
+	return nil
 }
@@ -7,3 +7,6 @@ type Noop struct{}
 
 func (c *Noop) EmitFloat(metricName string, metricValue float64, dimensions map[string]string) {}
 func (c *Noop) EmitGauge(metricName string, metricValue int64, dimensions map[string]string)   {}
+func (c *Noop) EmitCounter(metricName string, metricValue int64, dimensions map[string]string) {}
+func (c *Noop) EmitHistogram(metricName string, metricValue float64, buckets []float64, dimensions map[string]string) {
+}
@@ -0,0 +1,338 @@
+package prometheus
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"maps"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	prom "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+)
+
+const (
+	namespace = "aro"
+
+	// DefaultMaxSeriesPerMetric is the number of distinct sets of dimensions
+	// kept for each metric, unless New is given another. Series for further
+	// sets of dimensions are dropped until existing ones expire.
+	DefaultMaxSeriesPerMetric = 1000
+
+	// defaultSeriesTTL is how long a series is kept after it was last emitted,
+	// so that series for deleted clusters and the like are not scraped
+	// forever.
+	defaultSeriesTTL = 10 * time.Minute
+)
+
+// Prometheus is a metrics.Emitter which keeps the last emitted value of each
+// gauge, the total of each counter and the distribution of each histogram for
+// each set of dimensions, and serves them for Prometheus to scrape.
+type Prometheus struct {
+	log *logrus.Entry
+
+	// extraDimensions are values added to every emit (e.g. location)
+	extraDimensions map[string]string
+
+	maxSeriesPerMetric int
+	seriesTTL          time.Duration
+
+	registry *prom.Registry
+
+	mu      sync.Mutex
+	metrics map[string]*metric
+
+	now func() time.Time
+}
+
+type metric struct {
+	valueType prom.ValueType
+
+	// buckets are the sorted upper bounds of the buckets of a histogram
+	buckets []float64
+
+	series map[string]*series
+
+	// dropped is the number of emits dropped as the metric had too many
+	// series
+	dropped int64
+
+	// mismatched is set once an emit of the wrong type has been logged
+	mismatched bool
+}
+
+type series struct {
+	labels map[string]string
+
+	// value is the last emitted value of a gauge, the total of a counter or
+	// the sum of the observations of a histogram
+	value float64
+
+	// count and bucketCounts are the number of observations of a histogram,
+	// in total and less than or equal to each bucket's upper bound
+	count        uint64
+	bucketCounts []uint64
+
+	updated time.Time
+}
+
+// histogram is the ValueType used internally for histograms, which have no
+// ValueType of their own
+const histogram prom.ValueType = -1
+
+var (
+	_ metrics.Emitter   = &Prometheus{}
+	_ metrics.Scrapable = &Prometheus{}
+	_ prom.Collector    = &Prometheus{}
+)
+
+// New returns a new Prometheus emitter which keeps up to maxSeriesPerMetric
+// series for each metric
+func New(env env.Core, maxSeriesPerMetric int) *Prometheus {
+	p := newPrometheus(env.LoggerForComponent("prometheus"), map[string]string{
+		"location":    env.Location(),
+		"service":     env.Service(),
+		"version":     version.GitCommit,
+		"Environment": env.EnvironmentType(),
+	})
+	p.maxSeriesPerMetric = maxSeriesPerMetric
+
+	return p
+}
+
+func newPrometheus(log *logrus.Entry, extraDimensions map[string]string) *Prometheus {
+	p := &Prometheus{
+		log: log,
+
+		extraDimensions: extraDimensions,
+
+		maxSeriesPerMetric: DefaultMaxSeriesPerMetric,
+		seriesTTL:          defaultSeriesTTL,
+
+		registry: prom.NewRegistry(),
+		metrics:  map[string]*metric{},
+
+		now: time.Now,
+	}
+
+	p.registry.MustRegister(p)
+
+	return p
+}
+
+// EmitFloat records float information
+func (p *Prometheus) EmitFloat(metricName string, metricValue float64, dimensions map[string]string) {
+	p.emitMetric(metricName, prom.GaugeValue, nil, dimensions, func(m *metric, s *series) {
+		s.value = metricValue
+	})
+}
+
+// EmitGauge records gauge information
+func (p *Prometheus) EmitGauge(metricName string, metricValue int64, dimensions map[string]string) {
+	p.emitMetric(metricName, prom.GaugeValue, nil, dimensions, func(m *metric, s *series) {
+		s.value = float64(metricValue)
+	})
+}
+
+// EmitCounter records counter information
+func (p *Prometheus) EmitCounter(metricName string, metricValue int64, dimensions map[string]string) {
+	p.emitMetric(metricName, prom.CounterValue, nil, dimensions, func(m *metric, s *series) {
+		s.value += float64(metricValue)
+	})
+}
+
+// EmitHistogram records histogram information. The buckets of the first emit
+// of each metric are used for all of its series.
+func (p *Prometheus) EmitHistogram(metricName string, metricValue float64, buckets []float64, dimensions map[string]string) {
+	p.emitMetric(metricName, histogram, buckets, dimensions, func(m *metric, s *series) {
+		s.value += metricValue
+		s.count++
+
+		// buckets count the observations at or below their upper bound, so
+		// each bucket from the first that the observation fits in is
+		// incremented
+		for i := sort.SearchFloat64s(m.buckets, metricValue); i < len(m.buckets); i++ {
+			s.bucketCounts[i]++
+		}
+	})
+}
+
+// Handler returns the handler serving the emitted metrics
+func (p *Prometheus) Handler() http.Handler {
+	return promhttp.HandlerFor(p.registry, promhttp.HandlerOpts{
+		ErrorLog: p.log,
+	})
+}
+
+func (p *Prometheus) emitMetric(metricName string, valueType prom.ValueType, buckets []float64, dimensions map[string]string, update func(*metric, *series)) {
+	labels := make(map[string]string, len(dimensions)+len(p.extraDimensions))
+	for k, v := range dimensions {
+		labels[sanitizeName(k)] = v
+	}
+	for k, v := range p.extraDimensions {
+		labels[sanitizeName(k)] = v
+	}
+
+	name := namespace + "_" + sanitizeName(metricName)
+	key := seriesKey(labels)
+	now := p.now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	m, ok := p.metrics[name]
+	if !ok {
+		m = &metric{valueType: valueType, series: map[string]*series{}}
+		if valueType == histogram {
+			m.buckets = slices.Clone(buckets)
+			slices.Sort(m.buckets)
+		}
+		p.metrics[name] = m
+	}
+
+	if m.valueType != valueType {
+		if !m.mismatched {
+			p.log.Warnf("metric %s was emitted as more than one type, dropping emits of other types", metricName)
+			m.mismatched = true
+		}
+		return
+	}
+
+	s, ok := m.series[key]
+	if !ok {
+		p.expire(m, now)
+		if len(m.series) >= p.maxSeriesPerMetric {
+			if m.dropped == 0 {
+				p.log.Warnf("metric %s has more than %d series, dropping new series", metricName, p.maxSeriesPerMetric)
+			}
+			m.dropped++
+			return
+		}
+
+		s = &series{labels: labels, bucketCounts: make([]uint64, len(m.buckets))}
+		m.series[key] = s
+	}
+
+	update(m, s)
+	s.updated = now
+}
+
+// Describe sends no descriptors, as the dimensions of each metric are only
+// known once they have been emitted.
+func (p *Prometheus) Describe(chan<- *prom.Desc) {}
+
+// Collect sends the value of each unexpired series. As metrics
+// may be emitted with different dimensions, each series has the labels of all
+// of the dimensions of its metric, which are empty if it was emitted without
+// them.
+func (p *Prometheus) Collect(ch chan<- prom.Metric) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	dropped := prom.NewDesc(namespace+"_metrics_dropped_series", "Number of emits dropped as their metric had too many series.", []string{"metric"}, nil)
+
+	for _, name := range slices.Sorted(maps.Keys(p.metrics)) {
+		m := p.metrics[name]
+
+		p.expire(m, now)
+		if m.dropped > 0 {
+			ch <- prom.MustNewConstMetric(dropped, prom.GaugeValue, float64(m.dropped), name)
+		}
+		if len(m.series) == 0 {
+			delete(p.metrics, name)
+			continue
+		}
+
+		labelNames := map[string]struct{}{}
+		for _, s := range m.series {
+			for k := range s.labels {
+				labelNames[k] = struct{}{}
+			}
+		}
+		sortedLabelNames := slices.Sorted(maps.Keys(labelNames))
+
+		desc := prom.NewDesc(name, help(name, m.valueType), sortedLabelNames, nil)
+		for _, key := range slices.Sorted(maps.Keys(m.series)) {
+			s := m.series[key]
+
+			labelValues := make([]string, 0, len(sortedLabelNames))
+			for _, k := range sortedLabelNames {
+				labelValues = append(labelValues, s.labels[k])
+			}
+
+			var cm prom.Metric
+			var err error
+			if m.valueType == histogram {
+				buckets := make(map[float64]uint64, len(m.buckets))
+				for i, upperBound := range m.buckets {
+					buckets[upperBound] = s.bucketCounts[i]
+				}
+				cm, err = prom.NewConstHistogram(desc, s.count, s.value, buckets, labelValues...)
+			} else {
+				cm, err = prom.NewConstMetric(desc, m.valueType, s.value, labelValues...)
+			}
+			if err != nil {
+				p.log.Error(err)
+				continue
+			}
+			ch <- cm
+		}
+	}
+}
+
+func help(name string, valueType prom.ValueType) string {
+	switch valueType {
+	case prom.CounterValue:
+		return "Total of the values emitted to " + name + "."
+	case histogram:
+		return "Distribution of the values emitted to " + name + "."
+	default:
+		return "Last emitted value of " + name + "."
+	}
+}
+
+// expire removes the series of m which have not been emitted within the TTL.
+// The caller must hold p.mu.
+func (p *Prometheus) expire(m *metric, now time.Time) {
+	for key, s := range m.series {
+		if now.Sub(s.updated) > p.seriesTTL {
+			delete(m.series, key)
+		}
+	}
+}
+
+// seriesKey returns a string uniquely identifying a set of labels
+func seriesKey(labels map[string]string) string {
+	sb := &strings.Builder{}
+	for _, k := range slices.Sorted(maps.Keys(labels)) {
+		sb.WriteString(k)
+		sb.WriteByte('=')
+		sb.WriteString(labels[k])
+		sb.WriteByte(0)
+	}
+	return sb.String()
+}
+
+// sanitizeName turns a metric or dimension name such as
+// "mimo.scheduler.manifests.created" into a valid Prometheus name such as
+// "mimo_scheduler_manifests_created".
+func sanitizeName(s string) string {
+	b := []byte(s)
+	for i, c := range b {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c >= '0' && c <= '9' && i > 0) {
+			b[i] = '_'
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,169 @@
+package prometheus
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestEmit(t *testing.T) {
+	_, log := testlog.New()
+
+	p := newPrometheus(log, map[string]string{"location": "eastus"})
+
+	p.EmitGauge("tests.test_key", 42, map[string]string{"key": "value"})
+	p.EmitGauge("tests.test_key", 43, map[string]string{"key": "value"})
+	p.EmitGauge("tests.test_key", 1, map[string]string{"other.key": "other"})
+	p.EmitFloat("tests.float", 0.5, nil)
+
+	err := testutil.GatherAndCompare(p.registry, strings.NewReader(`
+# HELP aro_tests_float Last emitted value of aro_tests_float.
+# TYPE aro_tests_float gauge
+aro_tests_float{location="eastus"} 0.5
+# HELP aro_tests_test_key Last emitted value of aro_tests_test_key.
+# TYPE aro_tests_test_key gauge
+aro_tests_test_key{key="",location="eastus",other_key="other"} 1
+aro_tests_test_key{key="value",location="eastus",other_key=""} 43
+`))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEmitCounter(t *testing.T) {
+	_, log := testlog.New()
+
+	p := newPrometheus(log, nil)
+
+	p.EmitCounter("tests.count", 1, map[string]string{"code": "200"})
+	p.EmitCounter("tests.count", 2, map[string]string{"code": "200"})
+	p.EmitCounter("tests.count", 1, map[string]string{"code": "500"})
+
+	// emits of another type are dropped
+	p.EmitGauge("tests.count", 42, map[string]string{"code": "200"})
+
+	err := testutil.GatherAndCompare(p.registry, strings.NewReader(`
+# HELP aro_tests_count Total of the values emitted to aro_tests_count.
+# TYPE aro_tests_count counter
+aro_tests_count{code="200"} 3
+aro_tests_count{code="500"} 1
+`))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestEmitHistogram(t *testing.T) {
+	_, log := testlog.New()
+
+	p := newPrometheus(log, nil)
+
+	p.EmitHistogram("tests.duration", 0.5, []float64{10, 1}, nil)
+	p.EmitHistogram("tests.duration", 1, []float64{10, 1}, nil)
+	p.EmitHistogram("tests.duration", 5, []float64{10, 1}, nil)
+	p.EmitHistogram("tests.duration", 20, []float64{10, 1}, nil)
+
+	err := testutil.GatherAndCompare(p.registry, strings.NewReader(`
+# HELP aro_tests_duration Distribution of the values emitted to aro_tests_duration.
+# TYPE aro_tests_duration histogram
+aro_tests_duration_bucket{le="1"} 2
+aro_tests_duration_bucket{le="10"} 3
+aro_tests_duration_bucket{le="+Inf"} 4
+aro_tests_duration_sum 26.5
+aro_tests_duration_count 4
+`))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCardinalityLimit(t *testing.T) {
+	_, log := testlog.New()
+
+	now := time.Unix(0, 0)
+	p := newPrometheus(log, nil)
+	p.maxSeriesPerMetric = 2
+	p.now = func() time.Time { return now }
+
+	p.EmitGauge("tests.test_key", 1, map[string]string{"cluster": "a"})
+	p.EmitGauge("tests.test_key", 2, map[string]string{"cluster": "b"})
+	p.EmitGauge("tests.test_key", 3, map[string]string{"cluster": "c"})
+	p.EmitGauge("tests.test_key", 4, map[string]string{"cluster": "a"})
+
+	err := testutil.GatherAndCompare(p.registry, strings.NewReader(`
+# HELP aro_metrics_dropped_series Number of emits dropped as their metric had too many series.
+# TYPE aro_metrics_dropped_series gauge
+aro_metrics_dropped_series{metric="aro_tests_test_key"} 1
+# HELP aro_tests_test_key Last emitted value of aro_tests_test_key.
+# TYPE aro_tests_test_key gauge
+aro_tests_test_key{cluster="a"} 4
+aro_tests_test_key{cluster="b"} 2
+`))
+	if err != nil {
+		t.Error(err)
+	}
+
+	// Once "b" expires, there is room for "c"
+	now = now.Add(defaultSeriesTTL / 2)
+	p.EmitGauge("tests.test_key", 5, map[string]string{"cluster": "a"})
+	now = now.Add(defaultSeriesTTL/2 + time.Second)
+	p.EmitGauge("tests.test_key", 6, map[string]string{"cluster": "c"})
+
+	err = testutil.GatherAndCompare(p.registry, strings.NewReader(`
+# HELP aro_metrics_dropped_series Number of emits dropped as their metric had too many series.
+# TYPE aro_metrics_dropped_series gauge
+aro_metrics_dropped_series{metric="aro_tests_test_key"} 1
+# HELP aro_tests_test_key Last emitted value of aro_tests_test_key.
+# TYPE aro_tests_test_key gauge
+aro_tests_test_key{cluster="a"} 5
+aro_tests_test_key{cluster="c"} 6
+`))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	_, log := testlog.New()
+
+	p := newPrometheus(log, nil)
+	p.EmitGauge("tests.test_key", 42, nil)
+
+	w := httptest.NewRecorder()
+	p.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+
+	b, err := io.ReadAll(w.Result().Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), "aro_tests_test_key 42\n") {
+		t.Error(string(b))
+	}
+}
+
+func TestSanitizeName(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		want string
+	}{
+		{name: "mimo.scheduler.manifests.created", want: "mimo_scheduler_manifests_created"},
+		{name: "Environment", want: "Environment"},
+		{name: "resource-id", want: "resource_id"},
+		{name: "2xx", want: "_xx"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitizeName(tt.name); got != tt.want {
+				t.Errorf("got %s, wanted %s", got, tt.want)
+			}
+		})
+	}
+}
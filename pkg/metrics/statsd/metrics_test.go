@@ -47,3 +47,41 @@ func TestMarshalGauge(t *testing.T) {
 		t.Errorf("unexpected marshal output %s", string(b))
 	}
 }
+
+func TestMarshalCounter(t *testing.T) {
+	c := metric{
+		name:       "metric",
+		namespace:  "namespace",
+		dimensions: map[string]string{"key": "value"},
+
+		timestamp:    time.Unix(0, 0),
+		valueCounter: pointerutils.ToPtr(int64(3)),
+	}
+	b, err := c.marshalStatsd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `{"Metric":"metric","Namespace":"namespace","Dims":{"key":"value"},"TS":"1970-01-01T00:00:00.000"}:3|c`+"\n" {
+		t.Errorf("unexpected marshal output %s", string(b))
+	}
+}
+
+func TestMarshalHistogram(t *testing.T) {
+	h := metric{
+		name:       "metric",
+		namespace:  "namespace",
+		dimensions: map[string]string{"key": "value"},
+
+		timestamp:      time.Unix(0, 0),
+		valueHistogram: pointerutils.ToPtr(0.25),
+	}
+	b, err := h.marshalStatsd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(b) != `{"Metric":"metric","Namespace":"namespace","Dims":{"key":"value"},"TS":"1970-01-01T00:00:00.000"}:0.250000|h`+"\n" {
+		t.Errorf("unexpected marshal output %s", string(b))
+	}
+}
@@ -41,6 +41,11 @@ func TestActuatorLogic(t *testing.T) {
 		wantDidWork bool
 		wantErr     error
 		wantLogs    []testlog.ExpectedLogEntry
+
+		maintenanceWindow             *api.MaintenanceWindow
+		maintenanceState              api.MaintenanceState
+		maintenanceStateSetBySchedule bool
+		wantMaintenanceState          api.MaintenanceState
 	}{
 		{
 			desc: "old manifests are expired",
@@ -612,6 +617,582 @@ func TestActuatorLogic(t *testing.T) {
 			},
 			wantDidWork: true,
 		},
+		{
+			desc: "manifest runs after its prerequisite completes",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							RunBefore:         600,
+							RunAfter:          0,
+						},
+					},
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							RunBefore:         600,
+							RunAfter:          1,
+							Prerequisites:     []string{manifestID1},
+						},
+					},
+				)
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID1,
+						Dequeues:          1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStateCompleted,
+							MaintenanceTaskID: "0",
+							StatusText:        "done",
+							RunBefore:         600,
+							RunAfter:          0,
+						},
+					},
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						Dequeues:          1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStateCompleted,
+							MaintenanceTaskID: "0",
+							StatusText:        "done",
+							RunBefore:         600,
+							RunAfter:          1,
+							Prerequisites:     []string{manifestID1},
+						},
+					},
+				)
+			},
+			wantDidWork: true,
+		},
+		{
+			desc: "manifest with incomplete prerequisite is skipped",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							RunBefore:         600,
+							RunAfter:          0,
+							Prerequisites:     []string{manifestID2},
+						},
+					},
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							RunBefore:         600,
+							RunAfter:          1,
+						},
+					},
+				)
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							RunBefore:         600,
+							RunAfter:          0,
+							Prerequisites:     []string{manifestID2},
+						},
+					},
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						Dequeues:          1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStateCompleted,
+							MaintenanceTaskID: "0",
+							StatusText:        "done",
+							RunBefore:         600,
+							RunAfter:          1,
+						},
+					},
+				)
+			},
+			wantDidWork: true,
+		},
+		{
+			desc: "manifest with failed prerequisite is failed",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStateFailed,
+							MaintenanceTaskID: "0",
+							RunBefore:         600,
+							RunAfter:          0,
+						},
+					},
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							RunBefore:         600,
+							RunAfter:          0,
+							Prerequisites:     []string{manifestID1},
+						},
+					},
+				)
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStateFailed,
+							MaintenanceTaskID: "0",
+							RunBefore:         600,
+							RunAfter:          0,
+						},
+					},
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStateFailed,
+							MaintenanceTaskID: "0",
+							StatusText:        "prerequisite manifest " + manifestID1 + " (0) ended in state Failed",
+							RunBefore:         600,
+							RunAfter:          0,
+							Prerequisites:     []string{manifestID1},
+						},
+					},
+				)
+			},
+			wantDidWork: false,
+		},
+		{
+			desc: "manifest with missing prerequisite is failed",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							RunBefore:         600,
+							RunAfter:          0,
+							Prerequisites:     []string{manifestID3},
+						},
+					},
+				)
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStateFailed,
+							MaintenanceTaskID: "0",
+							StatusText:        "prerequisite manifest " + manifestID3 + " not found",
+							RunBefore:         600,
+							RunAfter:          0,
+							Prerequisites:     []string{manifestID3},
+						},
+					},
+				)
+			},
+			wantDidWork: false,
+		},
+		{
+			desc: "scheduled manifest outside of the maintenance window does not run",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			// 1970-01-01 is a Thursday
+			maintenanceWindow: &api.MaintenanceWindow{
+				DaysOfWeek:    []string{"Saturday"},
+				DurationHours: 4,
+			},
+			maintenanceState:              api.MaintenanceStatePending,
+			maintenanceStateSetBySchedule: true,
+			wantMaintenanceState:          api.MaintenanceStatePending,
+			wantDidWork:                   false,
+			wantLogs: []testlog.ExpectedLogEntry{
+				{
+					"level": Equal(logrus.InfoLevel),
+					"msg":   Equal("Processing 1 manifests"),
+				},
+				{
+					"level":      Equal(logrus.InfoLevel),
+					"msg":        Equal("begin processing manifest"),
+					"manifestID": Equal(manifestID1),
+				},
+				{
+					"level":      Equal(logrus.InfoLevel),
+					"msg":        Equal("outside of the cluster's maintenance window, skipping"),
+					"manifestID": Equal(manifestID1),
+				},
+			},
+		},
+		{
+			desc: "scheduled manifest within the maintenance window runs and clears the pending maintenance state",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					Dequeues:          1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStateCompleted,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						StatusText:        "done",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			maintenanceWindow: &api.MaintenanceWindow{
+				DaysOfWeek:    []string{"Thursday"},
+				DurationHours: 4,
+			},
+			maintenanceState:              api.MaintenanceStatePending,
+			maintenanceStateSetBySchedule: true,
+			wantMaintenanceState:          api.MaintenanceStateNone,
+			wantDidWork:                   true,
+		},
+		{
+			desc: "manifest not created by a schedule runs outside of the maintenance window",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						MaintenanceTaskID: "0",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					Dequeues:          1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStateCompleted,
+						MaintenanceTaskID: "0",
+						StatusText:        "done",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			maintenanceWindow: &api.MaintenanceWindow{
+				DaysOfWeek:    []string{"Saturday"},
+				DurationHours: 4,
+			},
+			maintenanceState:              api.MaintenanceStatePending,
+			maintenanceStateSetBySchedule: true,
+			wantMaintenanceState:          api.MaintenanceStatePending,
+			wantDidWork:                   true,
+		},
+		{
+			desc: "scheduled manifest timing out clears the pending maintenance state",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						RunBefore:         60,
+						RunAfter:          0,
+					},
+				})
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStateTimedOut,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						StatusText:        "timed out at 1970-01-01 00:02:00 +0000 UTC",
+						RunBefore:         60,
+						RunAfter:          0,
+					},
+				})
+			},
+			// 1970-01-01 is a Thursday
+			maintenanceWindow: &api.MaintenanceWindow{
+				DaysOfWeek:    []string{"Saturday"},
+				DurationHours: 4,
+			},
+			maintenanceState:              api.MaintenanceStatePending,
+			maintenanceStateSetBySchedule: true,
+			wantMaintenanceState:          api.MaintenanceStateNone,
+			wantDidWork:                   false,
+		},
+		{
+			desc: "scheduled manifest failing clears the pending maintenance state",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					Dequeues:          1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStateFailed,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			tasks: func(r *require.Assertions) map[api.MIMOTaskID]tasks.MaintenanceTask {
+				return map[api.MIMOTaskID]tasks.MaintenanceTask{
+					"0": func(th mimo.TaskContext, mmd *api.MaintenanceManifestDocument, oscd *api.OpenShiftClusterDocument) error {
+						return mimo.TerminalError(errors.New("oh no"))
+					},
+				}
+			},
+			maintenanceWindow: &api.MaintenanceWindow{
+				DaysOfWeek:    []string{"Thursday"},
+				DurationHours: 4,
+			},
+			maintenanceState:              api.MaintenanceStatePending,
+			maintenanceStateSetBySchedule: true,
+			wantMaintenanceState:          api.MaintenanceStateNone,
+			wantDidWork:                   true,
+		},
+		{
+			desc: "pending maintenance state is kept while another scheduled manifest is queued",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							CreatedBySchedule: "schedule",
+							RunBefore:         600,
+							RunAfter:          0,
+						},
+					},
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							CreatedBySchedule: "schedule",
+							RunBefore:         86400 * 8,
+							RunAfter:          86400 * 7,
+						},
+					},
+				)
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID1,
+						Dequeues:          1,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStateCompleted,
+							MaintenanceTaskID: "0",
+							CreatedBySchedule: "schedule",
+							StatusText:        "done",
+							RunBefore:         600,
+							RunAfter:          0,
+						},
+					},
+					&api.MaintenanceManifestDocument{
+						ID:                manifestID2,
+						ClusterResourceID: strings.ToLower(clusterResourceID),
+						MaintenanceManifest: api.MaintenanceManifest{
+							State:             api.MaintenanceManifestStatePending,
+							MaintenanceTaskID: "0",
+							CreatedBySchedule: "schedule",
+							RunBefore:         86400 * 8,
+							RunAfter:          86400 * 7,
+						},
+					},
+				)
+			},
+			maintenanceWindow: &api.MaintenanceWindow{
+				DaysOfWeek:    []string{"Thursday"},
+				DurationHours: 4,
+			},
+			maintenanceState:              api.MaintenanceStatePending,
+			maintenanceStateSetBySchedule: true,
+			wantMaintenanceState:          api.MaintenanceStatePending,
+			wantDidWork:                   true,
+		},
+		{
+			desc: "pending maintenance state set by an SRE is kept",
+			fixtures: func(f *testdatabase.Fixture) {
+				f.AddSubscriptionDocuments(
+					&api.SubscriptionDocument{
+						ID: mockSubID,
+					},
+				)
+				f.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			checkers: func(c *testdatabase.Checker) {
+				c.AddMaintenanceManifestDocuments(&api.MaintenanceManifestDocument{
+					ID:                manifestID1,
+					Dequeues:          1,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStateCompleted,
+						MaintenanceTaskID: "0",
+						CreatedBySchedule: "schedule",
+						StatusText:        "done",
+						RunBefore:         600,
+						RunAfter:          0,
+					},
+				})
+			},
+			maintenanceWindow: &api.MaintenanceWindow{
+				DaysOfWeek:    []string{"Thursday"},
+				DurationHours: 4,
+			},
+			maintenanceState:     api.MaintenanceStatePending,
+			wantMaintenanceState: api.MaintenanceStatePending,
+			wantDidWork:          true,
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.desc, func(t *testing.T) {
@@ -636,14 +1217,27 @@ func TestActuatorLogic(t *testing.T) {
 			fixtures := testdatabase.NewFixture()
 			checker := testdatabase.NewChecker()
 
-			// The cluster fixture is always the same
+			maintenanceState := tt.maintenanceState
+			if maintenanceState == "" {
+				maintenanceState = api.MaintenanceStateNone
+			}
+			wantMaintenanceState := tt.wantMaintenanceState
+			if wantMaintenanceState == "" {
+				wantMaintenanceState = api.MaintenanceStateNone
+			}
+			wantMaintenanceStateSetBySchedule := tt.maintenanceStateSetBySchedule && wantMaintenanceState == api.MaintenanceStatePending
+
+			// The cluster fixture is always the same, other than its
+			// maintenance window
 			fixtures.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
 				Key: strings.ToLower(clusterResourceID),
 				OpenShiftCluster: &api.OpenShiftCluster{
 					ID: clusterResourceID,
 					Properties: api.OpenShiftClusterProperties{
-						ProvisioningState: api.ProvisioningStateSucceeded,
-						MaintenanceState:  api.MaintenanceStateNone,
+						ProvisioningState:             api.ProvisioningStateSucceeded,
+						MaintenanceState:              maintenanceState,
+						MaintenanceStateSetBySchedule: tt.maintenanceStateSetBySchedule,
+						MaintenanceWindow:             tt.maintenanceWindow,
 					},
 				},
 			})
@@ -689,14 +1283,17 @@ func TestActuatorLogic(t *testing.T) {
 
 			tt.checkers(checker)
 
-			// Also check that the OpenShiftClusterDocument does not change
+			// Also check that the OpenShiftClusterDocument does not change,
+			// other than its maintenance state
 			checker.AddOpenShiftClusterDocuments(&api.OpenShiftClusterDocument{
 				Key: strings.ToLower(clusterResourceID),
 				OpenShiftCluster: &api.OpenShiftCluster{
 					ID: clusterResourceID,
 					Properties: api.OpenShiftClusterProperties{
-						ProvisioningState: api.ProvisioningStateSucceeded,
-						MaintenanceState:  api.MaintenanceStateNone,
+						ProvisioningState:             api.ProvisioningStateSucceeded,
+						MaintenanceState:              wantMaintenanceState,
+						MaintenanceStateSetBySchedule: wantMaintenanceStateSetBySchedule,
+						MaintenanceWindow:             tt.maintenanceWindow,
 					},
 				},
 			})
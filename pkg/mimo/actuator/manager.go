@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"net/http"
 	"sort"
 	"strings"
 	"time"
@@ -17,6 +18,8 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/mimo/tasks"
 	utilmimo "github.com/Azure/ARO-RP/pkg/util/mimo"
@@ -137,6 +140,10 @@ func (a *actuator) Process(ctx context.Context) (bool, error) {
 
 	evaluationTime := a.env.Now()
 
+	// Whether a manifest created by a schedule has finished, and so the
+	// cluster's pending maintenance state may need to be cleared
+	scheduledFinished := false
+
 	// Check for manifests that have timed out first
 	for _, doc := range docList {
 		if evaluationTime.After(time.Unix(doc.MaintenanceManifest.RunBefore, 0)) {
@@ -154,6 +161,8 @@ func (a *actuator) Process(ctx context.Context) (bool, error) {
 			})
 			if err != nil {
 				taskLog.Error(fmt.Errorf("failed to patch manifest with state TimedOut; will still attempt to process other manifests: %w", err))
+			} else if doc.MaintenanceManifest.CreatedBySchedule != "" {
+				scheduledFinished = true
 			}
 		} else {
 			// not timed out, do something about it
@@ -163,6 +172,9 @@ func (a *actuator) Process(ctx context.Context) (bool, error) {
 
 	// Nothing to do, return early
 	if len(manifestsToAction) == 0 {
+		if scheduledFinished {
+			a.clearMaintenancePending(ctx, mmf, ocDb)
+		}
 		return false, nil
 	}
 
@@ -186,6 +198,32 @@ func (a *actuator) Process(ctx context.Context) (bool, error) {
 		})
 		taskLog.Info("begin processing manifest")
 
+		// Manifests only run once all of their prerequisites have completed,
+		// and fail if any prerequisite did not succeed
+		ready, failure, err := a.checkPrerequisites(ctx, mmf, doc)
+		if err != nil {
+			taskLog.Error(fmt.Errorf("failed checking prerequisites: %w", err))
+			continue
+		}
+		if failure != "" {
+			taskLog.Infof("marking as failed: %s", failure)
+			_, err = mmf.Patch(ctx, a.clusterResourceID, doc.ID, func(d *api.MaintenanceManifestDocument) error {
+				d.MaintenanceManifest.State = api.MaintenanceManifestStateFailed
+				d.MaintenanceManifest.StatusText = failure
+				return nil
+			})
+			if err != nil {
+				taskLog.Error(fmt.Errorf("failed to patch manifest with state Failed: %w", err))
+			} else if doc.MaintenanceManifest.CreatedBySchedule != "" {
+				scheduledFinished = true
+			}
+			continue
+		}
+		if !ready {
+			taskLog.Info("prerequisites not yet completed, skipping")
+			continue
+		}
+
 		// Fetch a fresh OpenShift cluster document, in case the previous task/a
 		// concurrent action updated anything
 		oc, err := ocDb.Get(ctx, a.clusterResourceID)
@@ -194,6 +232,16 @@ func (a *actuator) Process(ctx context.Context) (bool, error) {
 			return false, fmt.Errorf("failed getting cluster document: %w", err)
 		}
 
+		// Manifests created by a schedule may only start within the cluster's
+		// maintenance window, if it has one, as of when the manifests were
+		// evaluated
+		window := oc.OpenShiftCluster.Properties.MaintenanceWindow
+		restrictedToWindow := window != nil && doc.MaintenanceManifest.CreatedBySchedule != ""
+		if restrictedToWindow && !window.Contains(evaluationTime) {
+			taskLog.Info("outside of the cluster's maintenance window, skipping")
+			continue
+		}
+
 		// Attempt a dequeue
 		doc, err = mmf.Lease(ctx, a.clusterResourceID, doc.ID)
 		if err != nil {
@@ -210,6 +258,8 @@ func (a *actuator) Process(ctx context.Context) (bool, error) {
 			_, err = mmf.EndLease(ctx, doc.ClusterResourceID, doc.ID, api.MaintenanceManifestStateFailed, &msg)
 			if err != nil {
 				taskLog.Error(fmt.Errorf("failed ending lease early on manifest: %w", err))
+			} else if doc.MaintenanceManifest.CreatedBySchedule != "" {
+				scheduledFinished = true
 			}
 			continue
 		}
@@ -264,9 +314,54 @@ func (a *actuator) Process(ctx context.Context) (bool, error) {
 		_, err = mmf.EndLease(ctx, doc.ClusterResourceID, doc.ID, state, &msg)
 		if err != nil {
 			taskLog.Error(fmt.Errorf("failed ending lease on manifest: %w", err))
+		} else if doc.MaintenanceManifest.CreatedBySchedule != "" && state != api.MaintenanceManifestStatePending {
+			scheduledFinished = true
 		}
 		taskLog.Info("manifest processing complete")
 	}
 
+	if scheduledFinished {
+		a.clearMaintenancePending(ctx, mmf, ocDb)
+	}
+
 	return doneSomeWork, nil
 }
+
+// clearMaintenancePending clears the pending maintenance state the Scheduler
+// set on the cluster, if none of its scheduled manifests are still queued
+func (a *actuator) clearMaintenancePending(ctx context.Context, mmf database.MaintenanceManifests, ocDb database.OpenShiftClusters) {
+	cleared, err := utilmimo.ClearMaintenancePending(ctx, mmf, ocDb, a.clusterResourceID)
+	if err != nil {
+		a.log.Error(err)
+	} else if cleared {
+		a.log.Info("cleared pending maintenance state")
+	}
+}
+
+// checkPrerequisites returns whether all of the prerequisites of `doc` have
+// Completed. If a prerequisite is missing or has finished unsuccessfully, a
+// message describing why `doc` can never run is returned instead.
+func (a *actuator) checkPrerequisites(ctx context.Context, mmf database.MaintenanceManifests, doc *api.MaintenanceManifestDocument) (bool, string, error) {
+	ready := true
+	for _, id := range doc.MaintenanceManifest.Prerequisites {
+		prereq, err := mmf.Get(ctx, a.clusterResourceID, id)
+		if cosmosdb.IsErrorStatusCode(err, http.StatusNotFound) {
+			return false, fmt.Sprintf("prerequisite manifest %s not found", id), nil
+		} else if err != nil {
+			return false, "", err
+		}
+
+		switch prereq.MaintenanceManifest.State {
+		case api.MaintenanceManifestStateCompleted:
+		case api.MaintenanceManifestStateFailed,
+			api.MaintenanceManifestStateRetriesExceeded,
+			api.MaintenanceManifestStateTimedOut,
+			api.MaintenanceManifestStateCancelled:
+			return false, fmt.Sprintf("prerequisite manifest %s (%s) ended in state %s", id, prereq.MaintenanceManifest.MaintenanceTaskID, prereq.MaintenanceManifest.State), nil
+		default:
+			ready = false
+		}
+	}
+
+	return ready, "", nil
+}
@@ -200,6 +200,9 @@ func (s *service) Run(_ctx context.Context, stop <-chan struct{}, done chan<- st
 		m.Handle("/healthz", http.StripPrefix("/healthz", c))
 		// Handle healthz subpaths
 		m.Handle("/healthz/", http.StripPrefix("/healthz", c))
+		if h := metrics.Handler(s.m); h != nil {
+			m.Handle("/metrics", h)
+		}
 
 		h := &http.Server{
 			Handler:     m,
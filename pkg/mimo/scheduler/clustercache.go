@@ -4,6 +4,7 @@ package scheduler
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
 	"fmt"
 	"iter"
 	"reflect"
@@ -18,17 +19,61 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
 	"github.com/Azure/ARO-RP/pkg/metrics"
 	"github.com/Azure/ARO-RP/pkg/util/changefeed"
 )
 
+type clusterCacheDBs interface {
+	database.DatabaseGroupWithOpenShiftClusters
+	database.DatabaseGroupWithSubscriptions
+}
+
+// ClusterCache is the cache of cluster selector data which the Scheduler
+// evaluates schedules against, for use outside of the Scheduler service. It
+// is populated by the same changefeeds as the Scheduler's own cache.
+type ClusterCache struct {
+	log *logrus.Entry
+
+	subs     changefeed.SubscriptionsCache
+	clusters *openShiftClusterCache
+}
+
+func NewClusterCache(log *logrus.Entry, m metrics.Emitter) *ClusterCache {
+	subs := changefeed.NewSubscriptionsChangefeedCache(m, false)
+
+	return &ClusterCache{
+		log: log,
+
+		subs:     subs,
+		clusters: newOpenShiftClusterCache(log, m, subs),
+	}
+}
+
+// Run starts populating the cache, until `stop` is closed.
+func (c *ClusterCache) Run(ctx context.Context, dbs clusterCacheDBs, stop <-chan struct{}) error {
+	return runClusterChangefeeds(ctx, c.log, dbs, c.subs, c.clusters, defaultChangefeedInteval, defaultChangefeedBatchSize, stop)
+}
+
+// Populated returns true once the cache has been populated.
+func (c *ClusterCache) Populated() bool {
+	_, ok := c.clusters.GetLastProcessed()
+	return ok
+}
+
+// GetClusters returns the cached clusters. It blocks until the cache has been
+// populated.
+func (c *ClusterCache) GetClusters() iter.Seq2[string, clusterView] {
+	return c.clusters.GetClusters()
+}
+
 type openShiftClusterCache struct {
 	log *logrus.Entry
 	m   metrics.Emitter
 
 	subCache changefeed.SubscriptionsCache
 
-	clusters *xsync.Map[string, selectorData]
+	clusters *xsync.Map[string, clusterView]
 
 	lastChangefeedDataUpdate   atomic.Value // time.Time
 	lastChangefeedProcessed    atomic.Value // time.Time
@@ -42,7 +87,7 @@ func newOpenShiftClusterCache(log *logrus.Entry, m metrics.Emitter, subCache cha
 		log:                        log,
 		m:                          m,
 		subCache:                   subCache,
-		clusters:                   xsync.NewMap[string, selectorData](),
+		clusters:                   xsync.NewMap[string, clusterView](),
 		initialPopulationWaitGroup: wg,
 	}
 }
@@ -81,17 +126,17 @@ func (c *openShiftClusterCache) OnDoc(doc *api.OpenShiftClusterDocument) {
 	default:
 		// Update the selector cache with the cluster data
 		c.clusters.Compute(
-			id, func(oldValue selectorData, loaded bool) (selectorData, xsync.ComputeOp) {
-				new, updated, err := c.toSelectorData(doc, oldValue)
+			id, func(oldValue clusterView, loaded bool) (clusterView, xsync.ComputeOp) {
+				new, updated, err := c.toClusterView(doc, oldValue)
 				if err != nil {
 					c.log.Errorf("failed creating selector data for %s: %s", id, err.Error())
-					return selectorData{}, xsync.CancelOp
+					return clusterView{}, xsync.CancelOp
 				}
 
 				if updated {
 					return new, xsync.UpdateOp
 				} else {
-					return selectorData{}, xsync.CancelOp
+					return clusterView{}, xsync.CancelOp
 				}
 			})
 	}
@@ -112,10 +157,10 @@ func (c *openShiftClusterCache) OnAllPendingProcessed(gotAny bool) {
 	}
 }
 
-func (c *openShiftClusterCache) toSelectorData(doc *api.OpenShiftClusterDocument, old selectorData) (selectorData, bool, error) {
+func (c *openShiftClusterCache) toClusterView(doc *api.OpenShiftClusterDocument, old clusterView) (clusterView, bool, error) {
 	r, err := azure.ParseResourceID(strings.ToLower(doc.OpenShiftCluster.ID))
 	if err != nil {
-		return nil, false, err
+		return clusterView{}, false, err
 	}
 
 	var subscriptionState string
@@ -123,18 +168,18 @@ func (c *openShiftClusterCache) toSelectorData(doc *api.OpenShiftClusterDocument
 	if hasSubCacheData {
 		subscriptionState = string(subCacheData.State)
 	} else {
-		return nil, false, fmt.Errorf("no matching subscription %s", r.SubscriptionID)
+		return clusterView{}, false, fmt.Errorf("no matching subscription %s", r.SubscriptionID)
 	}
 
-	new, err := ToSelectorData(doc, subscriptionState)
+	new, err := toClusterView(doc, subscriptionState)
 	if err != nil {
-		return nil, false, err
+		return clusterView{}, false, err
 	}
 
 	return new, !reflect.DeepEqual(old, new), nil
 }
 
-func (c *openShiftClusterCache) GetClusters() iter.Seq2[string, selectorData] {
+func (c *openShiftClusterCache) GetClusters() iter.Seq2[string, clusterView] {
 	c.initialPopulationWaitGroup.Wait()
 	return c.clusters.All()
 }
@@ -10,6 +10,7 @@ import (
 	"hash/crc32"
 	"iter"
 	"maps"
+	"net/http"
 	"strings"
 	"time"
 
@@ -18,15 +19,23 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/metrics"
 	"github.com/Azure/ARO-RP/pkg/mimo/tasks"
 	"github.com/Azure/ARO-RP/pkg/monitor/dimension"
-	"github.com/Azure/ARO-RP/pkg/util/log"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	utilmimo "github.com/Azure/ARO-RP/pkg/util/mimo"
 )
 
 const friendlyDateFormat string = "2006-01-02T15:04Z07:00"
 
+// maintenanceNotificationLeadTime is how long before a manifest runs on a
+// cluster with a maintenance window that the cluster's MaintenanceState is
+// set to Pending, to notify the customer of the upcoming maintenance.
+const maintenanceNotificationLeadTime = 24 * time.Hour
+
 type newSchedulerFunc func(
 	_env env.Interface,
 	log *logrus.Entry,
@@ -38,7 +47,7 @@ type newSchedulerFunc func(
 type getCachedScheduleDocFunc func() (*api.MaintenanceScheduleDocument, bool)
 
 // get the list of clusters that we have cached
-type getClustersFunc func() iter.Seq2[string, selectorData]
+type getClustersFunc func() iter.Seq2[string, clusterView]
 
 type Scheduler interface {
 	Process(context.Context) (bool, error)
@@ -112,67 +121,40 @@ func (a *scheduler) Process(ctx context.Context) (bool, error) {
 	}
 	now := a.env.Now()
 
-	next, hasFutureTime := Next(now, calDef)
+	periods, hasFutureTime := schedulePeriods(a.log, now, doc, calDef, scheduleAcross)
 	if !hasFutureTime {
 		a.log.Warnf("schedule '%s' will never trigger again, skipping", doc.MaintenanceSchedule.Schedule)
 		return true, nil
 	}
 
-	periods_friendly := []string{}
-	periods := []time.Time{}
-
-	// We may be within a scheduleAcross window of the previous schedule run,
-	// add that to the expected periods so we don't cancel them
-	scheduleAtStartOfScheduleAcross, hasFutureTime := Next(now.Add(-scheduleAcross), calDef)
-	if hasFutureTime && !next.Equal(scheduleAtStartOfScheduleAcross) {
-		periods_friendly = append(periods_friendly, fmt.Sprintf("%s (within scheduleAcross)", scheduleAtStartOfScheduleAcross.Format(friendlyDateFormat)))
-		periods = append(periods, scheduleAtStartOfScheduleAcross)
-	}
-
-	periods_friendly = append(periods_friendly, next.Format(friendlyDateFormat))
-	periods = append(periods, next)
-
-	if doc.MaintenanceSchedule.LookForwardCount > 1 {
-		for i := range doc.MaintenanceSchedule.LookForwardCount - 1 {
-			n, inFuture := Next(periods[len(periods)-1], calDef)
-			if !inFuture {
-				a.log.Infof("schedule '%s' will only trigger %d times but look forward is %d", doc.MaintenanceSchedule.Schedule, i+1, doc.MaintenanceSchedule.LookForwardCount)
-				break
-			}
-
-			periods = append(periods, n)
-			periods_friendly = append(periods_friendly, n.Format(friendlyDateFormat))
+	// For progressive rollouts, work out which waves are open in each period,
+	// pausing the schedule if the earlier waves have failed too often
+	var rollouts map[int64]*periodRollout
+	if len(doc.MaintenanceSchedule.Waves) > 0 {
+		var pausedReason string
+		rollouts, pausedReason, err = a.evaluateWaves(ctx, manifestsDB, doc, periods, scheduleAcross)
+		if err != nil {
+			return false, err
+		}
+		if pausedReason != "" {
+			return true, a.pause(ctx, doc, pausedReason)
 		}
 	}
 
-	a.log.Infof("next valid scheduled times: %s", strings.Join(periods_friendly, ", "))
-
 	// go over each of the clusters
-	for clusterID, cl := range a.getClusters() {
-		a.log.Debugf("checking selectors for %s (sub %s)", clusterID, cl["subscriptionID"])
-		clusterLog := log.EnrichWithResourceID(a.log, clusterID)
-
-		matchesSelectors, err := cl.Matches(clusterLog, doc.MaintenanceSchedule.Selectors)
+	for cl, err := range selectClusters(ctx, a.log, a.dbs, doc, a.getClusters, scheduleAcross, now) {
+		clusterID, clusterLog, window := cl.id, cl.log, cl.window
 		if err != nil {
-			clusterLog.Errorf("error matching selectors, skipping cluster: %s", err.Error())
+			clusterLog.Errorf("%s, skipping cluster", err.Error())
 			continue
 		}
 
-		if !matchesSelectors {
-			clusterLog.Debugf("cluster does not match selectors")
-			continue
+		wave := 0
+		if rollouts != nil {
+			wave = ClusterWave(clusterID, doc.MaintenanceSchedule.Waves)
+			clusterLog.Debugf("cluster is in wave %d", wave)
 		}
 
-		clusterLog.Debugf("cluster matches selectors")
-
-		// this is the amount of time we will be offset inside the
-		// 'scheduleAcross' window. This time is inclusive of the initial start
-		// time -- i.e. a schedule across 60s will spread clusters out from
-		// :00-:59, not :00 to +1:00.
-		offsetWithinScheduleAcross := PercentWithinPeriod(ClusterResourceIDHashToScheduleWithinPercent(clusterID), scheduleAcross)
-
-		clusterLog.Debugf("Calculated scheduleAcross offset is %s", offsetWithinScheduleAcross.String())
-
 		foundPeriods := map[int64]string{}
 
 		existingTasks, err := manifestsDB.GetFutureTasksForClusterAndScheduleID(ctx, clusterID, doc.ID, "")
@@ -205,16 +187,42 @@ func (a *scheduler) Process(ctx context.Context) (bool, error) {
 		manifestsFound := 0
 		manifestsCreated := 0
 		manifestsCancelled := 0
+		var nextRunAfter time.Time
+		// maintenance windows may move several periods to the same time
+		scheduled := map[int64]bool{}
 		for _, target := range periods {
-			targetWithOffset := target.Add(offsetWithinScheduleAcross)
-			scheduleMatch, found := foundPeriods[targetWithOffset.Unix()]
+			targetWithOffset := target.Add(cl.offset)
+
+			// Move the manifest into the cluster's maintenance window, if it
+			// has one
+			runAfter, runBefore, ok := scheduleInMaintenanceWindow(targetWithOffset, window)
+			if !ok {
+				clusterLog.Warnf("maintenance window does not open after %s, skipping", targetWithOffset.Format(time.RFC3339))
+				continue
+			}
+			if scheduled[runAfter.Unix()] {
+				clusterLog.Debugf("skipping manifest creation for %s window, already scheduled for %s", target, runAfter)
+				continue
+			}
+
+			scheduleMatch, found := foundPeriods[runAfter.Unix()]
 			if !found {
-				if target.Before(now) {
+				if rollout, ok := rollouts[target.Unix()]; ok {
+					if wave >= rollout.openWaves {
+						clusterLog.Debugf("skipping manifest creation for %s window (%s) as wave %d is not yet open", target, targetWithOffset, wave)
+						continue
+					}
+					if rollout.existing[clusterID] {
+						continue
+					}
+				}
+				runAfter, runBefore, ok = newManifestWindow(now, target, runAfter, runBefore, scheduleAcross, window, wave)
+				if !ok {
 					// Don't create manifests if the specified schedule start time is within the past
 					clusterLog.Debugf("skipping manifest creation for %s window (%s)", target, targetWithOffset)
 					continue
 				}
-				clusterLog.Debugf("creating manifest for %s window (%s)", target, targetWithOffset)
+				clusterLog.Debugf("creating manifest for %s window (%s)", target, runAfter)
 
 				newManifest, err := manifestsDB.Create(ctx, &api.MaintenanceManifestDocument{
 					ID:                manifestsDB.NewUUID(),
@@ -224,8 +232,8 @@ func (a *scheduler) Process(ctx context.Context) (bool, error) {
 
 						MaintenanceTaskID: doc.MaintenanceSchedule.MaintenanceTaskID,
 						CreatedBySchedule: api.MIMOScheduleID(doc.ID),
-						RunAfter:          targetWithOffset.Unix(),
-						RunBefore:         targetWithOffset.Add(time.Hour).Unix(),
+						RunAfter:          runAfter.Unix(),
+						RunBefore:         runBefore.Unix(),
 					},
 				})
 				if err != nil {
@@ -233,15 +241,20 @@ func (a *scheduler) Process(ctx context.Context) (bool, error) {
 					break
 				}
 
-				clusterLog.Infof("created new manifest id=%s for %s window (%s)", newManifest.ID, target.Format(friendlyDateFormat), targetWithOffset.Format(time.RFC3339))
+				clusterLog.Infof("created new manifest id=%s for %s window (%s)", newManifest.ID, target.Format(friendlyDateFormat), runAfter.Format(time.RFC3339))
 				manifestsCreated += 1
 			} else {
 				clusterLog.Debugf("found manifest for %s (%s)", target, scheduleMatch)
 				// Remove it from the foundPeriods, so that we can remove any
 				// remainders (e.g. if we changed the schedule)
-				delete(foundPeriods, targetWithOffset.Unix())
+				delete(foundPeriods, runAfter.Unix())
 				manifestsFound += 1
 			}
+
+			scheduled[runAfter.Unix()] = true
+			if nextRunAfter.IsZero() || runAfter.Before(nextRunAfter) {
+				nextRunAfter = runAfter
+			}
 		}
 
 		// Cancel the manifests which are not required by this schedule
@@ -279,12 +292,290 @@ func (a *scheduler) Process(ctx context.Context) (bool, error) {
 		}
 		if manifestsCancelled > 0 {
 			a.m.EmitGauge("mimo.scheduler.manifests.cancelled", int64(manifestsCancelled), clusterDims)
+
+			err = a.clearMaintenancePending(ctx, clusterLog, clusterID)
+			if err != nil {
+				clusterLog.Errorf("error clearing pending maintenance state: %s", err.Error())
+			}
+		}
+
+		// Let customers who have set a maintenance window know ahead of time
+		// that maintenance is coming up
+		if window != nil && !nextRunAfter.IsZero() && nextRunAfter.Before(now.Add(maintenanceNotificationLeadTime)) {
+			err = a.setMaintenancePending(ctx, clusterLog, clusterID, clusterDims)
+			if err != nil {
+				clusterLog.Errorf("error setting maintenance state to pending: %s", err.Error())
+			}
 		}
 	}
 
 	return true, nil
 }
 
+// schedulePeriods returns the start times of the calendar periods which the
+// schedule should have manifests for at `now`: the previous period, if `now`
+// is still within its scheduleAcross window, followed by the next
+// LookForwardCount periods. It returns false if the schedule will never
+// trigger again.
+func schedulePeriods(log *logrus.Entry, now time.Time, doc *api.MaintenanceScheduleDocument, calDef calendar, scheduleAcross time.Duration) ([]time.Time, bool) {
+	next, hasFutureTime := Next(now, calDef)
+	if !hasFutureTime {
+		return nil, false
+	}
+
+	periods_friendly := []string{}
+	periods := []time.Time{}
+
+	// We may be within a scheduleAcross window of the previous schedule run,
+	// add that to the expected periods so we don't cancel them
+	scheduleAtStartOfScheduleAcross, hasFutureTime := Next(now.Add(-scheduleAcross), calDef)
+	if hasFutureTime && !next.Equal(scheduleAtStartOfScheduleAcross) {
+		periods_friendly = append(periods_friendly, fmt.Sprintf("%s (within scheduleAcross)", scheduleAtStartOfScheduleAcross.Format(friendlyDateFormat)))
+		periods = append(periods, scheduleAtStartOfScheduleAcross)
+	}
+
+	periods_friendly = append(periods_friendly, next.Format(friendlyDateFormat))
+	periods = append(periods, next)
+
+	if doc.MaintenanceSchedule.LookForwardCount > 1 {
+		for i := range doc.MaintenanceSchedule.LookForwardCount - 1 {
+			n, inFuture := Next(periods[len(periods)-1], calDef)
+			if !inFuture {
+				log.Infof("schedule '%s' will only trigger %d times but look forward is %d", doc.MaintenanceSchedule.Schedule, i+1, doc.MaintenanceSchedule.LookForwardCount)
+				break
+			}
+
+			periods = append(periods, n)
+			periods_friendly = append(periods_friendly, n.Format(friendlyDateFormat))
+		}
+	}
+
+	log.Infof("next valid scheduled times: %s", strings.Join(periods_friendly, ", "))
+
+	return periods, true
+}
+
+// selectedCluster is a cluster which matches a schedule's selectors.
+type selectedCluster struct {
+	id  string
+	log *logrus.Entry
+
+	// offset is how far into each period's scheduleAcross window the
+	// cluster's manifests are targeted
+	offset time.Duration
+	window *api.MaintenanceWindow
+}
+
+// selectClusters returns the clusters from `getClusters` which match the
+// schedule's selectors. Clusters whose selector data or maintenance window
+// can't be evaluated are returned with an error, and should be skipped.
+func selectClusters(ctx context.Context, log *logrus.Entry, dbs database.DatabaseGroupWithClusterHealthSnapshots, doc *api.MaintenanceScheduleDocument, getClusters getClustersFunc, scheduleAcross time.Duration, now time.Time) iter.Seq2[selectedCluster, error] {
+	// only look up health snapshots for schedules which select on them
+	needsHealthSnapshots := selectsOn(doc.MaintenanceSchedule.Selectors, SelectorDataKeyCertificatesDaysUntilExpiry)
+
+	return func(yield func(selectedCluster, error) bool) {
+		for clusterID, cl := range getClusters() {
+			log.Debugf("checking selectors for %s (sub %s)", clusterID, cl.selectorData[SelectorDataKeySubscriptionID])
+			selected := selectedCluster{
+				id:  clusterID,
+				log: utillog.EnrichWithResourceID(log, clusterID),
+			}
+
+			if needsHealthSnapshots {
+				var err error
+				cl, err = withHealthSnapshot(ctx, dbs, cl, now)
+				if err != nil {
+					if !yield(selected, fmt.Errorf("unable to get health snapshot: %w", err)) {
+						return
+					}
+					continue
+				}
+			}
+
+			matchesSelectors, err := cl.Matches(selected.log, doc.MaintenanceSchedule.Selectors)
+			if err != nil {
+				if !yield(selected, fmt.Errorf("error matching selectors: %w", err)) {
+					return
+				}
+				continue
+			}
+
+			if !matchesSelectors {
+				selected.log.Debugf("cluster does not match selectors")
+				continue
+			}
+
+			selected.log.Debugf("cluster matches selectors")
+
+			// this is the amount of time we will be offset inside the
+			// 'scheduleAcross' window. This time is inclusive of the initial
+			// start time -- i.e. a schedule across 60s will spread clusters
+			// out from :00-:59, not :00 to +1:00.
+			selected.offset = PercentWithinPeriod(ClusterResourceIDHashToScheduleWithinPercent(clusterID), scheduleAcross)
+
+			selected.log.Debugf("Calculated scheduleAcross offset is %s", selected.offset.String())
+
+			selected.window, err = cl.MaintenanceWindow()
+			if err != nil {
+				if !yield(selected, fmt.Errorf("invalid maintenance window: %w", err)) {
+					return
+				}
+				continue
+			}
+
+			if !yield(selected, nil) {
+				return
+			}
+		}
+	}
+}
+
+// newManifestWindow returns when a manifest created at `now` for the period
+// starting at `target`, which scheduleInMaintenanceWindow placed at
+// `runAfter` to `runBefore`, should run. It returns false if the manifest
+// should not be created, as the period has already started or, for later
+// waves, its scheduleAcross window has closed.
+func newManifestWindow(now, target, runAfter, runBefore time.Time, scheduleAcross time.Duration, window *api.MaintenanceWindow, wave int) (time.Time, time.Time, bool) {
+	if wave > 0 {
+		// Later waves open once the earlier ones have finished, so create
+		// them for as long as the window is still open
+		if !now.Before(target.Add(scheduleAcross)) {
+			return time.Time{}, time.Time{}, false
+		}
+	} else if target.Before(now) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	// Manifests for later waves may be created after their target time, so
+	// give them the same time to start, unless the maintenance window will not
+	// open again
+	if runAfter.Before(now) {
+		var ok bool
+		_, runBefore, ok = scheduleInMaintenanceWindow(now, window)
+		if !ok {
+			return time.Time{}, time.Time{}, false
+		}
+	}
+
+	return runAfter, runBefore, true
+}
+
+// withHealthSnapshot returns a copy of `cl` with the selector data which comes
+// from the cluster's health snapshot filled in.
+func withHealthSnapshot(ctx context.Context, dbs database.DatabaseGroupWithClusterHealthSnapshots, cl clusterView, now time.Time) (clusterView, error) {
+	var snapshot *api.ClusterHealthSnapshot
+
+	if cl.documentID != "" {
+		dbClusterHealthSnapshots, err := dbs.ClusterHealthSnapshots()
+		if err != nil {
+			return clusterView{}, err
+		}
+
+		doc, err := dbClusterHealthSnapshots.Get(ctx, cl.documentID)
+		switch {
+		case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		case err != nil:
+			return clusterView{}, err
+		default:
+			snapshot = &doc.ClusterHealthSnapshot
+		}
+	}
+
+	cl.selectorData = maps.Clone(cl.selectorData)
+	cl.AddHealthSnapshot(snapshot, now)
+	return cl, nil
+}
+
+// setMaintenancePending sets the cluster's MaintenanceState to Pending, unless
+// it is already in another maintenance state.
+func (a *scheduler) setMaintenancePending(ctx context.Context, clusterLog *logrus.Entry, clusterID string, clusterDims map[string]string) error {
+	ocDb, err := a.dbs.OpenShiftClusters()
+	if err != nil {
+		return err
+	}
+
+	oc, err := ocDb.Get(ctx, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if !isNoMaintenanceState(oc.OpenShiftCluster.Properties.MaintenanceState) {
+		return nil
+	}
+
+	_, err = ocDb.Patch(ctx, clusterID, func(doc *api.OpenShiftClusterDocument) error {
+		// the state may have changed since we read it
+		if isNoMaintenanceState(doc.OpenShiftCluster.Properties.MaintenanceState) {
+			doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStatePending
+			doc.OpenShiftCluster.Properties.MaintenanceStateSetBySchedule = true
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	clusterLog.Info("set maintenance state to pending")
+	a.m.EmitGauge("mimo.scheduler.maintenance.pending", 1, clusterDims)
+	return nil
+}
+
+// clearMaintenancePending sets the cluster's MaintenanceState back from
+// Pending to None, if none of its scheduled manifests are still queued.
+func (a *scheduler) clearMaintenancePending(ctx context.Context, clusterLog *logrus.Entry, clusterID string) error {
+	manifestsDB, err := a.dbs.MaintenanceManifests()
+	if err != nil {
+		return err
+	}
+
+	ocDb, err := a.dbs.OpenShiftClusters()
+	if err != nil {
+		return err
+	}
+
+	cleared, err := utilmimo.ClearMaintenancePending(ctx, manifestsDB, ocDb, clusterID)
+	if err != nil {
+		return err
+	}
+
+	if cleared {
+		clusterLog.Info("cleared pending maintenance state")
+	}
+	return nil
+}
+
+func isNoMaintenanceState(s api.MaintenanceState) bool {
+	return s == "" || s == api.MaintenanceStateNone
+}
+
+// scheduleInMaintenanceWindow returns when a manifest targeted at `t` should
+// run. Without a maintenance window, it runs at `t` and has an hour to start.
+// Otherwise it runs at the first time from `t` at which the window is open,
+// and has an hour to start or until the window closes, whichever is sooner.
+// ok is false if the window never opens.
+func scheduleInMaintenanceWindow(t time.Time, w *api.MaintenanceWindow) (runAfter time.Time, runBefore time.Time, ok bool) {
+	if w == nil {
+		return t, t.Add(time.Hour), true
+	}
+
+	start, end, ok := w.Next(t)
+	if !ok {
+		return time.Time{}, time.Time{}, false
+	}
+
+	runAfter = t
+	if start.After(t) {
+		runAfter = start
+	}
+
+	runBefore = runAfter.Add(time.Hour)
+	if runBefore.After(end) {
+		runBefore = end
+	}
+
+	return runAfter, runBefore, true
+}
+
 // Take a cluster resource ID and deterministically turn it into a % to be used
 // for placing the cluster in the "scheduleAcross". For example, 0.0 will mean
 // that it will be scheduled exactly at the schedule time, 1.0 will mean
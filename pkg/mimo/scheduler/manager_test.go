@@ -62,6 +62,9 @@ func TestProcessLoop(t *testing.T) {
 		expectedLogs      []testlog.ExpectedLogEntry
 		extraRuns         int
 		expectedMetrics   []testmetrics.MetricsAssertion[int64]
+
+		maintenanceWindow    *api.MaintenanceWindow
+		wantMaintenanceState api.MaintenanceState
 	}{
 		{
 			desc: "valid schedule, new manifest created (lookahead=1, scheduleAcross=0s)",
@@ -1240,6 +1243,181 @@ func TestProcessLoop(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "manifest is moved into the maintenance window, and the cluster set to pending",
+			schedule: &api.MaintenanceScheduleDocument{
+				ID: manifestScheduleID,
+				MaintenanceSchedule: api.MaintenanceSchedule{
+					State:             api.MaintenanceScheduleStateEnabled,
+					MaintenanceTaskID: api.MIMOTaskID("0"),
+
+					Schedule:         "Thu *-*-* 06:00:00",
+					LookForwardCount: 1,
+					ScheduleAcross:   "0s",
+
+					Selectors: []*api.MaintenanceScheduleSelector{
+						{
+							Key:      string(SelectorDataKeySubscriptionState),
+							Operator: "in",
+							Values:   []string{string(api.SubscriptionStateRegistered)},
+						},
+					},
+				},
+			},
+			// 07:00-09:00 in New York is 12:00-14:00 UTC in January
+			maintenanceWindow: &api.MaintenanceWindow{
+				DaysOfWeek:    []string{"Thursday"},
+				StartHour:     7,
+				DurationHours: 2,
+				TimeZone:      "America/New_York",
+			},
+			wantMaintenanceState: api.MaintenanceStatePending,
+			existingManifests:    []*api.MaintenanceManifestDocument{},
+			desiredManifests: []*api.MaintenanceManifestDocument{
+				{
+					ID:                manifestID,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						CreatedBySchedule: api.MIMOScheduleID(manifestScheduleID),
+						MaintenanceTaskID: "0",
+						Priority:          0,
+						RunAfter:          time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC).Unix(),
+						RunBefore:         time.Date(2026, 1, 1, 13, 0, 0, 0, time.UTC).Unix(),
+					},
+				},
+			},
+			expectedLogs: append(base_logs, []testlog.ExpectedLogEntry{
+				{
+					"level": gomega.Equal(logrus.InfoLevel),
+					"msg":   gomega.Equal("next valid scheduled times: 2026-01-01T06:00Z"),
+				},
+				{
+					"level":       gomega.Equal(logrus.InfoLevel),
+					"msg":         gomega.Equal("created new manifest id=07070707-0707-0707-0707-070707070001 for 2026-01-01T06:00Z window (2026-01-01T12:00:00Z)"),
+					"resource_id": gomega.Equal(strings.ToLower(clusterResourceID)),
+				},
+				{
+					"level":       gomega.Equal(logrus.InfoLevel),
+					"msg":         gomega.Equal("created=1, found valid=0, cancelled=0"),
+					"resource_id": gomega.Equal(strings.ToLower(clusterResourceID)),
+				},
+				{
+					"level":       gomega.Equal(logrus.InfoLevel),
+					"msg":         gomega.Equal("set maintenance state to pending"),
+					"resource_id": gomega.Equal(strings.ToLower(clusterResourceID)),
+				},
+			}...),
+			expectedMetrics: []testmetrics.MetricsAssertion[int64]{
+				{
+					MetricName: "mimo.scheduler.manifests.created",
+					Dimensions: metric_dims,
+					Value:      1,
+				},
+				{
+					MetricName: "mimo.scheduler.maintenance.pending",
+					Dimensions: metric_dims,
+					Value:      1,
+				},
+				{
+					MetricName: "changefeed.caches.size",
+					Dimensions: map[string]string{
+						"name": "SubscriptionDocument",
+					},
+					Value: 1,
+				},
+				{
+					MetricName: "changefeed.caches.size",
+					Dimensions: map[string]string{
+						"name": "OpenShiftClusterDocument",
+					},
+					Value: 1,
+				},
+			},
+		},
+		{
+			desc: "existing manifest in the maintenance window is found, and the cluster not set to pending ahead of time",
+			schedule: &api.MaintenanceScheduleDocument{
+				ID: manifestScheduleID,
+				MaintenanceSchedule: api.MaintenanceSchedule{
+					State:             api.MaintenanceScheduleStateEnabled,
+					MaintenanceTaskID: api.MIMOTaskID("0"),
+
+					Schedule:         "Fri *-*-* 00:00:00",
+					LookForwardCount: 1,
+					ScheduleAcross:   "0s",
+
+					Selectors: []*api.MaintenanceScheduleSelector{
+						{
+							Key:      string(SelectorDataKeySubscriptionState),
+							Operator: "in",
+							Values:   []string{string(api.SubscriptionStateRegistered)},
+						},
+					},
+				},
+			},
+			maintenanceWindow: &api.MaintenanceWindow{
+				DaysOfWeek:    []string{"Saturday"},
+				StartHour:     2,
+				DurationHours: 4,
+			},
+			wantMaintenanceState: api.MaintenanceStateNone,
+			existingManifests: []*api.MaintenanceManifestDocument{
+				{
+					ID:                manifestID,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						CreatedBySchedule: api.MIMOScheduleID(manifestScheduleID),
+						MaintenanceTaskID: "0",
+						Priority:          0,
+						RunAfter:          time.Date(2026, 1, 3, 2, 0, 0, 0, time.UTC).Unix(),
+						RunBefore:         time.Date(2026, 1, 3, 3, 0, 0, 0, time.UTC).Unix(),
+					},
+				},
+			},
+			desiredManifests: []*api.MaintenanceManifestDocument{
+				{
+					ID:                manifestID,
+					ClusterResourceID: strings.ToLower(clusterResourceID),
+					MaintenanceManifest: api.MaintenanceManifest{
+						State:             api.MaintenanceManifestStatePending,
+						CreatedBySchedule: api.MIMOScheduleID(manifestScheduleID),
+						MaintenanceTaskID: "0",
+						Priority:          0,
+						RunAfter:          time.Date(2026, 1, 3, 2, 0, 0, 0, time.UTC).Unix(),
+						RunBefore:         time.Date(2026, 1, 3, 3, 0, 0, 0, time.UTC).Unix(),
+					},
+				},
+			},
+			expectedLogs: append(base_logs, []testlog.ExpectedLogEntry{
+				{
+					"level": gomega.Equal(logrus.InfoLevel),
+					"msg":   gomega.Equal("next valid scheduled times: 2026-01-02T00:00Z"),
+				},
+				{
+					"level":       gomega.Equal(logrus.InfoLevel),
+					"msg":         gomega.Equal("created=0, found valid=1, cancelled=0"),
+					"resource_id": gomega.Equal(strings.ToLower(clusterResourceID)),
+				},
+			}...),
+			expectedMetrics: []testmetrics.MetricsAssertion[int64]{
+				{
+					MetricName: "changefeed.caches.size",
+					Dimensions: map[string]string{
+						"name": "SubscriptionDocument",
+					},
+					Value: 1,
+				},
+				{
+					MetricName: "changefeed.caches.size",
+					Dimensions: map[string]string{
+						"name": "OpenShiftClusterDocument",
+					},
+					Value: 1,
+				},
+			},
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.desc, func(t *testing.T) {
@@ -1302,6 +1480,7 @@ func TestProcessLoop(t *testing.T) {
 					Properties: api.OpenShiftClusterProperties{
 						ProvisioningState: api.ProvisioningStateSucceeded,
 						MaintenanceState:  api.MaintenanceStateNone,
+						MaintenanceWindow: tt.maintenanceWindow,
 					},
 				},
 			})
@@ -1351,6 +1530,13 @@ func TestProcessLoop(t *testing.T) {
 			errs = checker.CheckMaintenanceSchedules(schedulesClient)
 			require.Empty(errs, "MaintenanceSchedules don't match")
 
+			if tt.wantMaintenanceState != "" {
+				oc, err := clusters.Get(ctx, strings.ToLower(clusterResourceID))
+				require.NoError(err)
+				require.Equal(tt.wantMaintenanceState, oc.OpenShiftCluster.Properties.MaintenanceState)
+				require.Equal(tt.wantMaintenanceState == api.MaintenanceStatePending, oc.OpenShiftCluster.Properties.MaintenanceStateSetBySchedule)
+			}
+
 			err = testlog.AssertLoggingOutput(hook, tt.expectedLogs)
 			require.NoError(err)
 
@@ -6,8 +6,11 @@ package scheduler
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -15,13 +18,20 @@ import (
 
 	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/util/dns"
+	"github.com/Azure/ARO-RP/pkg/util/version"
 )
 
 type SelectorDataType string
 
 const (
-	SelectorDataTypeString SelectorDataType = "string"
-	SelectorDataTypeDate   SelectorDataType = "date"
+	SelectorDataTypeString  SelectorDataType = "string"
+	SelectorDataTypeDate    SelectorDataType = "date"
+	SelectorDataTypeVersion SelectorDataType = "version"
+	// SelectorDataTypeList is a comma separated list of strings, which
+	// matches if any of its items match
+	SelectorDataTypeList SelectorDataType = "list"
+	// SelectorDataTypeInteger is a base 10 integer
+	SelectorDataTypeInteger SelectorDataType = "integer"
 )
 
 type SelectorDataKey string
@@ -37,66 +47,385 @@ const (
 	SelectorDataOutboundType          SelectorDataKey = "outboundType"
 	SelectorDataAPIServerVisibility   SelectorDataKey = "APIServerVisibility"
 	SelectorDataIsManagedDomain       SelectorDataKey = "isManagedDomain"
+	SelectorDataKeyVersion            SelectorDataKey = "version"
+	SelectorDataKeyRegion             SelectorDataKey = "region"
+	SelectorDataKeyCreatedAt          SelectorDataKey = "createdAt"
+	SelectorDataKeyMasterVMSize       SelectorDataKey = "masterVMSize"
+	SelectorDataKeyWorkerVMSizes      SelectorDataKey = "workerVMSizes"
+	// SelectorDataKeyCertificatesDaysUntilExpiry is the number of days until
+	// the earliest expiring certificate in the cluster's certificate
+	// inventory expires, or empty if it is not known. It is filled in from
+	// the cluster's health snapshot by the Scheduler when a schedule selects
+	// on it, e.g. to rotate certificates expiring within 30 days with the
+	// TLS_CERT_ROTATION task.
+	SelectorDataKeyCertificatesDaysUntilExpiry SelectorDataKey = "certificatesDaysUntilExpiry"
+
+	// SelectorDataKeyOperatorFlagPrefix is prepended to the name of each of
+	// the cluster's operator flags, e.g. "operatorFlags.aro.imageconfig.enabled"
+	SelectorDataKeyOperatorFlagPrefix = "operatorFlags."
 )
 
+// selectorDataKeys are the keys set in every cluster's selector data, in
+// addition to those with SelectorDataKeyOperatorFlagPrefix
+var selectorDataKeys = []SelectorDataKey{
+	SelectorDataKeyResourceID,
+	SelectorDataKeyBucketID,
+	SelectorDataKeySubscriptionID,
+	SelectorDataKeySubscriptionState,
+	SelectorDataKeyAuthenticationType,
+	SelectorDataArchitectureVersion,
+	SelectorDataProvisioningState,
+	SelectorDataOutboundType,
+	SelectorDataAPIServerVisibility,
+	SelectorDataIsManagedDomain,
+	SelectorDataKeyVersion,
+	SelectorDataKeyRegion,
+	SelectorDataKeyCreatedAt,
+	SelectorDataKeyMasterVMSize,
+	SelectorDataKeyWorkerVMSizes,
+	SelectorDataKeyCertificatesDaysUntilExpiry,
+}
+
 type selectorData map[SelectorDataKey]string
 
-func (s selectorData) GetType(key string) SelectorDataType {
+// clusterView is the Scheduler's view of a cluster: the selector data which
+// schedules select clusters by, and the details of the cluster which the
+// Scheduler needs but which are not selectable.
+type clusterView struct {
+	selectorData
+
+	// documentID is the ID of the cluster's OpenShiftClusterDocument
+	documentID string
+
+	// maintenanceWindow is the cluster's maintenance window, or nil if it
+	// does not have one
+	maintenanceWindow *api.MaintenanceWindow
+}
+
+func GetType(key string) SelectorDataType {
 	switch SelectorDataKey(key) {
-	case SelectorDataKeyResourceID, SelectorDataKeySubscriptionID, SelectorDataKeySubscriptionState:
-		return SelectorDataTypeString
+	case SelectorDataKeyVersion:
+		return SelectorDataTypeVersion
+	case SelectorDataKeyCreatedAt:
+		return SelectorDataTypeDate
+	case SelectorDataKeyWorkerVMSizes:
+		return SelectorDataTypeList
+	case SelectorDataKeyCertificatesDaysUntilExpiry:
+		return SelectorDataTypeInteger
 	}
 
 	return SelectorDataTypeString
 }
 
+func (s selectorData) GetType(key string) SelectorDataType {
+	return GetType(key)
+}
+
 func (s selectorData) GetString(key string) (string, bool) {
 	val, ok := s[SelectorDataKey(key)]
+	if !ok && strings.HasPrefix(key, SelectorDataKeyOperatorFlagPrefix) {
+		// operator flags which are not set on the cluster are empty, rather
+		// than an error, as not every cluster has every flag
+		return "", true
+	}
 	return val, ok
 }
 
-func (s selectorData) Matches(log *logrus.Entry, selectors []*api.MaintenanceScheduleSelector) (bool, error) {
-	matches := true
+// MaintenanceWindow returns the cluster's maintenance window, or nil if it
+// does not have one.
+func (c clusterView) MaintenanceWindow() (*api.MaintenanceWindow, error) {
+	if c.maintenanceWindow == nil {
+		return nil, nil
+	}
 
+	return c.maintenanceWindow, c.maintenanceWindow.Validate()
+}
+
+// Matches returns whether the cluster matches all of `selectors`.
+func (s selectorData) Matches(log *logrus.Entry, selectors []*api.MaintenanceScheduleSelector) (bool, error) {
 	// Empty selector list never matches
 	if len(selectors) == 0 {
 		return false, errors.New("empty selector list")
 	}
 
+	matches := true
 	for _, selector := range selectors {
-		selectorType := s.GetType(selector.Key)
-
-		switch selectorType {
-		case SelectorDataTypeString:
-			selectorVal, exists := s.GetString(selector.Key)
-			if !exists {
-				// selector doesn't match key
-				return false, fmt.Errorf("requested non-existent '%s' selector key", selector.Key)
+		m, err := s.matchesSelector(log, selector)
+		if err != nil {
+			return false, err
+		}
+		if !m {
+			matches = false
+		}
+	}
+	return matches, nil
+}
+
+func (s selectorData) matchesSelector(log *logrus.Entry, selector *api.MaintenanceScheduleSelector) (bool, error) {
+	switch selector.Operator {
+	case api.MaintenanceScheduleSelectorOperatorAll:
+		return s.Matches(log, selector.Selectors)
+
+	case api.MaintenanceScheduleSelectorOperatorAny:
+		if len(selector.Selectors) == 0 {
+			return false, errors.New("empty selector list")
+		}
+		matches := false
+		for _, nested := range selector.Selectors {
+			m, err := s.matchesSelector(log, nested)
+			if err != nil {
+				return false, err
 			}
-			switch selector.Operator {
-			// string eq
-			case api.MaintenanceScheduleSelectorOperatorEq:
-				if selectorVal != selector.Value {
-					// does not match
-					matches = false
-				}
+			if m {
+				matches = true
+			}
+		}
+		return matches, nil
+	}
 
-			// string in/not-in
-			case api.MaintenanceScheduleSelectorOperatorIn, api.MaintenanceScheduleSelectorOperatorNotIn:
-				contains := slices.Contains(selector.Values, selectorVal)
+	selectorVal, exists := s.GetString(selector.Key)
+	if !exists {
+		// selector doesn't match key
+		return false, fmt.Errorf("requested non-existent '%s' selector key", selector.Key)
+	}
+
+	selectorType := s.GetType(selector.Key)
+	switch selectorType {
+	case SelectorDataTypeString:
+		return matchesString(selectorVal, selector)
+
+	case SelectorDataTypeList:
+		var items []string
+		if selectorVal != "" {
+			items = strings.Split(selectorVal, ",")
+		}
 
-				if (!contains && selector.Operator == api.MaintenanceScheduleSelectorOperatorIn) ||
-					(contains && selector.Operator == api.MaintenanceScheduleSelectorOperatorNotIn) {
-					matches = false
+		if selector.Operator == api.MaintenanceScheduleSelectorOperatorNotIn {
+			for _, item := range items {
+				if slices.Contains(selector.Values, item) {
+					return false, nil
 				}
-			default:
-				return false, fmt.Errorf("unknown selector operator %s", selector.Operator)
 			}
+			return true, nil
+		}
+
+		for _, item := range items {
+			m, err := matchesString(item, selector)
+			if err != nil {
+				return false, err
+			}
+			if m {
+				return true, nil
+			}
+		}
+		return false, nil
+
+	case SelectorDataTypeVersion, SelectorDataTypeDate, SelectorDataTypeInteger:
+		switch selector.Operator {
+		case api.MaintenanceScheduleSelectorOperatorLt, api.MaintenanceScheduleSelectorOperatorLe,
+			api.MaintenanceScheduleSelectorOperatorGt, api.MaintenanceScheduleSelectorOperatorGe:
+		default:
+			return matchesString(selectorVal, selector)
+		}
+
+		// clusters which don't have a value can't be compared against
+		if selectorVal == "" {
+			return false, nil
+		}
+
+		cmp, err := compare(selectorType, selectorVal, selector.Value)
+		if err != nil {
+			return false, fmt.Errorf("unable to compare '%s' selector key: %w", selector.Key, err)
+		}
+
+		switch selector.Operator {
+		case api.MaintenanceScheduleSelectorOperatorLt:
+			return cmp < 0, nil
+		case api.MaintenanceScheduleSelectorOperatorLe:
+			return cmp <= 0, nil
+		case api.MaintenanceScheduleSelectorOperatorGt:
+			return cmp > 0, nil
 		default:
-			return false, fmt.Errorf("unhandled type '%s' of key '%s'", selectorType, selector.Key)
+			return cmp >= 0, nil
 		}
 	}
-	return matches, nil
+
+	return false, fmt.Errorf("unhandled type '%s' of key '%s'", selectorType, selector.Key)
+}
+
+func matchesString(val string, selector *api.MaintenanceScheduleSelector) (bool, error) {
+	switch selector.Operator {
+	// string eq
+	case api.MaintenanceScheduleSelectorOperatorEq:
+		return val == selector.Value, nil
+
+	// string in/not-in
+	case api.MaintenanceScheduleSelectorOperatorIn:
+		return slices.Contains(selector.Values, val), nil
+	case api.MaintenanceScheduleSelectorOperatorNotIn:
+		return !slices.Contains(selector.Values, val), nil
+
+	// string regex
+	case api.MaintenanceScheduleSelectorOperatorMatches:
+		rx, err := regexp.Compile(selector.Value)
+		if err != nil {
+			return false, err
+		}
+		return rx.MatchString(val), nil
+
+	case api.MaintenanceScheduleSelectorOperatorLt, api.MaintenanceScheduleSelectorOperatorLe,
+		api.MaintenanceScheduleSelectorOperatorGt, api.MaintenanceScheduleSelectorOperatorGe:
+		return false, fmt.Errorf("selector operator %s is not supported on key '%s' of type '%s'", selector.Operator, selector.Key, GetType(selector.Key))
+	}
+
+	return false, fmt.Errorf("unknown selector operator %s", selector.Operator)
+}
+
+// compare returns -1, 0 or 1 depending on whether `a` is less than, equal to
+// or greater than `b`.
+func compare(t SelectorDataType, a, b string) (int, error) {
+	switch t {
+	case SelectorDataTypeVersion:
+		va, err := version.ParseVersion(a)
+		if err != nil {
+			return 0, err
+		}
+		vb, err := version.ParseVersion(b)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case va.Lt(vb):
+			return -1, nil
+		case va.Eq(vb):
+			return 0, nil
+		}
+		return 1, nil
+
+	case SelectorDataTypeDate:
+		ta, err := time.Parse(time.RFC3339, a)
+		if err != nil {
+			return 0, err
+		}
+		tb, err := time.Parse(time.RFC3339, b)
+		if err != nil {
+			return 0, err
+		}
+		return ta.Compare(tb), nil
+
+	case SelectorDataTypeInteger:
+		ia, err := strconv.Atoi(a)
+		if err != nil {
+			return 0, err
+		}
+		ib, err := strconv.Atoi(b)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case ia < ib:
+			return -1, nil
+		case ia == ib:
+			return 0, nil
+		}
+		return 1, nil
+	}
+
+	return 0, fmt.Errorf("type '%s' cannot be compared", t)
+}
+
+// ValidateSelectors checks that the operators used in `selectors` are
+// supported by the types of their keys, and that any versions or dates being
+// compared against can be parsed. It does not check that the keys exist.
+func ValidateSelectors(selectors []*api.MaintenanceScheduleSelector) error {
+	for _, selector := range selectors {
+		switch selector.Operator {
+		case api.MaintenanceScheduleSelectorOperatorAny, api.MaintenanceScheduleSelectorOperatorAll:
+			err := ValidateSelectors(selector.Selectors)
+			if err != nil {
+				return err
+			}
+			continue
+
+		case api.MaintenanceScheduleSelectorOperatorLt, api.MaintenanceScheduleSelectorOperatorLe,
+			api.MaintenanceScheduleSelectorOperatorGt, api.MaintenanceScheduleSelectorOperatorGe:
+		default:
+			continue
+		}
+
+		t := GetType(selector.Key)
+		switch t {
+		case SelectorDataTypeVersion:
+			_, err := version.ParseVersion(selector.Value)
+			if err != nil {
+				return fmt.Errorf("selector key '%s' requires a version: %w", selector.Key, err)
+			}
+		case SelectorDataTypeDate:
+			_, err := time.Parse(time.RFC3339, selector.Value)
+			if err != nil {
+				return fmt.Errorf("selector key '%s' requires an RFC3339 date: %w", selector.Key, err)
+			}
+		case SelectorDataTypeInteger:
+			_, err := strconv.Atoi(selector.Value)
+			if err != nil {
+				return fmt.Errorf("selector key '%s' requires an integer: %w", selector.Key, err)
+			}
+		default:
+			return fmt.Errorf("selector operator %s is not supported on key '%s' of type '%s'", selector.Operator, selector.Key, t)
+		}
+	}
+	return nil
+}
+
+// ValidateSelectorKeys checks that the keys of `selectors`, including nested
+// selectors, are set in every cluster's selector data.
+func ValidateSelectorKeys(selectors []*api.MaintenanceScheduleSelector) error {
+	for _, selector := range selectors {
+		switch selector.Operator {
+		case api.MaintenanceScheduleSelectorOperatorAny, api.MaintenanceScheduleSelectorOperatorAll:
+			err := ValidateSelectorKeys(selector.Selectors)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !slices.Contains(selectorDataKeys, SelectorDataKey(selector.Key)) && !strings.HasPrefix(selector.Key, SelectorDataKeyOperatorFlagPrefix) {
+			return fmt.Errorf("unknown selector key '%s'", selector.Key)
+		}
+	}
+	return nil
+}
+
+// AddHealthSnapshot fills in the selector data which comes from the cluster's
+// health snapshot, which is nil if the cluster does not have one.
+func (s selectorData) AddHealthSnapshot(snapshot *api.ClusterHealthSnapshot, now time.Time) {
+	s[SelectorDataKeyCertificatesDaysUntilExpiry] = ""
+	if snapshot == nil {
+		return
+	}
+
+	var earliest int64
+	for _, r := range snapshot.Collectors {
+		if r.EarliestCertificateExpiry != 0 && (earliest == 0 || r.EarliestCertificateExpiry < earliest) {
+			earliest = r.EarliestCertificateExpiry
+		}
+	}
+
+	if earliest != 0 {
+		s[SelectorDataKeyCertificatesDaysUntilExpiry] = strconv.Itoa(int(time.Unix(earliest, 0).Sub(now) / (24 * time.Hour)))
+	}
+}
+
+// selectsOn returns whether any of `selectors`, including nested selectors,
+// select on `key`.
+func selectsOn(selectors []*api.MaintenanceScheduleSelector, key SelectorDataKey) bool {
+	for _, selector := range selectors {
+		if SelectorDataKey(selector.Key) == key || selectsOn(selector.Selectors, key) {
+			return true
+		}
+	}
+	return false
 }
 
 func ToSelectorData(doc *api.OpenShiftClusterDocument, subscriptionState string) (selectorData, error) {
@@ -122,5 +451,43 @@ func ToSelectorData(doc *api.OpenShiftClusterDocument, subscriptionState string)
 	new[SelectorDataOutboundType] = string(doc.OpenShiftCluster.Properties.NetworkProfile.OutboundType)
 	new[SelectorDataAPIServerVisibility] = string(doc.OpenShiftCluster.Properties.APIServerProfile.Visibility)
 	new[SelectorDataIsManagedDomain] = fmt.Sprintf("%t", dns.IsManagedDomain(doc.OpenShiftCluster.Properties.ClusterProfile.Domain))
+	new[SelectorDataKeyVersion] = doc.OpenShiftCluster.Properties.ClusterProfile.Version
+	new[SelectorDataKeyRegion] = strings.ToLower(doc.OpenShiftCluster.Location)
+
+	new[SelectorDataKeyCreatedAt] = ""
+	if !doc.OpenShiftCluster.Properties.CreatedAt.IsZero() {
+		new[SelectorDataKeyCreatedAt] = doc.OpenShiftCluster.Properties.CreatedAt.UTC().Format(time.RFC3339)
+	}
+
+	new[SelectorDataKeyMasterVMSize] = string(doc.OpenShiftCluster.Properties.MasterProfile.VMSize)
+
+	workerProfiles, _ := api.GetEnrichedWorkerProfiles(doc.OpenShiftCluster.Properties)
+	workerVMSizes := []string{}
+	for _, wp := range workerProfiles {
+		if wp.VMSize != "" && !slices.Contains(workerVMSizes, string(wp.VMSize)) {
+			workerVMSizes = append(workerVMSizes, string(wp.VMSize))
+		}
+	}
+	slices.Sort(workerVMSizes)
+	new[SelectorDataKeyWorkerVMSizes] = strings.Join(workerVMSizes, ",")
+
+	new[SelectorDataKeyCertificatesDaysUntilExpiry] = ""
+
+	for k, v := range doc.OpenShiftCluster.Properties.OperatorFlags {
+		new[SelectorDataKey(SelectorDataKeyOperatorFlagPrefix+k)] = v
+	}
 	return new, nil
 }
+
+func toClusterView(doc *api.OpenShiftClusterDocument, subscriptionState string) (clusterView, error) {
+	s, err := ToSelectorData(doc, subscriptionState)
+	if err != nil {
+		return clusterView{}, err
+	}
+
+	return clusterView{
+		selectorData:      s,
+		documentID:        doc.ID,
+		maintenanceWindow: doc.OpenShiftCluster.Properties.MaintenanceWindow,
+	}, nil
+}
@@ -0,0 +1,366 @@
+package scheduler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestSelectorDataMatches(t *testing.T) {
+	data := selectorData{
+		SelectorDataKeySubscriptionState: "Registered",
+		SelectorDataKeyVersion:           "4.14.16",
+		SelectorDataKeyRegion:            "eastus",
+		SelectorDataKeyCreatedAt:         "2024-03-01T12:00:00Z",
+		SelectorDataKeyWorkerVMSizes:     "Standard_D4s_v3,Standard_D4s_v5",
+
+		SelectorDataKeyCertificatesDaysUntilExpiry: "9",
+
+		"operatorFlags.aro.imageconfig.enabled": "true",
+	}
+
+	for _, tt := range []struct {
+		name      string
+		selectors []*api.MaintenanceScheduleSelector
+		want      bool
+		wantErr   string
+	}{
+		{
+			name:    "empty selector list",
+			wantErr: "empty selector list",
+		},
+		{
+			name: "non-existent key",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "foo", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "bar"},
+			},
+			wantErr: "requested non-existent 'foo' selector key",
+		},
+		{
+			name: "all selectors must match",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "region", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "eastus"},
+				{Key: "subscriptionState", Operator: api.MaintenanceScheduleSelectorOperatorNotIn, Values: []string{"Registered"}},
+			},
+			want: false,
+		},
+		{
+			name: "version less than",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "version", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "4.15.0"},
+			},
+			want: true,
+		},
+		{
+			name: "version range",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "version", Operator: api.MaintenanceScheduleSelectorOperatorGe, Value: "4.14.0"},
+				{Key: "version", Operator: api.MaintenanceScheduleSelectorOperatorLe, Value: "4.14.15"},
+			},
+			want: false,
+		},
+		{
+			name: "version equal",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "version", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "4.14.16"},
+			},
+			want: true,
+		},
+		{
+			name: "created before",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "createdAt", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "2024-06-01T00:00:00Z"},
+			},
+			want: true,
+		},
+		{
+			name: "created after",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "createdAt", Operator: api.MaintenanceScheduleSelectorOperatorGt, Value: "2024-06-01T00:00:00Z"},
+			},
+			want: false,
+		},
+		{
+			name: "regex",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "region", Operator: api.MaintenanceScheduleSelectorOperatorMatches, Value: "^east"},
+			},
+			want: true,
+		},
+		{
+			name: "list matches any item",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "workerVMSizes", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "Standard_D4s_v5"},
+			},
+			want: true,
+		},
+		{
+			name: "list notin matches no items",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "workerVMSizes", Operator: api.MaintenanceScheduleSelectorOperatorNotIn, Values: []string{"Standard_D4s_v3"}},
+			},
+			want: false,
+		},
+		{
+			name: "operator flag",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "operatorFlags.aro.imageconfig.enabled", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "true"},
+			},
+			want: true,
+		},
+		{
+			name: "unset operator flag is empty",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "operatorFlags.aro.dnsmasq.enabled", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: ""},
+			},
+			want: true,
+		},
+		{
+			name: "any group",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{
+					Operator: api.MaintenanceScheduleSelectorOperatorAny,
+					Selectors: []*api.MaintenanceScheduleSelector{
+						{Key: "region", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "westus"},
+						{
+							Operator: api.MaintenanceScheduleSelectorOperatorAll,
+							Selectors: []*api.MaintenanceScheduleSelector{
+								{Key: "region", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "eastus"},
+								{Key: "version", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "4.15.0"},
+							},
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "any group with no matches",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{
+					Operator: api.MaintenanceScheduleSelectorOperatorAny,
+					Selectors: []*api.MaintenanceScheduleSelector{
+						{Key: "region", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "westus"},
+						{Key: "version", Operator: api.MaintenanceScheduleSelectorOperatorGt, Value: "4.15.0"},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "comparison on string key",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "region", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "westus"},
+			},
+			wantErr: "selector operator lt is not supported on key 'region' of type 'string'",
+		},
+		{
+			name: "integer less than",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "certificatesDaysUntilExpiry", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "30"},
+			},
+			want: true,
+		},
+		{
+			name: "integer compared numerically",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "certificatesDaysUntilExpiry", Operator: api.MaintenanceScheduleSelectorOperatorGt, Value: "10"},
+			},
+			want: false,
+		},
+		{
+			name: "unparseable version",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "version", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "four"},
+			},
+			wantErr: "unable to compare 'version' selector key: could not parse version \"four\"",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, log := testlog.New()
+
+			got, err := data.Matches(log, tt.selectors)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+			if got != tt.want {
+				t.Errorf("wanted %t, got %t", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSelectorDataMatchesMissingValue(t *testing.T) {
+	_, log := testlog.New()
+	data := selectorData{SelectorDataKeyVersion: ""}
+
+	got, err := data.Matches(log, []*api.MaintenanceScheduleSelector{
+		{Key: "version", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "4.15.0"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got {
+		t.Error("cluster without a version should not match a version comparison")
+	}
+}
+
+func TestValidateSelectors(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		selectors []*api.MaintenanceScheduleSelector
+		wantErr   string
+	}{
+		{
+			name: "valid",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "foo", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "bar"},
+				{Key: "version", Operator: api.MaintenanceScheduleSelectorOperatorGe, Value: "4.14.0"},
+				{Key: "createdAt", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "2024-06-01T00:00:00Z"},
+			},
+		},
+		{
+			name: "invalid date in group",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{
+					Operator: api.MaintenanceScheduleSelectorOperatorAll,
+					Selectors: []*api.MaintenanceScheduleSelector{
+						{Key: "createdAt", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "yesterday"},
+					},
+				},
+			},
+			wantErr: "selector key 'createdAt' requires an RFC3339 date: parsing time \"yesterday\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"yesterday\" as \"2006\"",
+		},
+		{
+			name: "comparison on list",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "workerVMSizes", Operator: api.MaintenanceScheduleSelectorOperatorGt, Value: "Standard_D4s_v3"},
+			},
+			wantErr: "selector operator gt is not supported on key 'workerVMSizes' of type 'list'",
+		},
+		{
+			name: "invalid integer",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "certificatesDaysUntilExpiry", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "a month"},
+			},
+			wantErr: "selector key 'certificatesDaysUntilExpiry' requires an integer: strconv.Atoi: parsing \"a month\": invalid syntax",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSelectors(tt.selectors)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateSelectorKeys(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		selectors []*api.MaintenanceScheduleSelector
+		wantErr   string
+	}{
+		{
+			name: "valid",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "region", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "eastus"},
+				{Key: "operatorFlags.aro.imageconfig.enabled", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "true"},
+			},
+		},
+		{
+			name: "unknown key in group",
+			selectors: []*api.MaintenanceScheduleSelector{
+				{
+					Operator: api.MaintenanceScheduleSelectorOperatorAny,
+					Selectors: []*api.MaintenanceScheduleSelector{
+						{Key: "region", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "eastus"},
+						{Key: "foo", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "bar"},
+					},
+				},
+			},
+			wantErr: "unknown selector key 'foo'",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSelectorKeys(tt.selectors)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestAddHealthSnapshot(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name     string
+		snapshot *api.ClusterHealthSnapshot
+		want     string
+	}{
+		{
+			name: "no snapshot",
+		},
+		{
+			name: "no certificate inventory",
+			snapshot: &api.ClusterHealthSnapshot{
+				Collectors: []api.ClusterHealthCollectorResult{
+					{Name: "emitNodeConditions"},
+				},
+			},
+		},
+		{
+			name: "earliest expiry",
+			snapshot: &api.ClusterHealthSnapshot{
+				Collectors: []api.ClusterHealthCollectorResult{
+					{Name: "emitNodeConditions"},
+					{Name: "emitCertificateInventory", EarliestCertificateExpiry: now.Add(20*24*time.Hour + time.Hour).Unix()},
+					{Name: "other", EarliestCertificateExpiry: now.Add(45 * 24 * time.Hour).Unix()},
+				},
+			},
+			want: "20",
+		},
+		{
+			name: "expired",
+			snapshot: &api.ClusterHealthSnapshot{
+				Collectors: []api.ClusterHealthCollectorResult{
+					{Name: "emitCertificateInventory", EarliestCertificateExpiry: now.Add(-3 * 24 * time.Hour).Unix()},
+				},
+			},
+			want: "-3",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			data := selectorData{}
+			data.AddHealthSnapshot(tt.snapshot, now)
+
+			got, ok := data[SelectorDataKeyCertificatesDaysUntilExpiry]
+			if !ok {
+				t.Fatal("certificatesDaysUntilExpiry not set")
+			}
+			if got != tt.want {
+				t.Errorf("wanted %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestSelectsOn(t *testing.T) {
+	selectors := []*api.MaintenanceScheduleSelector{
+		{Key: "region", Operator: api.MaintenanceScheduleSelectorOperatorEq, Value: "eastus"},
+		{
+			Operator: api.MaintenanceScheduleSelectorOperatorAny,
+			Selectors: []*api.MaintenanceScheduleSelector{
+				{Key: "certificatesDaysUntilExpiry", Operator: api.MaintenanceScheduleSelectorOperatorLt, Value: "30"},
+			},
+		},
+	}
+
+	if !selectsOn(selectors, SelectorDataKeyCertificatesDaysUntilExpiry) {
+		t.Error("wanted nested selector key to be found")
+	}
+	if selectsOn(selectors, SelectorDataKeyVersion) {
+		t.Error("wanted unused selector key not to be found")
+	}
+}
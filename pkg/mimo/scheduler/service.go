@@ -47,6 +47,7 @@ var (
 	defaultSchedulePollReadinessInterval          = 90 * time.Second
 	defaultScheduleUnconditionalReconcileInterval = 60 * time.Minute
 	defaultChangefeedInteval                      = 10 * time.Second
+	defaultChangefeedBatchSize                    = 50
 	defaultChangefeedReadinessInterval            = time.Minute
 	defaultBucketRefreshInterval                  = 10 * time.Second
 	defaultBucketRefreshTTL                       = 60 * time.Second
@@ -107,6 +108,7 @@ type schedulerDBs interface {
 	database.DatabaseGroupWithMaintenanceManifests
 	database.DatabaseGroupWithMaintenanceSchedules
 	database.DatabaseGroupWithPoolWorkers
+	database.DatabaseGroupWithClusterHealthSnapshots
 }
 
 func NewService(env env.Interface, log *logrus.Entry, dbg schedulerDBs, m metrics.Emitter) *service {
@@ -126,7 +128,7 @@ func NewService(env env.Interface, log *logrus.Entry, dbg schedulerDBs, m metric
 		workerMaxStartupDelay: defaultWorkerMaxStartupDelay,
 		newScheduler:          NewSchedulerForSchedule,
 
-		changefeedBatchSize:                    50,
+		changefeedBatchSize:                    defaultChangefeedBatchSize,
 		interval:                               defaultServiceInterval,
 		changefeedInterval:                     defaultChangefeedInteval,
 		changefeedReadinessInterval:            defaultChangefeedReadinessInterval,
@@ -187,6 +189,9 @@ func (s *service) Run(_ctx context.Context, stop <-chan struct{}, done chan<- st
 		m.Handle("/healthz", http.StripPrefix("/healthz", c))
 		// Handle healthz subpaths
 		m.Handle("/healthz/", http.StripPrefix("/healthz", c))
+		if h := metrics.Handler(s.m); h != nil {
+			m.Handle("/metrics", h)
+		}
 
 		h := &http.Server{
 			Handler:     m,
@@ -281,28 +286,34 @@ func (s *service) Run(_ctx context.Context, stop <-chan struct{}, done chan<- st
 }
 
 func (s *service) startChangefeeds(ctx context.Context, stop <-chan struct{}) error {
-	dbOpenShiftClusters, err := s.dbGroup.OpenShiftClusters()
+	return runClusterChangefeeds(ctx, s.baseLog, s.dbGroup, s.subs, s.clusters, s.changefeedInterval, s.changefeedBatchSize, stop)
+}
+
+// runClusterChangefeeds starts the changefeeds which populate the cluster
+// cache and the subscriptions cache it depends on.
+func runClusterChangefeeds(ctx context.Context, log *logrus.Entry, dbs clusterCacheDBs, subs changefeed.SubscriptionsCache, clusters *openShiftClusterCache, interval time.Duration, batchSize int, stop <-chan struct{}) error {
+	dbOpenShiftClusters, err := dbs.OpenShiftClusters()
 	if err != nil {
 		return err
 	}
 
-	dbSubscriptions, err := s.dbGroup.Subscriptions()
+	dbSubscriptions, err := dbs.Subscriptions()
 	if err != nil {
 		return err
 	}
 
 	// start subscription changefeed
 	go changefeed.RunChangefeed(
-		ctx, s.baseLog.WithField("component", "changefeed"), dbSubscriptions.ChangeFeed(),
-		s.changefeedInterval,
-		s.changefeedBatchSize, s.subs, stop,
+		ctx, log.WithField("component", "changefeed"), dbSubscriptions.ChangeFeed(),
+		interval,
+		batchSize, subs, stop,
 	)
 
 	// start cluster changefeed
 	go changefeed.RunChangefeed(
-		ctx, s.baseLog.WithField("component", "changefeed"), dbOpenShiftClusters.ChangeFeed(),
-		s.changefeedInterval,
-		s.changefeedBatchSize, s.clusters, stop,
+		ctx, log.WithField("component", "changefeed"), dbOpenShiftClusters.ChangeFeed(),
+		interval,
+		batchSize, clusters, stop,
 	)
 
 	return nil
@@ -427,8 +438,8 @@ func (s *service) worker(stop <-chan struct{}, id string) {
 	time.Sleep(delay)
 
 	getDoc := func() (*api.MaintenanceScheduleDocument, bool) { return s.b.Doc(id) }
-	getClusters := func() iter.Seq2[string, selectorData] {
-		return func(yield func(string, selectorData) bool) {
+	getClusters := func() iter.Seq2[string, clusterView] {
+		return func(yield func(string, clusterView) bool) {
 			_ownedBuckets, ok := s.buckets.Load().([]int)
 			if !ok {
 				// no owned buckets yet
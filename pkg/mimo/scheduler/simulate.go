@@ -0,0 +1,91 @@
+package scheduler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+)
+
+// Simulate returns the manifests which the Scheduler would create for `doc`
+// if it processed it at `now`, for each of the clusters returned by
+// `getClusters` which match its selectors. The manifests are not saved and
+// have no IDs, and are ordered by RunAfter and then cluster.
+//
+// The clusters, their calendar periods and their maintenance windows are
+// worked out as the Scheduler works them out, but as if the schedule had no
+// manifests yet. Progressive rollouts are not simulated: each manifest is
+// returned as if its wave was already open, as it will be once the earlier
+// waves succeed.
+func Simulate(ctx context.Context, log *logrus.Entry, now time.Time, doc *api.MaintenanceScheduleDocument, getClusters getClustersFunc, dbs database.DatabaseGroupWithClusterHealthSnapshots) ([]*api.MaintenanceManifestDocument, error) {
+	scheduleAcross, err := time.ParseDuration(doc.MaintenanceSchedule.ScheduleAcross)
+	if err != nil {
+		return nil, err
+	}
+
+	calDef, err := ParseCalendar(doc.MaintenanceSchedule.Schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	manifests := []*api.MaintenanceManifestDocument{}
+
+	periods, hasFutureTime := schedulePeriods(log, now, doc, calDef, scheduleAcross)
+	if !hasFutureTime {
+		return manifests, nil
+	}
+
+	for cl, err := range selectClusters(ctx, log, dbs, doc, getClusters, scheduleAcross, now) {
+		if err != nil {
+			return nil, fmt.Errorf("cluster %s: %w", cl.id, err)
+		}
+
+		wave := 0
+		if len(doc.MaintenanceSchedule.Waves) > 0 {
+			wave = ClusterWave(cl.id, doc.MaintenanceSchedule.Waves)
+		}
+
+		scheduled := map[int64]bool{}
+		for _, target := range periods {
+			runAfter, runBefore, ok := scheduleInMaintenanceWindow(target.Add(cl.offset), cl.window)
+			if !ok || scheduled[runAfter.Unix()] {
+				continue
+			}
+
+			runAfter, runBefore, ok = newManifestWindow(now, target, runAfter, runBefore, scheduleAcross, cl.window, wave)
+			if !ok {
+				continue
+			}
+			scheduled[runAfter.Unix()] = true
+
+			manifests = append(manifests, &api.MaintenanceManifestDocument{
+				ClusterResourceID: cl.id,
+				MaintenanceManifest: api.MaintenanceManifest{
+					State: api.MaintenanceManifestStatePending,
+
+					MaintenanceTaskID: doc.MaintenanceSchedule.MaintenanceTaskID,
+					CreatedBySchedule: api.MIMOScheduleID(doc.ID),
+					RunAfter:          runAfter.Unix(),
+					RunBefore:         runBefore.Unix(),
+				},
+			})
+		}
+	}
+
+	sort.Slice(manifests, func(i, j int) bool {
+		if manifests[i].MaintenanceManifest.RunAfter != manifests[j].MaintenanceManifest.RunAfter {
+			return manifests[i].MaintenanceManifest.RunAfter < manifests[j].MaintenanceManifest.RunAfter
+		}
+		return manifests[i].ClusterResourceID < manifests[j].ClusterResourceID
+	})
+
+	return manifests, nil
+}
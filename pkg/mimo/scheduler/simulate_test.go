@@ -0,0 +1,142 @@
+package scheduler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestSimulate(t *testing.T) {
+	_, log := testlog.New()
+
+	subscriptionA := "00000000-0000-0000-0000-00000000000a"
+	subscriptionB := "00000000-0000-0000-0000-00000000000b"
+	clusterID := func(sub, name string) string {
+		return "/subscriptions/" + sub + "/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/" + name
+	}
+
+	clusters := []*api.OpenShiftClusterDocument{
+		{
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: clusterID(subscriptionA, "cluster1"),
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateSucceeded,
+				},
+			},
+		},
+		{
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: clusterID(subscriptionA, "cluster2"),
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateDeleting,
+				},
+			},
+		},
+		{
+			// moved into its maintenance window, once
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: clusterID(subscriptionA, "cluster3"),
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateSucceeded,
+					MaintenanceWindow: &api.MaintenanceWindow{
+						DaysOfWeek:    []string{"Saturday"},
+						StartHour:     3,
+						DurationHours: 2,
+					},
+				},
+			},
+		},
+		{
+			// not in the known subscriptions, so not in the cache
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: clusterID(subscriptionB, "cluster4"),
+				Properties: api.OpenShiftClusterProperties{
+					ProvisioningState: api.ProvisioningStateSucceeded,
+				},
+			},
+		},
+	}
+	subscriptions := []*api.SubscriptionDocument{
+		{
+			ID: subscriptionA,
+			Subscription: &api.Subscription{
+				State:      api.SubscriptionStateRegistered,
+				Properties: &api.SubscriptionProperties{},
+			},
+		},
+	}
+
+	doc := &api.MaintenanceScheduleDocument{
+		ID: "schedule",
+		MaintenanceSchedule: api.MaintenanceSchedule{
+			MaintenanceTaskID: "task",
+			Schedule:          "*-*-* 00:00",
+			LookForwardCount:  2,
+			ScheduleAcross:    "1h",
+			Selectors: []*api.MaintenanceScheduleSelector{
+				{
+					Key:      string(SelectorDataKeySubscriptionState),
+					Operator: api.MaintenanceScheduleSelectorOperatorEq,
+					Value:    string(api.SubscriptionStateRegistered),
+				},
+			},
+		},
+	}
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := NewClusterCache(log, &noop.Noop{})
+	for _, doc := range subscriptions {
+		cache.subs.OnDoc(doc)
+	}
+	cache.subs.OnAllPendingProcessed(true)
+	for _, doc := range clusters {
+		cache.clusters.OnDoc(doc)
+	}
+	cache.clusters.OnAllPendingProcessed(true)
+
+	manifests, err := Simulate(context.Background(), log, now, doc, cache.GetClusters, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	offset := PercentWithinPeriod(ClusterResourceIDHashToScheduleWithinPercent(clusterID(subscriptionA, "cluster1")), time.Hour)
+	expected := []*api.MaintenanceManifestDocument{}
+	for _, period := range []time.Time{
+		time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC),
+		time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC),
+	} {
+		expected = append(expected, &api.MaintenanceManifestDocument{
+			ClusterResourceID: clusterID(subscriptionA, "cluster1"),
+			MaintenanceManifest: api.MaintenanceManifest{
+				State:             api.MaintenanceManifestStatePending,
+				MaintenanceTaskID: "task",
+				CreatedBySchedule: "schedule",
+				RunAfter:          period.Add(offset).Unix(),
+				RunBefore:         period.Add(offset).Add(time.Hour).Unix(),
+			},
+		})
+	}
+	expected = append(expected, &api.MaintenanceManifestDocument{
+		ClusterResourceID: clusterID(subscriptionA, "cluster3"),
+		MaintenanceManifest: api.MaintenanceManifest{
+			State:             api.MaintenanceManifestStatePending,
+			MaintenanceTaskID: "task",
+			CreatedBySchedule: "schedule",
+			RunAfter:          time.Date(2026, 1, 3, 3, 0, 0, 0, time.UTC).Unix(),
+			RunBefore:         time.Date(2026, 1, 3, 4, 0, 0, 0, time.UTC).Unix(),
+		},
+	})
+
+	for _, l := range deep.Equal(expected, manifests) {
+		t.Error(l)
+	}
+}
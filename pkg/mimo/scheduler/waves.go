@@ -0,0 +1,161 @@
+package scheduler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+)
+
+// periodRollout is the state of a progressive rollout within one scheduled
+// period.
+type periodRollout struct {
+	// openWaves is the number of waves which may have manifests created
+	openWaves int
+	// existing is the set of clusters which already have a manifest in this
+	// period, in any state
+	existing map[string]bool
+}
+
+// ClusterWave returns the index of the wave in `waves` which the cluster
+// belongs to. Clusters are placed into waves using the same hash as their
+// offset within scheduleAcross, so earlier waves are also scheduled earlier.
+func ClusterWave(resourceID string, waves []int) int {
+	percent := ClusterResourceIDHashToScheduleWithinPercent(resourceID) * 100
+	for i, w := range waves {
+		if percent < float64(w) {
+			return i
+		}
+	}
+	return len(waves) - 1
+}
+
+func isFinishedManifestState(s api.MaintenanceManifestState) bool {
+	switch s {
+	case api.MaintenanceManifestStatePending, api.MaintenanceManifestStateInProgress:
+		return false
+	}
+	return true
+}
+
+func isFailedManifestState(s api.MaintenanceManifestState) bool {
+	switch s {
+	case api.MaintenanceManifestStateFailed,
+		api.MaintenanceManifestStateRetriesExceeded,
+		api.MaintenanceManifestStateTimedOut:
+		return true
+	}
+	return false
+}
+
+// evaluateWaves works out how many waves of `doc` are open in each of the
+// given periods. Only the first wave is open before a period starts. After
+// that, a wave opens once every manifest in the earlier waves of the period
+// has finished, as long as the proportion of those which failed
+// (cancelled manifests are not counted) is within the schedule's failure
+// threshold. If the threshold is exceeded, the reason the schedule should be
+// paused is returned.
+func (a *scheduler) evaluateWaves(ctx context.Context, manifestsDB database.MaintenanceManifests, doc *api.MaintenanceScheduleDocument, periods []time.Time, scheduleAcross time.Duration) (map[int64]*periodRollout, string, error) {
+	waves := doc.MaintenanceSchedule.Waves
+	threshold := doc.MaintenanceSchedule.WaveFailureThresholdPercent
+	now := a.env.Now()
+
+	rollouts := map[int64]*periodRollout{}
+	for _, period := range periods {
+		// scheduleAcross offsets are rounded to the second, so make sure a
+		// zero-length window still includes the period start
+		windowEnd := period.Add(scheduleAcross)
+		if scheduleAcross < time.Second {
+			windowEnd = period.Add(time.Second)
+		}
+
+		byWave := make([][]*api.MaintenanceManifestDocument, len(waves))
+		r := &periodRollout{
+			openWaves: 1,
+			existing:  map[string]bool{},
+		}
+
+		i := manifestsDB.GetForScheduleIDInWindow(ctx, doc.ID, period.Unix(), windowEnd.Unix(), "")
+		for {
+			docs, err := i.Next(ctx, -1)
+			if err != nil {
+				return nil, "", fmt.Errorf("error when consuming manifests for %s window: %w", period.Format(friendlyDateFormat), err)
+			}
+			if docs == nil || docs.GetCount() == 0 {
+				break
+			}
+
+			for _, d := range docs.MaintenanceManifestDocuments {
+				clusterID := strings.ToLower(d.ClusterResourceID)
+				r.existing[clusterID] = true
+				w := ClusterWave(clusterID, waves)
+				byWave[w] = append(byWave[w], d)
+			}
+		}
+
+		// Nothing can have finished in a period which has not started yet
+		failed, total := 0, 0
+		for ; r.openWaves < len(waves) && !now.Before(period); r.openWaves++ {
+			previous := byWave[r.openWaves-1]
+
+			finished := true
+			for _, d := range previous {
+				if !isFinishedManifestState(d.MaintenanceManifest.State) {
+					finished = false
+					break
+				}
+				if d.MaintenanceManifest.State == api.MaintenanceManifestStateCancelled {
+					continue
+				}
+				total++
+				if isFailedManifestState(d.MaintenanceManifest.State) {
+					failed++
+				}
+			}
+			if !finished {
+				break
+			}
+
+			if total > 0 && failed*100 > threshold*total {
+				return nil, fmt.Sprintf("%d of %d manifests in the first %d%% of the %s window failed, exceeding the failure threshold of %d%%",
+					failed, total, waves[r.openWaves-1], period.Format(friendlyDateFormat), threshold), nil
+			}
+		}
+
+		a.log.Infof("%d of %d waves open for %s window", r.openWaves, len(waves), period.Format(friendlyDateFormat))
+		rollouts[period.Unix()] = r
+	}
+
+	return rollouts, "", nil
+}
+
+// pause marks the schedule as paused so that no further manifests are created
+// for it until it is re-enabled.
+func (a *scheduler) pause(ctx context.Context, doc *api.MaintenanceScheduleDocument, reason string) error {
+	schedulesDB, err := a.dbs.MaintenanceSchedules()
+	if err != nil {
+		return fmt.Errorf("unable to get maintenanceschedules: %w", err)
+	}
+
+	_, err = schedulesDB.Patch(ctx, doc.ID, func(d *api.MaintenanceScheduleDocument) error {
+		d.MaintenanceSchedule.State = api.MaintenanceScheduleStatePaused
+		d.MaintenanceSchedule.PausedReason = reason
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to pause schedule: %w", err)
+	}
+
+	a.log.Warnf("paused schedule: %s", reason)
+	a.m.EmitGauge("mimo.scheduler.schedule.paused", 1, map[string]string{
+		"scheduleID": doc.ID,
+		"taskID":     string(doc.MaintenanceSchedule.MaintenanceTaskID),
+	})
+	return nil
+}
@@ -0,0 +1,313 @@
+package scheduler
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"fmt"
+	"iter"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/monitor/dimension"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+	"github.com/Azure/ARO-RP/test/util/deterministicuuid"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+	testmetrics "github.com/Azure/ARO-RP/test/util/metrics"
+)
+
+func TestClusterWave(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		clusterN int
+		waves    []int
+		want     int
+	}{
+		{
+			name:     "single wave",
+			clusterN: 2,
+			waves:    []int{100},
+			want:     0,
+		},
+		{
+			name:     "first wave",
+			clusterN: 4, // hash 0.18
+			waves:    []int{1, 10, 50, 100},
+			want:     2,
+		},
+		{
+			name:     "last wave",
+			clusterN: 2, // hash 0.77
+			waves:    []int{1, 10, 50, 100},
+			want:     3,
+		},
+		{
+			name:     "boundary",
+			clusterN: 1, // hash 0.36
+			waves:    []int{36, 37, 100},
+			want:     1,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ClusterWave(waveTestClusterID(tt.clusterN), tt.waves)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func waveTestClusterID(n int) string {
+	return fmt.Sprintf("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroup/providers/microsoft.redhatopenshift/openshiftclusters/cluster%d", n)
+}
+
+func TestProcessWaves(t *testing.T) {
+	uuidGeneratorSchedules := deterministicuuid.NewTestUUIDGenerator(deterministicuuid.MAINTENANCE_SCHEDULES)
+	scheduleID := uuidGeneratorSchedules.Generate()
+
+	// with waves of 50% and 100%, clusters 1 (hash 0.36) and 4 (hash 0.18)
+	// are in the first wave and cluster 2 (hash 0.77) is in the second
+	cluster1 := waveTestClusterID(1)
+	cluster2 := waveTestClusterID(2)
+	cluster4 := waveTestClusterID(4)
+
+	// the schedule started at 00:00 on Monday 5th Jan, with a scheduleAcross
+	// of 1h, so is still running at 00:30
+	period := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	nextPeriod := period.AddDate(0, 0, 7)
+	offset := func(clusterID string) time.Duration {
+		return PercentWithinPeriod(ClusterResourceIDHashToScheduleWithinPercent(clusterID), time.Hour)
+	}
+
+	schedule := &api.MaintenanceScheduleDocument{
+		ID: scheduleID,
+		MaintenanceSchedule: api.MaintenanceSchedule{
+			State:             api.MaintenanceScheduleStateEnabled,
+			MaintenanceTaskID: api.MIMOTaskID("0"),
+
+			Schedule:         "Mon *-*-* 00:00:00",
+			LookForwardCount: 1,
+			ScheduleAcross:   "1h",
+
+			Selectors: []*api.MaintenanceScheduleSelector{
+				{
+					Key:      string(SelectorDataKeySubscriptionState),
+					Operator: "eq",
+					Value:    string(api.SubscriptionStateRegistered),
+				},
+			},
+
+			Waves:                       []int{50, 100},
+			WaveFailureThresholdPercent: 10,
+		},
+	}
+
+	manifest := func(id, clusterID string, state api.MaintenanceManifestState, runAfter, runBefore time.Time) *api.MaintenanceManifestDocument {
+		return &api.MaintenanceManifestDocument{
+			ID:                id,
+			ClusterResourceID: clusterID,
+			MaintenanceManifest: api.MaintenanceManifest{
+				State:             state,
+				MaintenanceTaskID: "0",
+				CreatedBySchedule: api.MIMOScheduleID(scheduleID),
+				RunAfter:          runAfter.Unix(),
+				RunBefore:         runBefore.Unix(),
+			},
+		}
+	}
+	scheduled := func(id, clusterID string, state api.MaintenanceManifestState, p time.Time) *api.MaintenanceManifestDocument {
+		return manifest(id, clusterID, state, p.Add(offset(clusterID)), p.Add(offset(clusterID)).Add(time.Hour))
+	}
+	created := func(clusterID string, value int64) testmetrics.MetricsAssertion[int64] {
+		r, err := azure.ParseResourceID(clusterID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return testmetrics.MetricsAssertion[int64]{
+			MetricName: "mimo.scheduler.manifests.created",
+			Dimensions: map[string]string{
+				dimension.ResourceID:           clusterID,
+				dimension.SubscriptionID:       r.SubscriptionID,
+				dimension.ClusterResourceGroup: r.ResourceGroup,
+				dimension.ResourceName:         r.ResourceName,
+			},
+			Value: value,
+		}
+	}
+
+	for _, tt := range []struct {
+		name              string
+		now               time.Time
+		existingManifests []*api.MaintenanceManifestDocument
+		desiredManifests  func(newID func() string) []*api.MaintenanceManifestDocument
+		wantPausedReason  string
+		wantMetrics       []testmetrics.MetricsAssertion[int64]
+	}{
+		{
+			name: "later waves wait for earlier waves to finish",
+			now:  period.Add(30 * time.Minute),
+			existingManifests: []*api.MaintenanceManifestDocument{
+				scheduled("00000000-0000-0000-0000-000000000001", cluster1, api.MaintenanceManifestStateInProgress, period),
+				scheduled("00000000-0000-0000-0000-000000000004", cluster4, api.MaintenanceManifestStateCompleted, period),
+			},
+			desiredManifests: func(newID func() string) []*api.MaintenanceManifestDocument {
+				// clusters are iterated in order, and only the first wave is
+				// open for the next period
+				return []*api.MaintenanceManifestDocument{
+					scheduled("00000000-0000-0000-0000-000000000001", cluster1, api.MaintenanceManifestStateInProgress, period),
+					scheduled("00000000-0000-0000-0000-000000000004", cluster4, api.MaintenanceManifestStateCompleted, period),
+					scheduled(newID(), cluster1, api.MaintenanceManifestStatePending, nextPeriod),
+					scheduled(newID(), cluster4, api.MaintenanceManifestStatePending, nextPeriod),
+				}
+			},
+			wantMetrics: []testmetrics.MetricsAssertion[int64]{
+				created(cluster1, 1),
+				created(cluster4, 1),
+			},
+		},
+		{
+			name: "later waves are created once earlier waves succeed",
+			now:  period.Add(30 * time.Minute),
+			existingManifests: []*api.MaintenanceManifestDocument{
+				scheduled("00000000-0000-0000-0000-000000000001", cluster1, api.MaintenanceManifestStateCompleted, period),
+				scheduled("00000000-0000-0000-0000-000000000004", cluster4, api.MaintenanceManifestStateCompleted, period),
+			},
+			desiredManifests: func(newID func() string) []*api.MaintenanceManifestDocument {
+				return []*api.MaintenanceManifestDocument{
+					scheduled("00000000-0000-0000-0000-000000000001", cluster1, api.MaintenanceManifestStateCompleted, period),
+					scheduled("00000000-0000-0000-0000-000000000004", cluster4, api.MaintenanceManifestStateCompleted, period),
+					scheduled(newID(), cluster1, api.MaintenanceManifestStatePending, nextPeriod),
+					scheduled(newID(), cluster2, api.MaintenanceManifestStatePending, period),
+					scheduled(newID(), cluster4, api.MaintenanceManifestStatePending, nextPeriod),
+				}
+			},
+			wantMetrics: []testmetrics.MetricsAssertion[int64]{
+				created(cluster1, 1),
+				created(cluster2, 1),
+				created(cluster4, 1),
+			},
+		},
+		{
+			name: "later waves created after their target time are given time to start",
+			now:  period.Add(50 * time.Minute),
+			existingManifests: []*api.MaintenanceManifestDocument{
+				scheduled("00000000-0000-0000-0000-000000000001", cluster1, api.MaintenanceManifestStateCompleted, period),
+				scheduled("00000000-0000-0000-0000-000000000004", cluster4, api.MaintenanceManifestStateCancelled, period),
+			},
+			desiredManifests: func(newID func() string) []*api.MaintenanceManifestDocument {
+				return []*api.MaintenanceManifestDocument{
+					scheduled("00000000-0000-0000-0000-000000000001", cluster1, api.MaintenanceManifestStateCompleted, period),
+					scheduled("00000000-0000-0000-0000-000000000004", cluster4, api.MaintenanceManifestStateCancelled, period),
+					scheduled(newID(), cluster1, api.MaintenanceManifestStatePending, nextPeriod),
+					manifest(newID(), cluster2, api.MaintenanceManifestStatePending, period.Add(offset(cluster2)), period.Add(110*time.Minute)),
+					scheduled(newID(), cluster4, api.MaintenanceManifestStatePending, nextPeriod),
+				}
+			},
+			wantMetrics: []testmetrics.MetricsAssertion[int64]{
+				created(cluster1, 1),
+				created(cluster2, 1),
+				created(cluster4, 1),
+			},
+		},
+		{
+			name: "schedule is paused when earlier waves exceed the failure threshold",
+			now:  period.Add(30 * time.Minute),
+			existingManifests: []*api.MaintenanceManifestDocument{
+				scheduled("00000000-0000-0000-0000-000000000001", cluster1, api.MaintenanceManifestStateFailed, period),
+				scheduled("00000000-0000-0000-0000-000000000004", cluster4, api.MaintenanceManifestStateCompleted, period),
+			},
+			desiredManifests: func(newID func() string) []*api.MaintenanceManifestDocument {
+				return []*api.MaintenanceManifestDocument{
+					scheduled("00000000-0000-0000-0000-000000000001", cluster1, api.MaintenanceManifestStateFailed, period),
+					scheduled("00000000-0000-0000-0000-000000000004", cluster4, api.MaintenanceManifestStateCompleted, period),
+				}
+			},
+			wantPausedReason: "1 of 2 manifests in the first 50% of the 2026-01-05T00:00Z window failed, exceeding the failure threshold of 10%",
+			wantMetrics: []testmetrics.MetricsAssertion[int64]{
+				{
+					MetricName: "mimo.scheduler.schedule.paused",
+					Dimensions: map[string]string{
+						"scheduleID": scheduleID,
+						"taskID":     "0",
+					},
+					Value: 1,
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := t.Context()
+
+			controller := gomock.NewController(t)
+			_env := mock_env.NewMockInterface(controller)
+			_env.EXPECT().Now().AnyTimes().Return(tt.now)
+
+			_, log := testlog.New()
+			m := testmetrics.NewFakeMetricsEmitter(t)
+
+			manifests, manifestsClient := testdatabase.NewFakeMaintenanceManifests(_env.Now)
+			schedules, schedulesClient := testdatabase.NewFakeMaintenanceSchedules()
+			dbs := database.NewDBGroup().
+				WithMaintenanceManifests(manifests).
+				WithMaintenanceSchedules(schedules)
+
+			fixtures := testdatabase.NewFixture()
+			fixtures.AddMaintenanceScheduleDocuments(schedule)
+			fixtures.AddMaintenanceManifestDocuments(tt.existingManifests...)
+			err := fixtures.WithMaintenanceManifests(manifests).WithMaintenanceSchedules(schedules).Create()
+			require.NoError(err)
+
+			a := &scheduler{
+				log: log,
+				env: _env,
+				m:   m,
+
+				dbs:       dbs,
+				cachedDoc: func() (*api.MaintenanceScheduleDocument, bool) { return schedule, true },
+				getClusters: func() iter.Seq2[string, clusterView] {
+					return func(yield func(string, clusterView) bool) {
+						for _, clusterID := range []string{cluster1, cluster2, cluster4} {
+							if !yield(clusterID, clusterView{selectorData: selectorData{
+								SelectorDataKeyResourceID:        clusterID,
+								SelectorDataKeySubscriptionState: string(api.SubscriptionStateRegistered),
+							}}) {
+								return
+							}
+						}
+					}
+				},
+			}
+
+			didWork, err := a.Process(ctx)
+			require.NoError(err)
+			require.True(didWork)
+
+			uuids := deterministicuuid.NewTestUUIDGenerator(deterministicuuid.MAINTENANCE_MANIFESTS)
+			checker := testdatabase.NewChecker()
+			checker.AddMaintenanceManifestDocuments(tt.desiredManifests(uuids.Generate)...)
+
+			wantSchedule := *schedule
+			if tt.wantPausedReason != "" {
+				wantSchedule.MaintenanceSchedule.State = api.MaintenanceScheduleStatePaused
+				wantSchedule.MaintenanceSchedule.PausedReason = tt.wantPausedReason
+			}
+			checker.AddMaintenanceScheduleDocuments(&wantSchedule)
+
+			errs := checker.CheckMaintenanceManifests(manifestsClient)
+			require.Empty(errs, "MaintenanceManifests don't match")
+
+			errs = checker.CheckMaintenanceSchedules(schedulesClient)
+			require.Empty(errs, "MaintenanceSchedules don't match")
+
+			m.AssertFloats()
+			m.AssertGauges(tt.wantMetrics...)
+		})
+	}
+}
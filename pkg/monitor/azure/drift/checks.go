@@ -0,0 +1,223 @@
+package drift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v6"
+	azstorage "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/util/azureerrors"
+)
+
+// numMasters is the number of master VMs the RP creates
+const numMasters = 3
+
+func (d *DriftMonitor) infraID() string {
+	if d.oc.Properties.InfraID == "" {
+		return "aro"
+	}
+	return d.oc.Properties.InfraID
+}
+
+// checkLoadBalancers checks that the internal load balancer, and the public
+// load balancer unless the cluster uses user defined routing, exist
+func (d *DriftMonitor) checkLoadBalancers(ctx context.Context) ([]Drift, error) {
+	infraID := d.infraID()
+
+	var names []string
+	switch d.oc.Properties.ArchitectureVersion {
+	case api.ArchitectureVersionV1:
+		names = append(names, infraID+"-internal-lb")
+		if d.oc.Properties.NetworkProfile.OutboundType != api.OutboundTypeUserDefinedRouting {
+			names = append(names, infraID+"-public-lb")
+		}
+	case api.ArchitectureVersionV2:
+		names = append(names, infraID+"-internal")
+		if d.oc.Properties.NetworkProfile.OutboundType != api.OutboundTypeUserDefinedRouting {
+			names = append(names, infraID)
+		}
+	default:
+		return nil, fmt.Errorf("unknown architecture version %d", d.oc.Properties.ArchitectureVersion)
+	}
+
+	var drift []Drift
+	for _, name := range names {
+		lb, err := d.loadBalancers.Get(ctx, d.resourceGroup, name, nil)
+		if azureerrors.IsStatusNotFoundError(err) {
+			drift = append(drift, Drift{
+				ResourceType: ResourceTypeLoadBalancer,
+				ResourceName: name,
+				Reason:       ReasonMissing,
+				Message:      "load balancer not found",
+			})
+			continue
+		}
+		if err != nil {
+			return drift, err
+		}
+
+		if lb.SKU == nil || lb.SKU.Name == nil || *lb.SKU.Name != armnetwork.LoadBalancerSKUNameStandard {
+			drift = append(drift, Drift{
+				ResourceType: ResourceTypeLoadBalancer,
+				ResourceName: name,
+				Reason:       ReasonMisconfigured,
+				Message:      fmt.Sprintf("load balancer SKU is not %s", armnetwork.LoadBalancerSKUNameStandard),
+			})
+		}
+	}
+
+	return drift, nil
+}
+
+// checkPrivateDNSZones checks that there is no private DNS zone for the
+// cluster's domain: the RP no longer creates it, and removes that of older
+// clusters. Other private DNS zones are not the RP's, so are not drift.
+func (d *DriftMonitor) checkPrivateDNSZones(ctx context.Context) ([]Drift, error) {
+	zones, err := d.privateZones.ListByResourceGroup(ctx, d.resourceGroup, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var drift []Drift
+	for _, zone := range zones {
+		if zone.Name == nil || !strings.EqualFold(*zone.Name, d.privateZoneName) {
+			continue
+		}
+		drift = append(drift, Drift{
+			ResourceType: ResourceTypePrivateDNSZone,
+			ResourceName: *zone.Name,
+			Reason:       ReasonUnexpected,
+			Message:      "private DNS zone is not expected",
+		})
+	}
+
+	return drift, nil
+}
+
+// checkStorageAccounts checks that the cluster and image registry storage
+// accounts exist and keep the security settings the RP gave them
+func (d *DriftMonitor) checkStorageAccounts(ctx context.Context) ([]Drift, error) {
+	names := []string{"cluster" + d.oc.Properties.StorageSuffix}
+	if d.oc.Properties.ImageRegistryStorageAccountName != "" {
+		names = append(names, d.oc.Properties.ImageRegistryStorageAccountName)
+	}
+
+	var drift []Drift
+	for _, name := range names {
+		account, err := d.storageAccounts.GetProperties(ctx, d.resourceGroup, name, nil)
+		if azureerrors.IsStatusNotFoundError(err) {
+			drift = append(drift, Drift{
+				ResourceType: ResourceTypeStorageAccount,
+				ResourceName: name,
+				Reason:       ReasonMissing,
+				Message:      "storage account not found",
+			})
+			continue
+		}
+		if err != nil {
+			return drift, err
+		}
+
+		var problems []string
+		if props := account.Properties; props == nil {
+			problems = append(problems, "storage account has no properties")
+		} else {
+			if props.AllowBlobPublicAccess == nil || *props.AllowBlobPublicAccess {
+				problems = append(problems, "blob public access is allowed")
+			}
+			if props.EnableHTTPSTrafficOnly == nil || !*props.EnableHTTPSTrafficOnly {
+				problems = append(problems, "HTTP traffic is allowed")
+			}
+			if props.MinimumTLSVersion == nil || *props.MinimumTLSVersion != azstorage.MinimumTLSVersionTLS12 {
+				problems = append(problems, fmt.Sprintf("minimum TLS version is not %s", azstorage.MinimumTLSVersionTLS12))
+			}
+		}
+
+		if len(problems) > 0 {
+			drift = append(drift, Drift{
+				ResourceType: ResourceTypeStorageAccount,
+				ResourceName: name,
+				Reason:       ReasonMisconfigured,
+				Message:      strings.Join(problems, "; "),
+			})
+		}
+	}
+
+	return drift, nil
+}
+
+// checkMasterVirtualMachines checks that the master VMs exist and have the
+// size in the cluster document's master profile
+func (d *DriftMonitor) checkMasterVirtualMachines(ctx context.Context) ([]Drift, error) {
+	expectedSize := string(d.oc.Properties.MasterProfile.VMSize)
+
+	var drift []Drift
+	for i := range numMasters {
+		name := fmt.Sprintf("%s-master-%d", d.infraID(), i)
+
+		vm, err := d.virtualMachines.GetDefault(ctx, d.resourceGroup, name)
+		if azureerrors.IsStatusNotFoundError(err) {
+			drift = append(drift, Drift{
+				ResourceType: ResourceTypeVirtualMachine,
+				ResourceName: name,
+				Reason:       ReasonMissing,
+				Message:      "master virtual machine not found",
+			})
+			continue
+		}
+		if err != nil {
+			return drift, err
+		}
+
+		var size string
+		if vm.Properties != nil && vm.Properties.HardwareProfile != nil && vm.Properties.HardwareProfile.VMSize != nil {
+			size = string(*vm.Properties.HardwareProfile.VMSize)
+		}
+
+		if !strings.EqualFold(size, expectedSize) {
+			drift = append(drift, Drift{
+				ResourceType: ResourceTypeVirtualMachine,
+				ResourceName: name,
+				Reason:       ReasonMisconfigured,
+				Message:      fmt.Sprintf("virtual machine size is %q, expected %q", size, expectedSize),
+			})
+		}
+	}
+
+	return drift, nil
+}
+
+// checkDenyAssignment checks that a deny assignment exists at the scope of
+// the managed resource group, unless deny assignments are disabled
+func (d *DriftMonitor) checkDenyAssignment(ctx context.Context) ([]Drift, error) {
+	if !d.denyAssignmentsExpected {
+		return nil, nil
+	}
+
+	denyAssignments, err := d.denyAssignments.ListForResourceGroup(ctx, d.resourceGroup, "atScope()")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, da := range denyAssignments {
+		if da.DenyAssignmentProperties != nil && da.Scope != nil &&
+			strings.EqualFold(*da.Scope, d.oc.Properties.ClusterProfile.ResourceGroupID) {
+			return nil, nil
+		}
+	}
+
+	return []Drift{
+		{
+			ResourceType: ResourceTypeDenyAssignment,
+			ResourceName: d.resourceGroup,
+			Reason:       ReasonMissing,
+			Message:      "no deny assignment found at the scope of the managed resource group",
+		},
+	}, nil
+}
@@ -0,0 +1,265 @@
+package drift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/monitor/dimension"
+	"github.com/Azure/ARO-RP/pkg/monitor/emitter"
+	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/azuresdk/armcompute"
+	sdknetwork "github.com/Azure/ARO-RP/pkg/util/azureclient/azuresdk/armnetwork"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/azuresdk/armstorage"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/authorization"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/mgmt/privatedns"
+	"github.com/Azure/ARO-RP/pkg/util/dns"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+const (
+	MetricDrift                      = "monitor.drift.resource"
+	MetricDriftCount                 = "monitor.drift.count"
+	MetricFailedDriftMonitorCreation = "monitor.drift.failedmonitorcreation"
+)
+
+// Types of resource in the managed resource group which are checked for drift
+const (
+	ResourceTypeDenyAssignment = "DenyAssignment"
+	ResourceTypeLoadBalancer   = "LoadBalancer"
+	ResourceTypePrivateDNSZone = "PrivateDNSZone"
+	ResourceTypeStorageAccount = "StorageAccount"
+	ResourceTypeVirtualMachine = "VirtualMachine"
+)
+
+// Reasons a resource has drifted from what the RP expects
+const (
+	// ReasonMissing means an expected resource does not exist
+	ReasonMissing = "Missing"
+	// ReasonUnexpected means a resource exists which the RP does not expect
+	ReasonUnexpected = "Unexpected"
+	// ReasonMisconfigured means a resource exists but is not configured the
+	// way the RP configured it
+	ReasonMisconfigured = "Misconfigured"
+)
+
+// Drift is a difference between a resource in the cluster's managed resource
+// group and what the RP expects from the cluster document
+type Drift struct {
+	ResourceType string `json:"resourceType"`
+	ResourceName string `json:"resourceName"`
+	Reason       string `json:"reason"`
+	Message      string `json:"message"`
+}
+
+var (
+	_ monitoring.Monitor       = (*DriftMonitor)(nil)
+	_ monitoring.DriftReporter = (*DriftMonitor)(nil)
+)
+
+// DriftMonitor compares the resources in a cluster's managed resource group
+// with what the RP expects from the cluster document
+type DriftMonitor struct {
+	log     *logrus.Entry
+	emitter metrics.Emitter
+	oc      *api.OpenShiftCluster
+
+	resourceGroup           string
+	privateZoneName         string
+	denyAssignmentsExpected bool
+
+	loadBalancers   sdknetwork.LoadBalancersClient
+	storageAccounts armstorage.AccountsClient
+	virtualMachines armcompute.VirtualMachinesClient
+	privateZones    privatedns.PrivateZonesClient
+	denyAssignments authorization.DenyAssignmentClient
+
+	dims      map[string]string
+	checkedAt time.Time
+	report    []Drift
+	err       error
+}
+
+func NewMonitor(log *logrus.Entry, oc *api.OpenShiftCluster, e env.Interface, subscriptionID string, tenantID string, emitter metrics.Emitter, dims map[string]string, trigger <-chan time.Time) monitoring.Monitor {
+	if oc == nil {
+		return &monitoring.NoOpMonitor{}
+	}
+
+	// clusters which are being created, updated or deleted are expected to
+	// differ from their document
+	if oc.Properties.ProvisioningState != api.ProvisioningStateSucceeded || oc.Properties.ClusterProfile.ResourceGroupID == "" {
+		return &monitoring.NoOpMonitor{}
+	}
+
+	select {
+	case <-trigger:
+	default:
+		return &monitoring.NoOpMonitor{}
+	}
+
+	token, err := e.FPNewClientCertificateCredential(tenantID, nil)
+	if err != nil {
+		log.Error("Unable to create FP credential for drift monitoring.", err)
+		emitter.EmitGauge(MetricFailedDriftMonitorCreation, int64(1), dims)
+		return &monitoring.NoOpMonitor{}
+	}
+
+	authorizer, err := e.FPAuthorizer(tenantID, nil, e.Environment().ResourceManagerScope)
+	if err != nil {
+		log.Error("Unable to create FP Authorizer for drift monitoring.", err)
+		emitter.EmitGauge(MetricFailedDriftMonitorCreation, int64(1), dims)
+		return &monitoring.NoOpMonitor{}
+	}
+
+	clientOptions := arm.ClientOptions{
+		ClientOptions: azcore.ClientOptions{
+			Cloud: e.Environment().Cloud,
+		},
+	}
+
+	loadBalancers, err := sdknetwork.NewLoadBalancersClient(subscriptionID, token, &clientOptions)
+	if err != nil {
+		log.Error("Unable to create the load balancers client for drift monitoring", err)
+		emitter.EmitGauge(MetricFailedDriftMonitorCreation, int64(1), dims)
+		return &monitoring.NoOpMonitor{}
+	}
+
+	storageAccounts, err := armstorage.NewAccountsClient(subscriptionID, token, &clientOptions)
+	if err != nil {
+		log.Error("Unable to create the storage accounts client for drift monitoring", err)
+		emitter.EmitGauge(MetricFailedDriftMonitorCreation, int64(1), dims)
+		return &monitoring.NoOpMonitor{}
+	}
+
+	virtualMachines, err := armcompute.NewVirtualMachinesClient(subscriptionID, token, &clientOptions)
+	if err != nil {
+		log.Error("Unable to create the virtual machines client for drift monitoring", err)
+		emitter.EmitGauge(MetricFailedDriftMonitorCreation, int64(1), dims)
+		return &monitoring.NoOpMonitor{}
+	}
+
+	// the RP used to create a private DNS zone named after the cluster's fully
+	// qualified domain
+	privateZoneName, err := dns.ManagedDomain(e, oc.Properties.ClusterProfile.Domain)
+	if err != nil {
+		log.Error("Unable to determine the cluster domain for drift monitoring", err)
+		emitter.EmitGauge(MetricFailedDriftMonitorCreation, int64(1), dims)
+		return &monitoring.NoOpMonitor{}
+	}
+	if privateZoneName == "" {
+		privateZoneName = oc.Properties.ClusterProfile.Domain
+	}
+
+	return &DriftMonitor{
+		log:     log,
+		emitter: emitter,
+		oc:      oc,
+
+		resourceGroup:           stringutils.LastTokenByte(oc.Properties.ClusterProfile.ResourceGroupID, '/'),
+		privateZoneName:         privateZoneName,
+		denyAssignmentsExpected: !e.FeatureIsSet(env.FeatureDisableDenyAssignments),
+
+		loadBalancers:   loadBalancers,
+		storageAccounts: storageAccounts,
+		virtualMachines: virtualMachines,
+		privateZones:    privatedns.NewPrivateZonesClient(e.Environment(), subscriptionID, authorizer),
+		denyAssignments: authorization.NewDenyAssignmentsClient(e.Environment(), subscriptionID, authorizer),
+
+		dims: dims,
+	}
+}
+
+// Monitor checks the resources in the cluster's managed resource group, emits
+// a metric for each drifted resource and logs the drift report, which is kept
+// for DriftReport
+func (d *DriftMonitor) Monitor(ctx context.Context) (_err error) {
+	// guard for any monitor-level panics
+	defer func() {
+		if e := recover(); e != nil {
+			_err = &monitoring.MonitorPanic{PanicValue: e}
+		}
+		d.err = _err
+	}()
+
+	now := time.Now()
+	errs := []error{}
+	d.checkedAt = now
+	d.report = nil
+
+	for _, check := range []func(context.Context) ([]Drift, error){
+		d.checkLoadBalancers,
+		d.checkPrivateDNSZones,
+		d.checkStorageAccounts,
+		d.checkMasterVirtualMachines,
+		d.checkDenyAssignment,
+	} {
+		drift, err := check(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		d.report = append(d.report, drift...)
+	}
+
+	for _, drift := range d.report {
+		emitter.EmitGauge(d.emitter, MetricDrift, int64(1), d.dims, map[string]string{
+			dimension.ResourceType: drift.ResourceType,
+			dimension.ResourceName: drift.ResourceName,
+			dimension.DriftReason:  drift.Reason,
+		})
+
+		d.log.WithFields(logrus.Fields{
+			"resourceGroup": d.resourceGroup,
+			"resourceType":  drift.ResourceType,
+			"resourceName":  drift.ResourceName,
+			"reason":        drift.Reason,
+		}).Warnf("managed resource group drift: %s", drift.Message)
+	}
+
+	d.emitter.EmitGauge(MetricDriftCount, int64(len(d.report)), d.dims)
+
+	// emit a metric with how long we took
+	d.emitter.EmitFloat("monitor.drift.duration", time.Since(now).Seconds(), d.dims)
+
+	return errors.Join(errs...)
+}
+
+// DriftReport returns the drift found by the last call to Monitor, or nil if
+// it has not been called
+func (d *DriftMonitor) DriftReport() *api.ClusterHealthDriftReport {
+	if d.checkedAt.IsZero() {
+		return nil
+	}
+
+	r := &api.ClusterHealthDriftReport{
+		CheckedAt: d.checkedAt.Unix(),
+	}
+	if d.err != nil {
+		r.Error = d.err.Error()
+	}
+
+	for _, drift := range d.report {
+		r.Resources = append(r.Resources, api.ClusterHealthDriftedResource{
+			ResourceType: drift.ResourceType,
+			ResourceName: drift.ResourceName,
+			Reason:       drift.Reason,
+			Message:      drift.Message,
+		})
+	}
+
+	return r
+}
+
+func (d *DriftMonitor) MonitorName() string {
+	return "drift"
+}
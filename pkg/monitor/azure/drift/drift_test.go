@@ -0,0 +1,447 @@
+package drift
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	sdkcompute "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v7"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v6"
+	azstorage "github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	mgmtauthorization "github.com/Azure/azure-sdk-for-go/services/preview/authorization/mgmt/2018-09-01-preview/authorization"
+	mgmtprivatedns "github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/Azure/go-autorest/autorest"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/env"
+	"github.com/Azure/ARO-RP/pkg/monitor/dimension"
+	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	mock_armcompute "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/azuresdk/armcompute"
+	mock_armnetwork "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/azuresdk/armnetwork"
+	mock_armstorage "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/azuresdk/armstorage"
+	mock_authorization "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/authorization"
+	mock_privatedns "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/mgmt/privatedns"
+	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/pointerutils"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+var (
+	tenantID       = "1111-1111-1111-1111"
+	subscriptionID = "0000-0000-0000-0000"
+	clusterRG      = "aro-cluster"
+	clusterRGID    = fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionID, clusterRG)
+	ocID           = fmt.Sprintf("/subscriptions/%s/resourceGroups/myRG/providers/Microsoft.RedHatOpenShift/OpenShiftClusters/testing", subscriptionID)
+	ocLocation     = "eastus"
+	infraID        = "testing-abcde"
+
+	dims = map[string]string{
+		dimension.ResourceID:     ocID,
+		dimension.SubscriptionID: subscriptionID,
+		dimension.Location:       ocLocation,
+	}
+
+	notFoundErr = &azcore.ResponseError{StatusCode: http.StatusNotFound}
+)
+
+func ocFactory() api.OpenShiftCluster {
+	return api.OpenShiftCluster{
+		ID:       ocID,
+		Location: ocLocation,
+		Properties: api.OpenShiftClusterProperties{
+			ProvisioningState:               api.ProvisioningStateSucceeded,
+			ArchitectureVersion:             api.ArchitectureVersionV2,
+			InfraID:                         infraID,
+			StorageSuffix:                   "abcde",
+			ImageRegistryStorageAccountName: "imageregistryabcde",
+			ClusterProfile: api.ClusterProfile{
+				Domain:          "testing",
+				ResourceGroupID: clusterRGID,
+			},
+			NetworkProfile: api.NetworkProfile{
+				OutboundType: api.OutboundTypeLoadbalancer,
+			},
+			MasterProfile: api.MasterProfile{
+				VMSize: api.VMSizeStandardD8sV3,
+			},
+		},
+	}
+}
+
+func loadBalancer(sku armnetwork.LoadBalancerSKUName) armnetwork.LoadBalancersClientGetResponse {
+	return armnetwork.LoadBalancersClientGetResponse{
+		LoadBalancer: armnetwork.LoadBalancer{
+			SKU: &armnetwork.LoadBalancerSKU{
+				Name: pointerutils.ToPtr(sku),
+			},
+		},
+	}
+}
+
+func storageAccount(allowBlobPublicAccess, httpsOnly bool, tlsVersion azstorage.MinimumTLSVersion) azstorage.AccountsClientGetPropertiesResponse {
+	return azstorage.AccountsClientGetPropertiesResponse{
+		Account: azstorage.Account{
+			Properties: &azstorage.AccountProperties{
+				AllowBlobPublicAccess:  pointerutils.ToPtr(allowBlobPublicAccess),
+				EnableHTTPSTrafficOnly: pointerutils.ToPtr(httpsOnly),
+				MinimumTLSVersion:      pointerutils.ToPtr(tlsVersion),
+			},
+		},
+	}
+}
+
+func virtualMachine(size sdkcompute.VirtualMachineSizeTypes) sdkcompute.VirtualMachine {
+	return sdkcompute.VirtualMachine{
+		Properties: &sdkcompute.VirtualMachineProperties{
+			HardwareProfile: &sdkcompute.HardwareProfile{
+				VMSize: pointerutils.ToPtr(size),
+			},
+		},
+	}
+}
+
+type mocks struct {
+	loadBalancers   *mock_armnetwork.MockLoadBalancersClient
+	storageAccounts *mock_armstorage.MockAccountsClient
+	virtualMachines *mock_armcompute.MockVirtualMachinesClient
+	privateZones    *mock_privatedns.MockPrivateZonesClient
+	denyAssignments *mock_authorization.MockDenyAssignmentClient
+}
+
+// expectNoDrift sets up the clients to return the resources the RP expects,
+// except for those which have already been given an expectation
+func (m *mocks) expectNoDrift(ctx context.Context) {
+	m.loadBalancers.EXPECT().Get(ctx, clusterRG, gomock.Any(), nil).Return(loadBalancer(armnetwork.LoadBalancerSKUNameStandard), nil).AnyTimes()
+	m.privateZones.EXPECT().ListByResourceGroup(ctx, clusterRG, nil).Return(nil, nil).AnyTimes()
+	m.storageAccounts.EXPECT().GetProperties(ctx, clusterRG, gomock.Any(), nil).Return(storageAccount(false, true, azstorage.MinimumTLSVersionTLS12), nil).AnyTimes()
+	m.virtualMachines.EXPECT().GetDefault(ctx, clusterRG, gomock.Any()).Return(virtualMachine(sdkcompute.VirtualMachineSizeTypesStandardD8SV3), nil).AnyTimes()
+	m.denyAssignments.EXPECT().ListForResourceGroup(ctx, clusterRG, "atScope()").Return([]mgmtauthorization.DenyAssignment{
+		{
+			DenyAssignmentProperties: &mgmtauthorization.DenyAssignmentProperties{
+				Scope: pointerutils.ToPtr(clusterRGID),
+			},
+		},
+	}, nil).AnyTimes()
+}
+
+func TestMonitor(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tt := range []struct {
+		name                  string
+		modOC                 func(*api.OpenShiftCluster)
+		disableDenyAssignment bool
+		mock                  func(*mocks)
+		wantReport            []Drift
+		wantErr               string
+	}{
+		{
+			name: "no drift",
+		},
+		{
+			name: "no drift with user defined routing on an architecture version 1 cluster",
+			modOC: func(oc *api.OpenShiftCluster) {
+				oc.Properties.ArchitectureVersion = api.ArchitectureVersionV1
+				oc.Properties.NetworkProfile.OutboundType = api.OutboundTypeUserDefinedRouting
+			},
+			mock: func(m *mocks) {
+				m.loadBalancers.EXPECT().Get(ctx, clusterRG, infraID+"-internal-lb", nil).Return(loadBalancer(armnetwork.LoadBalancerSKUNameStandard), nil)
+			},
+		},
+		{
+			name: "missing and misconfigured load balancers",
+			mock: func(m *mocks) {
+				m.loadBalancers.EXPECT().Get(ctx, clusterRG, infraID+"-internal", nil).Return(armnetwork.LoadBalancersClientGetResponse{}, notFoundErr)
+				m.loadBalancers.EXPECT().Get(ctx, clusterRG, infraID, nil).Return(loadBalancer(armnetwork.LoadBalancerSKUNameBasic), nil)
+			},
+			wantReport: []Drift{
+				{
+					ResourceType: ResourceTypeLoadBalancer,
+					ResourceName: infraID + "-internal",
+					Reason:       ReasonMissing,
+					Message:      "load balancer not found",
+				},
+				{
+					ResourceType: ResourceTypeLoadBalancer,
+					ResourceName: infraID,
+					Reason:       ReasonMisconfigured,
+					Message:      "load balancer SKU is not Standard",
+				},
+			},
+		},
+		{
+			name: "unexpected private DNS zone",
+			mock: func(m *mocks) {
+				m.privateZones.EXPECT().ListByResourceGroup(ctx, clusterRG, nil).Return([]mgmtprivatedns.PrivateZone{
+					{
+						Name: pointerutils.ToPtr("testing.eastus.aroapp.io"),
+					},
+					{
+						Name: pointerutils.ToPtr("privatelink.blob.core.windows.net"),
+					},
+				}, nil)
+			},
+			wantReport: []Drift{
+				{
+					ResourceType: ResourceTypePrivateDNSZone,
+					ResourceName: "testing.eastus.aroapp.io",
+					Reason:       ReasonUnexpected,
+					Message:      "private DNS zone is not expected",
+				},
+			},
+		},
+		{
+			name: "missing and misconfigured storage accounts",
+			mock: func(m *mocks) {
+				m.storageAccounts.EXPECT().GetProperties(ctx, clusterRG, "clusterabcde", nil).Return(storageAccount(true, false, azstorage.MinimumTLSVersionTLS10), nil)
+				m.storageAccounts.EXPECT().GetProperties(ctx, clusterRG, "imageregistryabcde", nil).Return(azstorage.AccountsClientGetPropertiesResponse{}, notFoundErr)
+			},
+			wantReport: []Drift{
+				{
+					ResourceType: ResourceTypeStorageAccount,
+					ResourceName: "clusterabcde",
+					Reason:       ReasonMisconfigured,
+					Message:      "blob public access is allowed; HTTP traffic is allowed; minimum TLS version is not TLS1_2",
+				},
+				{
+					ResourceType: ResourceTypeStorageAccount,
+					ResourceName: "imageregistryabcde",
+					Reason:       ReasonMissing,
+					Message:      "storage account not found",
+				},
+			},
+		},
+		{
+			name: "missing and resized master virtual machines",
+			mock: func(m *mocks) {
+				m.virtualMachines.EXPECT().GetDefault(ctx, clusterRG, infraID+"-master-0").Return(virtualMachine(sdkcompute.VirtualMachineSizeTypesStandardD4SV3), nil)
+				m.virtualMachines.EXPECT().GetDefault(ctx, clusterRG, infraID+"-master-2").Return(sdkcompute.VirtualMachine{}, notFoundErr)
+			},
+			wantReport: []Drift{
+				{
+					ResourceType: ResourceTypeVirtualMachine,
+					ResourceName: infraID + "-master-0",
+					Reason:       ReasonMisconfigured,
+					Message:      `virtual machine size is "Standard_D4s_v3", expected "Standard_D8s_v3"`,
+				},
+				{
+					ResourceType: ResourceTypeVirtualMachine,
+					ResourceName: infraID + "-master-2",
+					Reason:       ReasonMissing,
+					Message:      "master virtual machine not found",
+				},
+			},
+		},
+		{
+			name: "missing deny assignment",
+			mock: func(m *mocks) {
+				m.denyAssignments.EXPECT().ListForResourceGroup(ctx, clusterRG, "atScope()").Return([]mgmtauthorization.DenyAssignment{
+					{
+						DenyAssignmentProperties: &mgmtauthorization.DenyAssignmentProperties{
+							Scope: pointerutils.ToPtr("/subscriptions/" + subscriptionID),
+						},
+					},
+				}, nil)
+			},
+			wantReport: []Drift{
+				{
+					ResourceType: ResourceTypeDenyAssignment,
+					ResourceName: clusterRG,
+					Reason:       ReasonMissing,
+					Message:      "no deny assignment found at the scope of the managed resource group",
+				},
+			},
+		},
+		{
+			name:                  "deny assignments disabled",
+			disableDenyAssignment: true,
+			mock: func(m *mocks) {
+				m.denyAssignments.EXPECT().ListForResourceGroup(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+		},
+		{
+			name: "errors do not stop other checks",
+			mock: func(m *mocks) {
+				m.privateZones.EXPECT().ListByResourceGroup(ctx, clusterRG, nil).Return(nil, autorest.DetailedError{StatusCode: http.StatusForbidden, Message: "forbidden"})
+				m.virtualMachines.EXPECT().GetDefault(ctx, clusterRG, infraID+"-master-0").Return(sdkcompute.VirtualMachine{}, errors.New("throttled"))
+				m.loadBalancers.EXPECT().Get(ctx, clusterRG, infraID, nil).Return(armnetwork.LoadBalancersClientGetResponse{}, notFoundErr)
+			},
+			wantReport: []Drift{
+				{
+					ResourceType: ResourceTypeLoadBalancer,
+					ResourceName: infraID,
+					Reason:       ReasonMissing,
+					Message:      "load balancer not found",
+				},
+			},
+			wantErr: "#: forbidden: StatusCode=403\nthrottled",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			m := &mocks{
+				loadBalancers:   mock_armnetwork.NewMockLoadBalancersClient(ctrl),
+				storageAccounts: mock_armstorage.NewMockAccountsClient(ctrl),
+				virtualMachines: mock_armcompute.NewMockVirtualMachinesClient(ctrl),
+				privateZones:    mock_privatedns.NewMockPrivateZonesClient(ctrl),
+				denyAssignments: mock_authorization.NewMockDenyAssignmentClient(ctrl),
+			}
+			if tt.mock != nil {
+				tt.mock(m)
+			}
+			m.expectNoDrift(ctx)
+
+			emitter := mock_metrics.NewMockEmitter(ctrl)
+			for _, drift := range tt.wantReport {
+				emitter.EXPECT().EmitGauge(MetricDrift, int64(1), map[string]string{
+					dimension.ResourceID:     ocID,
+					dimension.SubscriptionID: subscriptionID,
+					dimension.Location:       ocLocation,
+					dimension.ResourceType:   drift.ResourceType,
+					dimension.ResourceName:   drift.ResourceName,
+					dimension.DriftReason:    drift.Reason,
+				})
+			}
+			emitter.EXPECT().EmitGauge(MetricDriftCount, int64(len(tt.wantReport)), dims)
+			emitter.EXPECT().EmitFloat("monitor.drift.duration", gomock.Any(), dims)
+
+			oc := ocFactory()
+			if tt.modOC != nil {
+				tt.modOC(&oc)
+			}
+
+			d := &DriftMonitor{
+				log:     logrus.NewEntry(logrus.New()),
+				emitter: emitter,
+				oc:      &oc,
+
+				resourceGroup:           clusterRG,
+				privateZoneName:         "testing.eastus.aroapp.io",
+				denyAssignmentsExpected: !tt.disableDenyAssignment,
+
+				loadBalancers:   m.loadBalancers,
+				storageAccounts: m.storageAccounts,
+				virtualMachines: m.virtualMachines,
+				privateZones:    m.privateZones,
+				denyAssignments: m.denyAssignments,
+
+				dims: dims,
+			}
+
+			err := d.Monitor(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			for _, diff := range deep.Equal(d.report, tt.wantReport) {
+				t.Error(diff)
+			}
+
+			report := d.DriftReport()
+			if report == nil || report.CheckedAt == 0 {
+				t.Fatalf("got drift report %v", report)
+			}
+			if report.Error != tt.wantErr {
+				t.Errorf("got drift report error %q, wanted %q", report.Error, tt.wantErr)
+			}
+			if len(report.Resources) != len(tt.wantReport) {
+				t.Errorf("got %d drifted resources in the drift report, wanted %d", len(report.Resources), len(tt.wantReport))
+			}
+		})
+	}
+}
+
+func isOfType[T any](mon monitoring.Monitor) bool {
+	_, ok := mon.(T)
+	return ok
+}
+
+func TestNewMonitor(t *testing.T) {
+	log := logrus.NewEntry(logrus.New())
+
+	for _, tt := range []struct {
+		name          string
+		modOC         func(*api.OpenShiftCluster)
+		mockInterface func(*mock_env.MockInterface)
+		mockEmitter   func(*mock_metrics.MockEmitter)
+		tick          bool
+		valid         func(monitoring.Monitor) bool
+	}{
+		{
+			name:  "not ticked: returning NoOpMonitor",
+			valid: isOfType[*monitoring.NoOpMonitor],
+		},
+		{
+			name: "cluster not in a succeeded state: returning NoOpMonitor",
+			modOC: func(oc *api.OpenShiftCluster) {
+				oc.Properties.ProvisioningState = api.ProvisioningStateUpdating
+			},
+			tick:  true,
+			valid: isOfType[*monitoring.NoOpMonitor],
+		},
+		{
+			name: "ticked with an error while creating FP credential: returning NoOpMonitor",
+			mockEmitter: func(emitter *mock_metrics.MockEmitter) {
+				emitter.EXPECT().EmitGauge(MetricFailedDriftMonitorCreation, int64(1), dims)
+			},
+			mockInterface: func(mi *mock_env.MockInterface) {
+				mi.EXPECT().FPNewClientCertificateCredential(gomock.Any(), gomock.Any()).Return(nil, errors.New("Unknown Error"))
+			},
+			tick:  true,
+			valid: isOfType[*monitoring.NoOpMonitor],
+		},
+		{
+			name: "ticked: returning DriftMonitor",
+			mockInterface: func(mi *mock_env.MockInterface) {
+				mi.EXPECT().FPNewClientCertificateCredential(gomock.Any(), gomock.Any()).Return(&azidentity.ClientCertificateCredential{}, nil)
+				mi.EXPECT().FPAuthorizer(tenantID, nil, gomock.Any()).Return(&autorest.NullAuthorizer{}, nil)
+				mi.EXPECT().Environment().Return(&azureclient.AROEnvironment{}).AnyTimes()
+				mi.EXPECT().Domain().Return("eastus.aroapp.io").AnyTimes()
+				mi.EXPECT().FeatureIsSet(env.FeatureDisableDenyAssignments).Return(false)
+			},
+			tick:  true,
+			valid: isOfType[*DriftMonitor],
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			e := mock_env.NewMockInterface(ctrl)
+			emitter := mock_metrics.NewMockEmitter(ctrl)
+
+			oc := ocFactory()
+			if tt.modOC != nil {
+				tt.modOC(&oc)
+			}
+			if tt.mockInterface != nil {
+				tt.mockInterface(e)
+			}
+			if tt.mockEmitter != nil {
+				tt.mockEmitter(emitter)
+			}
+			ticking := make(chan time.Time, 1) // buffered
+			if tt.tick {
+				ticking <- time.Now()
+			}
+
+			mon := NewMonitor(log, &oc, e, subscriptionID, tenantID, emitter, dims, ticking)
+			if !tt.valid(mon) {
+				t.Error("Invalid monitoring object returned")
+			}
+		})
+	}
+}
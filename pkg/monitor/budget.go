@@ -0,0 +1,43 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+)
+
+// acquireRun waits for a slot in the monitor's budget of concurrent
+// monitoring passes, returning false if stop is closed first. Every
+// successful call must be followed by a call to releaseRun.
+func (mon *monitor) acquireRun(stop <-chan struct{}) bool {
+	if mon.runBudget == nil {
+		return true
+	}
+
+	select {
+	case mon.runBudget <- struct{}{}:
+		return true
+	default:
+	}
+
+	start := time.Now()
+	defer func() {
+		mon.m.EmitFloat("monitor.budget.wait", time.Since(start).Seconds(), nil)
+	}()
+
+	select {
+	case mon.runBudget <- struct{}{}:
+		return true
+	case <-stop:
+		return false
+	}
+}
+
+func (mon *monitor) releaseRun() {
+	if mon.runBudget == nil {
+		return
+	}
+
+	<-mon.runBudget
+}
@@ -0,0 +1,70 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRunBudget(t *testing.T) {
+	env := SetupTestEnvironment(t)
+	defer env.Cleanup()
+
+	mon := env.CreateTestMonitor("budget")
+	mon.runBudget = make(chan struct{}, 1)
+
+	stop := make(chan struct{})
+
+	if !mon.acquireRun(stop) {
+		t.Fatal("failed to acquire the free slot")
+	}
+
+	// a second pass waits for the slot to be released
+	acquired := make(chan bool)
+	go func() {
+		acquired <- mon.acquireRun(stop)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("acquired a slot beyond the budget")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	mon.releaseRun()
+	if !<-acquired {
+		t.Fatal("failed to acquire the released slot")
+	}
+
+	// waiting passes give up when the worker stops
+	go func() {
+		acquired <- mon.acquireRun(stop)
+	}()
+	close(stop)
+	if <-acquired {
+		t.Error("acquired a slot beyond the budget after stopping")
+	}
+
+	mon.releaseRun()
+	if len(mon.runBudget) != 0 {
+		t.Errorf("got %d slots in use, wanted 0", len(mon.runBudget))
+	}
+}
+
+func TestRunBudgetUnlimited(t *testing.T) {
+	env := SetupTestEnvironment(t)
+	defer env.Cleanup()
+
+	mon := env.CreateTestMonitor("budget-unlimited")
+
+	for range 10 {
+		if !mon.acquireRun(nil) {
+			t.Fatal("failed to acquire a slot")
+		}
+	}
+	for range 10 {
+		mon.releaseRun()
+	}
+}
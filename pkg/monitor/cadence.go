@@ -0,0 +1,82 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// Clusters which need attention are monitored every
+// mon.interval / fastCadenceDivisor, and clusters which have been healthy for
+// stableCadencePasses passes in a row every
+// mon.interval * stableCadenceMultiplier
+const (
+	fastCadenceDivisor      = 2
+	stableCadenceMultiplier = 2
+	stableCadencePasses     = 10
+)
+
+// Cadences, used in the worker's logs and the monitor.cadence metric
+const (
+	cadenceFast   = "fast"
+	cadenceNormal = "normal"
+	cadenceStable = "stable"
+)
+
+// cadence tracks how long a cluster has been healthy. The cluster's
+// monitoring worker keeps it across passes.
+type cadence struct {
+	healthyPasses int
+}
+
+// next returns the cadence at which to monitor the cluster in doc, given the
+// health snapshot of the pass just finished, or nil if the pass did not
+// finish, and how long to wait between the starts of its passes. Passes which
+// did not finish, e.g. because they timed out or the monitor is overloaded,
+// keep the normal cadence rather than adding load.
+func (c *cadence) next(doc *api.OpenShiftClusterDocument, snapshot *api.ClusterHealthSnapshot, interval time.Duration) (string, time.Duration) {
+	if snapshot == nil {
+		c.healthyPasses = 0
+		return cadenceNormal, interval
+	}
+
+	if needsAttention(doc, snapshot) {
+		c.healthyPasses = 0
+		return cadenceFast, interval / fastCadenceDivisor
+	}
+
+	c.healthyPasses++
+	if c.healthyPasses >= stableCadencePasses {
+		return cadenceStable, interval * stableCadenceMultiplier
+	}
+
+	return cadenceNormal, interval
+}
+
+// needsAttention returns whether the cluster is not fully provisioned, is in
+// maintenance, or was not found healthy by the monitoring pass which produced
+// snapshot
+func needsAttention(doc *api.OpenShiftClusterDocument, snapshot *api.ClusterHealthSnapshot) bool {
+	if doc.OpenShiftCluster.Properties.ProvisioningState != api.ProvisioningStateSucceeded {
+		return true
+	}
+
+	switch doc.OpenShiftCluster.Properties.MaintenanceState {
+	case "", api.MaintenanceStateNone:
+	default:
+		return true
+	}
+
+	// collectors only record conditions which are not as expected, e.g.
+	// degraded cluster operators
+	for _, r := range snapshot.Collectors {
+		if r.Error != "" || len(r.Conditions) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
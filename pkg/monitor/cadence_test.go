@@ -0,0 +1,139 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+func TestCadence(t *testing.T) {
+	const interval = time.Minute
+
+	healthy := &api.ClusterHealthSnapshot{
+		Collectors: []api.ClusterHealthCollectorResult{
+			{Name: "emitAPIServerHealthzCode"},
+		},
+	}
+
+	for _, tt := range []struct {
+		name              string
+		provisioning      api.ProvisioningState
+		maintenance       api.MaintenanceState
+		snapshot          *api.ClusterHealthSnapshot
+		healthyPasses     int
+		wantCadence       string
+		wantInterval      time.Duration
+		wantHealthyPasses int
+	}{
+		{
+			name:              "healthy",
+			snapshot:          healthy,
+			wantCadence:       cadenceNormal,
+			wantInterval:      interval,
+			wantHealthyPasses: 1,
+		},
+		{
+			name:              "healthy and stable",
+			snapshot:          healthy,
+			healthyPasses:     stableCadencePasses - 1,
+			wantCadence:       cadenceStable,
+			wantInterval:      2 * interval,
+			wantHealthyPasses: stableCadencePasses,
+		},
+		{
+			name:          "failed provisioning",
+			provisioning:  api.ProvisioningStateFailed,
+			snapshot:      healthy,
+			healthyPasses: stableCadencePasses,
+			wantCadence:   cadenceFast,
+			wantInterval:  interval / 2,
+		},
+		{
+			name:          "in maintenance",
+			maintenance:   api.MaintenanceStateUnplanned,
+			snapshot:      healthy,
+			healthyPasses: stableCadencePasses,
+			wantCadence:   cadenceFast,
+			wantInterval:  interval / 2,
+		},
+		{
+			name:              "maintenance state none",
+			maintenance:       api.MaintenanceStateNone,
+			snapshot:          healthy,
+			wantCadence:       cadenceNormal,
+			wantInterval:      interval,
+			wantHealthyPasses: 1,
+		},
+		{
+			name: "degraded cluster operator",
+			snapshot: &api.ClusterHealthSnapshot{
+				Collectors: []api.ClusterHealthCollectorResult{
+					{
+						Name: "emitClusterOperatorConditions",
+						Conditions: []api.ClusterHealthCondition{
+							{Name: "ingress", Type: "Degraded", Status: "True"},
+						},
+					},
+				},
+			},
+			healthyPasses: stableCadencePasses,
+			wantCadence:   cadenceFast,
+			wantInterval:  interval / 2,
+		},
+		{
+			name: "failing collector",
+			snapshot: &api.ClusterHealthSnapshot{
+				Collectors: []api.ClusterHealthCollectorResult{
+					{Name: "emitAPIServerHealthzCode", Error: "error fetching APIServer healthz endpoint"},
+				},
+			},
+			wantCadence:  cadenceFast,
+			wantInterval: interval / 2,
+		},
+		{
+			name:          "pass did not finish",
+			healthyPasses: stableCadencePasses,
+			wantCadence:   cadenceNormal,
+			wantInterval:  interval,
+		},
+		{
+			name:          "pass did not finish in maintenance",
+			maintenance:   api.MaintenanceStateUnplanned,
+			healthyPasses: stableCadencePasses,
+			wantCadence:   cadenceNormal,
+			wantInterval:  interval,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			provisioning := tt.provisioning
+			if provisioning == "" {
+				provisioning = api.ProvisioningStateSucceeded
+			}
+
+			doc := &api.OpenShiftClusterDocument{
+				OpenShiftCluster: &api.OpenShiftCluster{
+					Properties: api.OpenShiftClusterProperties{
+						ProvisioningState: provisioning,
+						MaintenanceState:  tt.maintenance,
+					},
+				},
+			}
+			c := &cadence{healthyPasses: tt.healthyPasses}
+
+			gotCadence, gotInterval := c.next(doc, tt.snapshot, interval)
+			if gotCadence != tt.wantCadence {
+				t.Errorf("got cadence %q, wanted %q", gotCadence, tt.wantCadence)
+			}
+			if gotInterval != tt.wantInterval {
+				t.Errorf("got interval %s, wanted %s", gotInterval, tt.wantInterval)
+			}
+			if c.healthyPasses != tt.wantHealthyPasses {
+				t.Errorf("got %d healthy passes, wanted %d", c.healthyPasses, tt.wantHealthyPasses)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 
+	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/operator"
 	utilcert "github.com/Azure/ARO-RP/pkg/util/cert"
 	"github.com/Azure/ARO-RP/pkg/util/dns"
@@ -135,12 +136,24 @@ func (mon *Monitor) processCertificate(ctx context.Context, secretNamespace, sec
 	}
 
 	// emit the cert expiration metric if the cert is valid
-	mon.emitGauge(certificateExpirationMetricName, int64(utilcert.DaysUntilExpiration(cert)), map[string]string{
+	daysUntilExpiration := utilcert.DaysUntilExpiration(cert)
+	mon.emitGauge(certificateExpirationMetricName, int64(daysUntilExpiration), map[string]string{
 		"namespace":  secretNamespace,
 		"name":       secretName,
 		"subject":    cert.Subject.CommonName,
 		"thumbprint": utilcert.Thumbprint(cert),
 	})
+
+	if daysUntilExpiration <= healthSnapshotCertificateExpiryDays {
+		recordHealth(ctx, func(r *api.ClusterHealthCollectorResult) {
+			r.Certificates = append(r.Certificates, api.ClusterHealthCertificate{
+				Namespace:           secretNamespace,
+				Name:                secretName,
+				Subject:             cert.Subject.CommonName,
+				DaysUntilExpiration: daysUntilExpiration,
+			})
+		})
+	}
 	return nil
 }
 
@@ -0,0 +1,244 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	utilcert "github.com/Azure/ARO-RP/pkg/util/cert"
+	"github.com/Azure/ARO-RP/pkg/util/pem"
+)
+
+const (
+	certificateInventoryMetricName            = "certificate.inventory.daysuntilexpiration"
+	certificateInventoryEarliestMetricName    = "certificate.inventory.earliestexpiration"
+	certificateInventoryParseErrorsMetricName = "certificate.inventory.parseerrors"
+
+	caBundleKey = "ca-bundle.crt"
+)
+
+// Classes of certificate in the certificate inventory
+const (
+	certificateClassAPIServer = "apiserver"
+	certificateClassCABundle  = "cabundle"
+	certificateClassEtcd      = "etcd"
+	certificateClassIngress   = "ingress"
+	certificateClassOther     = "other"
+	certificateClassSigner    = "signer"
+)
+
+// caBundleConfigMaps are the kube-apiserver and kubelet signer CA bundles in
+// the certificate inventory
+var caBundleConfigMaps = []types.NamespacedName{
+	{Namespace: "openshift-config-managed", Name: "kube-apiserver-client-ca"},
+	{Namespace: "openshift-config-managed", Name: "kube-apiserver-server-ca"},
+	{Namespace: "openshift-config-managed", Name: "kubelet-serving-ca"},
+	{Namespace: "openshift-kube-apiserver-operator", Name: "kube-apiserver-to-kubelet-client-ca"},
+}
+
+type inventoryCertificate struct {
+	namespace string
+	name      string
+	class     string
+	cert      *x509.Certificate
+}
+
+// emitCertificateInventory emits the days until expiration of the earliest
+// expiring certificate of each class, across the TLS secrets in openshift-*
+// namespaces and the kube-apiserver and kubelet CA bundles, and of the
+// earliest expiring certificate overall.
+func (mon *Monitor) emitCertificateInventory(ctx context.Context) error {
+	certs, parseErrors, err := mon.listInventoryCertificates(ctx)
+	if err != nil {
+		return err
+	}
+
+	if parseErrors > 0 {
+		mon.emitGauge(certificateInventoryParseErrorsMetricName, int64(parseErrors), nil)
+	}
+
+	if len(certs) == 0 {
+		return nil
+	}
+
+	earliest := certs[0]
+	earliestByClass := map[string]inventoryCertificate{}
+	for _, c := range certs {
+		if c.cert.NotAfter.Before(earliest.cert.NotAfter) {
+			earliest = c
+		}
+		if e, ok := earliestByClass[c.class]; !ok || c.cert.NotAfter.Before(e.cert.NotAfter) {
+			earliestByClass[c.class] = c
+		}
+
+		daysUntilExpiration := utilcert.DaysUntilExpiration(c.cert)
+		if daysUntilExpiration <= healthSnapshotCertificateExpiryDays {
+			recordHealth(ctx, func(r *api.ClusterHealthCollectorResult) {
+				r.Certificates = append(r.Certificates, api.ClusterHealthCertificate{
+					Namespace:           c.namespace,
+					Name:                c.name,
+					Subject:             c.cert.Subject.CommonName,
+					DaysUntilExpiration: daysUntilExpiration,
+				})
+			})
+		}
+	}
+
+	for class, c := range earliestByClass {
+		mon.emitGauge(certificateInventoryMetricName, int64(utilcert.DaysUntilExpiration(c.cert)), map[string]string{
+			"class": class,
+		})
+	}
+
+	mon.emitGauge(certificateInventoryEarliestMetricName, int64(utilcert.DaysUntilExpiration(earliest.cert)), map[string]string{
+		"class":     earliest.class,
+		"namespace": earliest.namespace,
+		"name":      earliest.name,
+		"subject":   earliest.cert.Subject.CommonName,
+	})
+
+	recordHealth(ctx, func(r *api.ClusterHealthCollectorResult) {
+		r.EarliestCertificateExpiry = earliest.cert.NotAfter.Unix()
+	})
+
+	return nil
+}
+
+// listInventoryCertificates returns the certificates in the inventory, and
+// how many secrets and CA bundles could not be parsed. TLS secrets are listed
+// one openshift-* namespace at a time, so that the secrets of customer
+// namespaces are never read.
+func (mon *Monitor) listInventoryCertificates(ctx context.Context) ([]inventoryCertificate, int, error) {
+	var certs []inventoryCertificate
+	var parseErrors int
+
+	namespaces, err := mon.listOpenShiftNamespaces(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, ns := range namespaces {
+		var cont string
+		l := &corev1.SecretList{}
+
+		for {
+			err := mon.ocpclientset.List(ctx, l, client.InNamespace(ns), client.Continue(cont), client.Limit(mon.queryLimit),
+				client.MatchingFields(map[string]string{"type": string(corev1.SecretTypeTLS)}))
+			if err != nil {
+				return nil, 0, err
+			}
+
+			for _, secret := range l.Items {
+				cert, err := pem.ParseFirstCertificate(secret.Data[corev1.TLSCertKey])
+				if err != nil {
+					mon.log.Debugf("unable to parse certificate in secret %s/%s: %s", secret.Namespace, secret.Name, err)
+					parseErrors++
+					continue
+				}
+
+				certs = append(certs, inventoryCertificate{
+					namespace: secret.Namespace,
+					name:      secret.Name,
+					class:     certificateClass(secret.Namespace, secret.Name),
+					cert:      cert,
+				})
+			}
+
+			cont = l.Continue
+			if cont == "" {
+				break
+			}
+		}
+	}
+
+	for _, key := range caBundleConfigMaps {
+		cm := &corev1.ConfigMap{}
+		err := mon.ocpclientset.Get(ctx, key, cm)
+		if kerrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+
+		_, bundle, err := pem.Parse([]byte(cm.Data[caBundleKey]))
+		if err != nil {
+			mon.log.Debugf("unable to parse CA bundle in configmap %s/%s: %s", cm.Namespace, cm.Name, err)
+			parseErrors++
+			continue
+		}
+
+		for _, cert := range bundle {
+			certs = append(certs, inventoryCertificate{
+				namespace: cm.Namespace,
+				name:      cm.Name,
+				class:     certificateClassCABundle,
+				cert:      cert,
+			})
+		}
+	}
+
+	return certs, parseErrors, nil
+}
+
+// listOpenShiftNamespaces returns the names of the cluster's openshift-*
+// namespaces
+func (mon *Monitor) listOpenShiftNamespaces(ctx context.Context) ([]string, error) {
+	var namespaces []string
+
+	var cont string
+	l := &corev1.NamespaceList{}
+
+	for {
+		err := mon.ocpclientset.List(ctx, l, client.Continue(cont), client.Limit(mon.queryLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ns := range l.Items {
+			if strings.HasPrefix(ns.Name, "openshift-") {
+				namespaces = append(namespaces, ns.Name)
+			}
+		}
+
+		cont = l.Continue
+		if cont == "" {
+			break
+		}
+	}
+
+	return namespaces, nil
+}
+
+// certificateClass returns the class of the certificate in the TLS secret
+// with the given namespace and name
+func certificateClass(namespace, name string) string {
+	switch {
+	case strings.Contains(name, "signer"):
+		return certificateClassSigner
+	case namespace == etcdNamespace:
+		return certificateClassEtcd
+	case strings.HasPrefix(namespace, "openshift-ingress"),
+		// the ARO operator's copy of the managed domain's certificates
+		namespace == operator.Namespace && strings.HasSuffix(name, "-ingress"):
+		return certificateClassIngress
+	case namespace == operator.Namespace && strings.HasSuffix(name, "-apiserver"),
+		strings.HasPrefix(namespace, "openshift-kube-apiserver"),
+		strings.HasPrefix(namespace, "openshift-apiserver"),
+		strings.HasPrefix(namespace, "openshift-oauth-apiserver"):
+		return certificateClassAPIServer
+	}
+
+	return certificateClassOther
+}
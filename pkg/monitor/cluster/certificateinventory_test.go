@@ -0,0 +1,203 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/Azure/ARO-RP/pkg/util/clienthelper"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestEmitCertificateInventory(t *testing.T) {
+	ctx := context.Background()
+
+	// an hour of slack, so that the days until expiration do not round down
+	// while the test runs
+	now := time.Now().Add(time.Hour)
+
+	generatePEM := func(subject string, expiration time.Time) []byte {
+		_, certificate, err := utiltls.GenerateTestKeyAndCertificate(subject, nil, nil, false, false, tweakTemplateFn(expiration))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate[0].Raw})
+	}
+
+	tlsSecret := func(namespace, name string, data []byte) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey: data,
+			},
+			Type: corev1.SecretTypeTLS,
+		}
+	}
+
+	etcdExpiration := now.Add(10 * 24 * time.Hour)
+
+	objects := []client.Object{
+		// TLS secrets are only listed in openshift-* namespaces
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "openshift-etcd"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "openshift-ingress"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "openshift-kube-apiserver-operator"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "openshift-config"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "customer"}},
+		tlsSecret("openshift-etcd", "etcd-peer-master-0", generatePEM("etcd-peer", etcdExpiration)),
+		tlsSecret("openshift-ingress", "router-certs-default", generatePEM("*.apps.contoso.aroapp.io", now.Add(100*24*time.Hour))),
+		tlsSecret("openshift-kube-apiserver-operator", "aggregator-client-signer", generatePEM("aggregator-signer", now.Add(200*24*time.Hour))),
+		// certificates outside openshift-* namespaces are not in the inventory
+		tlsSecret("customer", "expiring", generatePEM("customer", now.Add(24*time.Hour))),
+		// unparseable certificates are counted, not returned as errors
+		tlsSecret("openshift-config", "broken", []byte("not a certificate")),
+		// only TLS secrets are in the inventory
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "opaque",
+				Namespace: "openshift-config",
+			},
+			Data: map[string][]byte{
+				corev1.TLSCertKey: generatePEM("opaque", now.Add(24*time.Hour)),
+			},
+			Type: corev1.SecretTypeOpaque,
+		},
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubelet-serving-ca",
+				Namespace: "openshift-config-managed",
+			},
+			Data: map[string]string{
+				caBundleKey: string(generatePEM("kubelet-signer", now.Add(50*24*time.Hour))) +
+					string(generatePEM("kubelet-signer-next", now.Add(300*24*time.Hour))),
+			},
+		},
+	}
+
+	controller := gomock.NewController(t)
+	m := mock_metrics.NewMockEmitter(controller)
+
+	_, log := testlog.New()
+
+	fakeClient := fake.
+		NewClientBuilder().
+		WithObjects(objects...)
+
+	// create an index on the client for secret.Type, which is used in the
+	// List filter
+	fakeClient.WithIndex(&corev1.Secret{}, "type", func(o client.Object) []string {
+		s, _ := o.(*corev1.Secret)
+		return []string{string(s.Type)}
+	})
+
+	mon := &Monitor{
+		log:          log,
+		ocpclientset: clienthelper.NewWithClient(log, fakeClient.Build()),
+		m:            m,
+		queryLimit:   1,
+	}
+
+	m.EXPECT().EmitGauge(certificateInventoryParseErrorsMetricName, int64(1), map[string]string{})
+	for class, days := range map[string]int64{
+		certificateClassEtcd:     10,
+		certificateClassIngress:  100,
+		certificateClassSigner:   200,
+		certificateClassCABundle: 50,
+	} {
+		m.EXPECT().EmitGauge(certificateInventoryMetricName, days, map[string]string{
+			"class": class,
+		})
+	}
+	m.EXPECT().EmitGauge(certificateInventoryEarliestMetricName, int64(10), map[string]string{
+		"class":     certificateClassEtcd,
+		"namespace": "openshift-etcd",
+		"name":      "etcd-peer-master-0",
+		"subject":   "etcd-peer",
+	})
+
+	r := &healthResult{}
+	err := mon.emitCertificateInventory(withHealthResult(ctx, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r.r.EarliestCertificateExpiry != etcdExpiration.Unix() {
+		t.Errorf("got earliest certificate expiry %d, wanted %d", r.r.EarliestCertificateExpiry, etcdExpiration.Unix())
+	}
+
+	if len(r.r.Certificates) != 1 || r.r.Certificates[0].Name != "etcd-peer-master-0" || r.r.Certificates[0].DaysUntilExpiration != 10 {
+		t.Errorf("unexpected certificates in health result: %#v", r.r.Certificates)
+	}
+}
+
+func TestCertificateClass(t *testing.T) {
+	for _, tt := range []struct {
+		namespace string
+		name      string
+		want      string
+	}{
+		{
+			namespace: "openshift-kube-apiserver-operator",
+			name:      "kube-apiserver-to-kubelet-signer",
+			want:      certificateClassSigner,
+		},
+		{
+			namespace: "openshift-etcd",
+			name:      "etcd-serving-master-0",
+			want:      certificateClassEtcd,
+		},
+		{
+			namespace: "openshift-ingress",
+			name:      "router-certs-default",
+			want:      certificateClassIngress,
+		},
+		{
+			namespace: "openshift-azure-operator",
+			name:      "00000000-0000-0000-0000-000000000000-ingress",
+			want:      certificateClassIngress,
+		},
+		{
+			namespace: "openshift-azure-operator",
+			name:      "00000000-0000-0000-0000-000000000000-apiserver",
+			want:      certificateClassAPIServer,
+		},
+		{
+			namespace: "openshift-kube-apiserver",
+			name:      "internal-loadbalancer-serving-certkey",
+			want:      certificateClassAPIServer,
+		},
+		{
+			namespace: "openshift-oauth-apiserver",
+			name:      "serving-cert",
+			want:      certificateClassAPIServer,
+		},
+		{
+			namespace: "openshift-monitoring",
+			name:      "prometheus-k8s-tls",
+			want:      certificateClassOther,
+		},
+	} {
+		t.Run(tt.namespace+"/"+tt.name, func(t *testing.T) {
+			got := certificateClass(tt.namespace, tt.name)
+			if got != tt.want {
+				t.Errorf("got %q, wanted %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,9 +4,11 @@ package cluster
 // Licensed under the Apache License 2.0.
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"net/http"
+	"slices"
 	"sync"
 	"time"
 
@@ -29,10 +31,13 @@ import (
 	"github.com/Azure/ARO-RP/pkg/metrics"
 	"github.com/Azure/ARO-RP/pkg/monitor/dimension"
 	"github.com/Azure/ARO-RP/pkg/monitor/emitter"
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
 	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
 	aroclient "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned"
 	"github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/scheme"
+	"github.com/Azure/ARO-RP/pkg/proxy"
 	"github.com/Azure/ARO-RP/pkg/util/clienthelper"
+	"github.com/Azure/ARO-RP/pkg/util/liveconfig"
 	"github.com/Azure/ARO-RP/pkg/util/namespace"
 	"github.com/Azure/ARO-RP/pkg/util/steps"
 	"github.com/Azure/ARO-RP/pkg/util/version"
@@ -42,10 +47,17 @@ const MONITOR_GOROUTINES_PER_CLUSTER = 5
 
 var _ monitoring.Monitor = (*Monitor)(nil)
 
-type collectorFunc func(context.Context) error
-
 type Monitor struct {
-	collectors []collectorFunc
+	collectors []collector
+	schedule   *CollectorSchedule
+	liveConfig liveconfig.Manager
+
+	// health holds the results of the collectors for the health snapshot,
+	// and stateChanges the changes they saw since the previous pass
+	healthMu          sync.Mutex
+	health            map[string]api.ClusterHealthCollectorResult
+	healthCollectedAt time.Time
+	stateChanges      []events.Event
 
 	log       *logrus.Entry
 	hourlyRun bool
@@ -54,6 +66,7 @@ type Monitor struct {
 	dims map[string]string
 
 	restconfig  *rest.Config
+	dialer      proxy.Dialer
 	cli         kubernetes.Interface
 	configcli   configclient.Interface
 	operatorcli operatorclient.Interface
@@ -82,7 +95,7 @@ type Monitor struct {
 	parallelism int
 }
 
-func NewMonitor(log *logrus.Entry, restConfig *rest.Config, oc *api.OpenShiftCluster, env env.Interface, tenantID string, m metrics.Emitter, hourlyRun bool) (monitoring.Monitor, error) {
+func NewMonitor(log *logrus.Entry, restConfig *rest.Config, dialer proxy.Dialer, oc *api.OpenShiftCluster, env env.Interface, tenantID string, m metrics.Emitter, hourlyRun bool, schedule *CollectorSchedule) (monitoring.Monitor, error) {
 	r, err := azure.ParseResourceID(oc.ID)
 	if err != nil {
 		return nil, err
@@ -141,6 +154,9 @@ func NewMonitor(log *logrus.Entry, restConfig *rest.Config, oc *api.OpenShiftClu
 	}
 
 	mon := &Monitor{
+		collectors: collectors,
+		schedule:   schedule,
+
 		log:       log,
 		hourlyRun: hourlyRun,
 
@@ -148,6 +164,7 @@ func NewMonitor(log *logrus.Entry, restConfig *rest.Config, oc *api.OpenShiftClu
 		dims: dims,
 
 		restconfig:  restConfig,
+		dialer:      dialer,
 		cli:         cli,
 		configcli:   configcli,
 		operatorcli: operatorcli,
@@ -166,37 +183,82 @@ func NewMonitor(log *logrus.Entry, restConfig *rest.Config, oc *api.OpenShiftClu
 		queryLimit:          50,
 		parallelism:         MONITOR_GOROUTINES_PER_CLUSTER,
 	}
-	mon.collectors = []collectorFunc{
-		mon.emitAroOperatorHeartbeat,
-		mon.emitAroOperatorConditions,
-		mon.emitNSGReconciliation,
-		mon.emitClusterOperatorConditions,
-		mon.emitClusterOperatorVersions,
-		mon.emitClusterVersionConditions,
-		mon.emitClusterVersions,
-		mon.emitDaemonsetStatuses,
-		mon.emitMachineConfigPoolConditions,
-		mon.emitMachineConfigPoolUnmanagedNodeCounts,
-		mon.emitMachineConditions,
-		mon.emitNodeConditions,
-		mon.emitPodConditions,
-		mon.emitCNVVirtualMachineInstanceStatuses,
-		mon.emitSummary,
-		mon.emitOperatorFlagsAndSupportBanner,
-		mon.emitMaintenanceState,
-		mon.emitIngressAndAPIServerCertificateExpiry,
-		mon.emitEtcdCertificateExpiry,
-		mon.emitPrometheusAlerts, // at the end for now because it's the slowest/least reliable
-		mon.emitCWPStatus,
-		mon.emitClusterAuthenticationType,
-		mon.emitNetworkMTU,
-	}
 
+	if env != nil {
+		mon.liveConfig = env.LiveConfig()
+	}
 	return mon, nil
 }
 
-func (mon *Monitor) timeCall(ctx context.Context, f func(context.Context) error) (err error) {
-	collectorName := steps.ShortName(f)
+func (mon *Monitor) timeCall(ctx context.Context, f collectorFunc) error {
+	return mon.timeNamedCall(ctx, steps.ShortName(f), f)
+}
+
+// runCollector runs a registered collector, bounded by its timeout
+func (mon *Monitor) runCollector(ctx context.Context, c collector) error {
+	return mon.timeNamedCall(ctx, c.name, func(ctx context.Context) error {
+		if c.timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+		}
+		return c.run(mon, ctx)
+	})
+}
+
+// dueCollectors returns the registered collectors which are enabled for the
+// cluster and due to run on this pass, cheapest first
+func (mon *Monitor) dueCollectors(ctx context.Context) []collector {
+	var overrides *liveconfig.MonitorCollectors
+	if mon.liveConfig != nil {
+		var err error
+		overrides, err = mon.liveConfig.MonitorCollectors(ctx)
+		if err != nil {
+			mon.log.Warnf("ignoring collector overrides: %s", err)
+			overrides = nil
+		}
+	}
+
+	now := mon.now()
+	due := make([]collector, 0, len(mon.collectors))
+	for _, c := range mon.collectors {
+		enabled := !c.disabled
+		if overrides != nil {
+			enabled = overrides.IsEnabled(mon.oc.ID, c.name, enabled)
+		}
+		if !enabled {
+			mon.log.Debugf("skipping %s because it is disabled", c.name)
+			continue
+		}
+
+		if !mon.schedule.due(c, now) {
+			mon.log.Debugf("skipping %s because it ran within the last %s", c.name, c.interval)
+			if result, ok := mon.schedule.result(c.name); ok {
+				mon.setHealthResult(result)
+			}
+			continue
+		}
+
+		due = append(due, c)
+	}
+
+	slices.SortStableFunc(due, func(a, b collector) int {
+		return cmp.Compare(a.cost, b.cost)
+	})
+
+	return due
+}
+
+func (mon *Monitor) timeNamedCall(ctx context.Context, collectorName string, f collectorFunc) (err error) {
+	innerNow := mon.now()
+
+	// Record the outcome of the collector for the health snapshot once any
+	// panic has been recovered below
+	result := &healthResult{}
+	ctx = withHealthResult(ctx, result)
+	defer func() {
+		mon.finishHealthResult(collectorName, result, innerNow, err)
+	}()
 
 	// Don't run collectors if we have already timed out
 	if ctx.Err() != nil {
@@ -205,7 +267,6 @@ func (mon *Monitor) timeCall(ctx context.Context, f func(context.Context) error)
 		return &failureToRunClusterCollector{collectorName: collectorName, inner: ctx.Err()}
 	}
 
-	innerNow := mon.now()
 	mon.log.Debugf("running %s", collectorName)
 
 	// If the collector panics we should return the error (so that it bubbles
@@ -245,6 +306,8 @@ func (mon *Monitor) Monitor(ctx context.Context) (_err error) {
 	monitoringStartTime := mon.now()
 	mon.log.Debug("monitoring")
 
+	mon.startHealthSnapshot(monitoringStartTime)
+
 	if mon.hourlyRun {
 		mon.emitGauge("cluster.provisioning", 1, map[string]string{
 			"provisioningState":       mon.oc.Properties.ProvisioningState.String(),
@@ -282,12 +345,16 @@ func (mon *Monitor) Monitor(ctx context.Context) (_err error) {
 	wg := new(errgroup.Group)
 	wg.SetLimit(mon.parallelism)
 
+	due := mon.dueCollectors(ctx)
+
 	// Create a channel capable of buffering one error from every collector
-	errChan := make(chan error, len(mon.collectors))
+	errChan := make(chan error, len(due))
 
-	for _, f := range mon.collectors {
+	// Collectors are started in order, so cheaper collectors are not held up
+	// waiting for expensive ones
+	for _, c := range due {
 		wg.Go(func() error {
-			innerErr := mon.timeCall(ctx, f)
+			innerErr := mon.runCollector(ctx, c)
 			if innerErr != nil {
 				// NOTE: The channel only has room to accommodate one error per
 				// collector, so if a collector needs to return multiple errors
@@ -327,6 +394,7 @@ func (mon *Monitor) emitMonitorCollectorSkipped(collectorName string) {
 
 func (mon *Monitor) emitMonitorCollectionTiming(collectorName string, duration float64) {
 	emitter.EmitFloat(mon.m, "monitor.cluster.collector.duration", duration, mon.dims, map[string]string{"collector": collectorName})
+	emitter.EmitHistogram(mon.m, "monitor.cluster.collector.duration.seconds", duration, metrics.DurationSecondsBuckets, mon.dims, map[string]string{"collector": collectorName})
 }
 
 func (mon *Monitor) emitGauge(m string, value int64, dims map[string]string) {
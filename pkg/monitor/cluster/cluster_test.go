@@ -65,7 +65,7 @@ func TestMonitor(t *testing.T) {
 		name           string
 		expectedErrors []error
 		hooks          func(*testclienthelper.HookingClient)
-		collectors     func(*Monitor) []collectorFunc
+		collectors     []collector
 		healthzCall    func(*http.Request) (*http.Response, error)
 		expectedGauges []fakemetrics.MetricsAssertion[int64]
 		expectedFloats []fakemetrics.MetricsAssertion[float64]
@@ -109,8 +109,8 @@ func TestMonitor(t *testing.T) {
 		{
 			name:        "collector failure",
 			healthzCall: func(r *http.Request) (*http.Response, error) { return &http.Response{StatusCode: http.StatusOK}, nil },
-			collectors: func(m *Monitor) []collectorFunc {
-				return []collectorFunc{m.emitPodConditions}
+			collectors: []collector{
+				{name: "emitPodConditions", run: (*Monitor).emitPodConditions},
 			},
 			hooks: func(hc *testclienthelper.HookingClient) {
 				hc.WithPreListHook(func(obj client.ObjectList, opts *client.ListOptions) error {
@@ -168,8 +168,9 @@ func TestMonitor(t *testing.T) {
 		{
 			name:        "collector panic does not stop other collectors",
 			healthzCall: func(r *http.Request) (*http.Response, error) { return &http.Response{StatusCode: http.StatusOK}, nil },
-			collectors: func(m *Monitor) []collectorFunc {
-				return []collectorFunc{m.emitPodConditions, m.emitNodeConditions}
+			collectors: []collector{
+				{name: "emitPodConditions", run: (*Monitor).emitPodConditions},
+				{name: "emitNodeConditions", run: (*Monitor).emitNodeConditions},
 			},
 			hooks: func(hc *testclienthelper.HookingClient) {
 				hc.WithPreListHook(func(obj client.ObjectList, opts *client.ListOptions) error {
@@ -340,16 +341,20 @@ func TestMonitor(t *testing.T) {
 		{
 			name:        "timeout during collector means other collectors are skipped",
 			healthzCall: func(r *http.Request) (*http.Response, error) { return &http.Response{StatusCode: http.StatusOK}, nil },
-			collectors: func(m *Monitor) []collectorFunc {
-				return []collectorFunc{
-					func(ctx context.Context) error {
+			collectors: []collector{
+				{
+					name: "1",
+					run: func(*Monitor, context.Context) error {
 						_cancel()
 						return nil
 					},
-					func(ctx context.Context) error {
+				},
+				{
+					name: "2",
+					run: func(*Monitor, context.Context) error {
 						return nil
 					},
-				}
+				},
 			},
 			expectedErrors: []error{
 				&failureToRunClusterCollector{collectorName: "2"},
@@ -461,13 +466,21 @@ func TestMonitor(t *testing.T) {
 				parallelism:         1,
 			}
 
-			if tt.collectors != nil {
-				mon.collectors = tt.collectors(mon)
-			}
+			mon.collectors = tt.collectors
 
 			err := mon.Monitor(_ctx)
 			utilerror.AssertErrorMatchesAll(t, err, tt.expectedErrors)
 
+			// collector timings are emitted as both a float and a histogram
+			h := []fakemetrics.MetricsAssertion[[]float64]{}
+			for _, a := range tt.expectedFloats {
+				h = append(h, fakemetrics.MetricsAssertion[[]float64]{
+					MetricName: a.MetricName + ".seconds",
+					Value:      []float64{a.Value},
+					Dimensions: a.Dimensions,
+				})
+			}
+
 			// we only emit duration when no errors
 			f := tt.expectedFloats
 			if len(tt.expectedErrors) == 0 {
@@ -480,6 +493,7 @@ func TestMonitor(t *testing.T) {
 
 			m.AssertFloats(f...)
 			m.AssertGauges(tt.expectedGauges...)
+			m.AssertHistograms(h...)
 		})
 	}
 }
@@ -13,6 +13,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	configv1 "github.com/openshift/api/config/v1"
+
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
 )
 
 type clusterOperatorConditionsIgnoreRegexStruct struct {
@@ -62,6 +64,14 @@ var clusterOperatorConditionsExpected = map[configv1.ClusterStatusConditionType]
 	configv1.EvaluationConditionsDetected: configv1.ConditionFalse,
 }
 
+// clusterOperatorStateConditions are the conditions whose changes are reported
+// as events. Progressing is left out as every operator reports it during an
+// upgrade.
+var clusterOperatorStateConditions = map[configv1.ClusterStatusConditionType]struct{}{
+	configv1.OperatorAvailable: {},
+	configv1.OperatorDegraded:  {},
+}
+
 func (mon *Monitor) emitClusterOperatorConditions(ctx context.Context) error {
 	var cont string
 	l := &configv1.ClusterOperatorList{}
@@ -75,6 +85,10 @@ func (mon *Monitor) emitClusterOperatorConditions(ctx context.Context) error {
 
 		for _, co := range l.Items {
 			for _, c := range co.Status.Conditions {
+				if _, ok := clusterOperatorStateConditions[c.Type]; ok {
+					recordState(ctx, events.KindClusterOperator, co.Name, string(c.Type), string(c.Status), c.Message)
+				}
+
 				if clusterOperatorConditionIsExpected(&co, &c) {
 					continue
 				}
@@ -84,6 +98,7 @@ func (mon *Monitor) emitClusterOperatorConditions(ctx context.Context) error {
 					"status": string(c.Status),
 					"type":   string(c.Type),
 				})
+				recordHealthCondition(ctx, co.Name, string(c.Type), string(c.Status), c.Message)
 
 				if mon.hourlyRun {
 					mon.log.WithFields(logrus.Fields{
@@ -10,6 +10,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	configv1 "github.com/openshift/api/config/v1"
+
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
 )
 
 var clusterVersionConditionsExpected = map[configv1.ClusterStatusConditionType]configv1.ConditionStatus{
@@ -19,6 +21,14 @@ var clusterVersionConditionsExpected = map[configv1.ClusterStatusConditionType]c
 	configv1.OperatorUpgradeable: configv1.ConditionTrue,
 }
 
+// clusterVersionStateConditions are the conditions whose changes are reported
+// as events. Progressing changes when an upgrade starts and when it finishes.
+var clusterVersionStateConditions = map[configv1.ClusterStatusConditionType]struct{}{
+	configv1.OperatorAvailable:   {},
+	configv1.OperatorDegraded:    {},
+	configv1.OperatorProgressing: {},
+}
+
 func (mon *Monitor) emitClusterVersionConditions(ctx context.Context) error {
 	cv := &configv1.ClusterVersion{}
 	err := mon.ocpclientset.Get(ctx, types.NamespacedName{Name: "version"}, cv)
@@ -26,7 +36,15 @@ func (mon *Monitor) emitClusterVersionConditions(ctx context.Context) error {
 		return fmt.Errorf("failure fetching ClusterVersion: %w", err)
 	}
 
+	if mon.clusterActualVersion != nil {
+		recordState(ctx, events.KindClusterVersion, cv.Name, events.TypeVersion, mon.clusterActualVersion.String(), "")
+	}
+
 	for _, c := range cv.Status.Conditions {
+		if _, ok := clusterVersionStateConditions[c.Type]; ok {
+			recordState(ctx, events.KindClusterVersion, cv.Name, string(c.Type), string(c.Status), c.Message)
+		}
+
 		if c.Status == clusterVersionConditionsExpected[c.Type] {
 			continue
 		}
@@ -0,0 +1,162 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+type collectorFunc func(context.Context) error
+
+// collectorIntervalTolerance allows for passes starting slightly early, so
+// that a collector with an interval of 5 minutes runs on every fifth pass of
+// a monitor running every minute
+const collectorIntervalTolerance = 5 * time.Second
+
+// costClass is how expensive a collector is to run against the cluster.
+// Cheaper collectors are started first on each pass so that slow collectors
+// cannot delay health signals.
+type costClass int
+
+const (
+	// costCheap collectors read a handful of objects, or none
+	costCheap costClass = iota
+	// costModerate collectors list objects of a kind across the cluster
+	costModerate
+	// costExpensive collectors list many objects or query Prometheus
+	costExpensive
+)
+
+// collector is a collector registered to be run by the cluster monitor
+type collector struct {
+	// name identifies the collector in metrics, logs and live config
+	name string
+
+	// interval is how often the collector runs. It runs on every pass if 0.
+	interval time.Duration
+
+	// timeout bounds each run of the collector, which is otherwise only
+	// bounded by the pass's deadline if 0
+	timeout time.Duration
+
+	cost costClass
+
+	// disabled collectors only run where live config enables them
+	disabled bool
+
+	run func(*Monitor, context.Context) error
+}
+
+// collectors is the registry of collectors run by the cluster monitor, in the
+// order they are started within each cost class
+var collectors = []collector{
+	{name: "emitAroOperatorHeartbeat", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitAroOperatorHeartbeat},
+	{name: "emitAroOperatorConditions", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitAroOperatorConditions},
+	{name: "emitInternetCheckerResults", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitInternetCheckerResults},
+	{name: "emitGuardRailsViolations", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitGuardRailsViolations},
+	{name: "emitEtcdBackupAge", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitEtcdBackupAge},
+	{name: "emitNSGReconciliation", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitNSGReconciliation},
+	{name: "emitClusterOperatorConditions", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitClusterOperatorConditions},
+	{name: "emitClusterOperatorVersions", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitClusterOperatorVersions},
+	{name: "emitClusterVersionConditions", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitClusterVersionConditions},
+	{name: "emitClusterVersions", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitClusterVersions},
+	{name: "emitDaemonsetStatuses", timeout: 20 * time.Second, cost: costModerate, run: (*Monitor).emitDaemonsetStatuses},
+	{name: "emitMachineConfigPoolConditions", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitMachineConfigPoolConditions},
+	{name: "emitMachineConfigPoolUnmanagedNodeCounts", timeout: 20 * time.Second, cost: costModerate, run: (*Monitor).emitMachineConfigPoolUnmanagedNodeCounts},
+	{name: "emitMachineConditions", timeout: 20 * time.Second, cost: costModerate, run: (*Monitor).emitMachineConditions},
+	{name: "emitNodeConditions", timeout: 20 * time.Second, cost: costModerate, run: (*Monitor).emitNodeConditions},
+	{name: "emitPodConditions", timeout: 30 * time.Second, cost: costExpensive, run: (*Monitor).emitPodConditions},
+	{name: "emitCNVVirtualMachineInstanceStatuses", timeout: 20 * time.Second, cost: costModerate, run: (*Monitor).emitCNVVirtualMachineInstanceStatuses},
+	{name: "emitSummary", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitSummary},
+	{name: "emitOperatorFlagsAndSupportBanner", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitOperatorFlagsAndSupportBanner},
+	{name: "emitMaintenanceState", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitMaintenanceState},
+	{name: "emitIngressAndAPIServerCertificateExpiry", interval: 5 * time.Minute, timeout: 20 * time.Second, cost: costModerate, run: (*Monitor).emitIngressAndAPIServerCertificateExpiry},
+	{name: "emitEtcdCertificateExpiry", interval: 5 * time.Minute, timeout: 20 * time.Second, cost: costModerate, run: (*Monitor).emitEtcdCertificateExpiry},
+	{name: "emitCertificateInventory", interval: 30 * time.Minute, timeout: 30 * time.Second, cost: costExpensive, run: (*Monitor).emitCertificateInventory},
+	{name: "emitPrometheusAlerts", timeout: 30 * time.Second, cost: costExpensive, run: (*Monitor).emitPrometheusAlerts},
+	{name: "emitCWPStatus", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitCWPStatus},
+	{name: "emitClusterAuthenticationType", interval: 5 * time.Minute, timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitClusterAuthenticationType},
+	{name: "emitNetworkMTU", timeout: 10 * time.Second, cost: costCheap, run: (*Monitor).emitNetworkMTU},
+	{name: "emitSyntheticProbes", interval: 5 * time.Minute, timeout: 30 * time.Second, cost: costModerate, disabled: true, run: (*Monitor).emitSyntheticProbes},
+}
+
+// CollectorSchedule records when each of a cluster's collectors last ran, so
+// that collectors with an interval are only run once it has passed, and their
+// last results, so that the health snapshot of a pass in which they did not
+// run still includes them. It also records the states each collector last
+// observed, so that changes to them can be reported. The cluster's monitoring
+// worker keeps it across passes.
+type CollectorSchedule struct {
+	mu         sync.Mutex
+	lastRun    map[string]time.Time
+	lastResult map[string]api.ClusterHealthCollectorResult
+	lastStates map[string]map[stateKey]observedState
+}
+
+func NewCollectorSchedule() *CollectorSchedule {
+	return &CollectorSchedule{
+		lastRun:    map[string]time.Time{},
+		lastResult: map[string]api.ClusterHealthCollectorResult{},
+		lastStates: map[string]map[stateKey]observedState{},
+	}
+}
+
+// due returns whether c should run at `now`. Every collector is due if s is
+// nil.
+func (s *CollectorSchedule) due(c collector, now time.Time) bool {
+	if s == nil || c.interval == 0 {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	lastRun, ok := s.lastRun[c.name]
+	return !ok || now.Sub(lastRun) >= c.interval-collectorIntervalTolerance
+}
+
+// ran records that the collector with the given name succeeded in the pass
+// which started at `start`. Collectors which fail are not recorded, so that
+// they are retried on the next pass rather than once their interval has
+// passed. It is a no-op if s is nil.
+func (s *CollectorSchedule) ran(name string, start time.Time) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastRun[name] = start
+}
+
+// setResult records the last result of a collector. It is a no-op if s is
+// nil.
+func (s *CollectorSchedule) setResult(result api.ClusterHealthCollectorResult) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.lastResult[result.Name] = result
+}
+
+// result returns the last result of the collector with the given name
+func (s *CollectorSchedule) result(name string) (api.ClusterHealthCollectorResult, bool) {
+	if s == nil {
+		return api.ClusterHealthCollectorResult{}, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result, ok := s.lastResult[name]
+	return result, ok
+}
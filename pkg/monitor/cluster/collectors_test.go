@@ -0,0 +1,166 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/util/liveconfig"
+	"github.com/Azure/ARO-RP/pkg/util/steps"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+	fakemetrics "github.com/Azure/ARO-RP/test/util/metrics"
+)
+
+type fakeLiveConfig struct {
+	liveconfig.Manager
+	collectors *liveconfig.MonitorCollectors
+}
+
+func (f *fakeLiveConfig) MonitorCollectors(context.Context) (*liveconfig.MonitorCollectors, error) {
+	return f.collectors, nil
+}
+
+func TestCollectorRegistry(t *testing.T) {
+	names := map[string]bool{}
+	for _, c := range collectors {
+		// collectors are named after their methods, so that the collector
+		// dimension of their metrics is unchanged
+		if c.name != steps.ShortName(c.run) {
+			t.Errorf("collector %s runs %s", c.name, steps.ShortName(c.run))
+		}
+		if names[c.name] {
+			t.Errorf("collector %s is registered more than once", c.name)
+		}
+		names[c.name] = true
+	}
+}
+
+func TestDueCollectors(t *testing.T) {
+	const resourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster"
+
+	succeed := func(*Monitor, context.Context) error { return nil }
+
+	// failsOnce fails on its first run only
+	failed := false
+	failsOnce := func(*Monitor, context.Context) error {
+		if !failed {
+			failed = true
+			return errors.New("failed")
+		}
+		return nil
+	}
+
+	registered := []collector{
+		{name: "expensive", cost: costExpensive, run: succeed},
+		{name: "moderate", cost: costModerate, run: succeed},
+		{name: "cheap", cost: costCheap, run: succeed},
+		{name: "fiveMinutely", interval: 5 * time.Minute, cost: costCheap, run: succeed},
+		{name: "optIn", cost: costCheap, disabled: true, run: succeed},
+		{name: "failsOnce", interval: 5 * time.Minute, cost: costExpensive, run: failsOnce},
+	}
+
+	for _, tt := range []struct {
+		name      string
+		overrides *liveconfig.MonitorCollectors
+		passes    []time.Duration
+		want      [][]string
+	}{
+		{
+			name:   "cheapest first, and collectors with an interval only once it has passed since they last succeeded",
+			passes: []time.Duration{0, time.Minute, 5*time.Minute - time.Second},
+			want: [][]string{
+				{"cheap", "fiveMinutely", "moderate", "expensive", "failsOnce"},
+				{"cheap", "moderate", "expensive", "failsOnce"},
+				{"cheap", "fiveMinutely", "moderate", "expensive"},
+			},
+		},
+		{
+			name: "live config enables and disables collectors",
+			overrides: &liveconfig.MonitorCollectors{
+				Disabled: []string{"expensive"},
+				Clusters: map[string]liveconfig.MonitorCollectorsOverride{
+					resourceID: {Enabled: []string{"optIn"}},
+				},
+			},
+			passes: []time.Duration{0},
+			want: [][]string{
+				{"cheap", "fiveMinutely", "optIn", "moderate", "failsOnce"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, log := testlog.New()
+
+			failed = false
+
+			var now time.Time
+			mon := &Monitor{
+				log:        log,
+				m:          &noop.Noop{},
+				oc:         &api.OpenShiftCluster{ID: resourceID},
+				collectors: registered,
+				schedule:   NewCollectorSchedule(),
+				now:        func() time.Time { return now },
+			}
+			if tt.overrides != nil {
+				mon.liveConfig = &fakeLiveConfig{collectors: tt.overrides}
+			}
+
+			for i, pass := range tt.passes {
+				now = time.Unix(0, 0).Add(pass)
+				mon.startHealthSnapshot(now)
+
+				got := []string{}
+				for _, c := range mon.dueCollectors(t.Context()) {
+					got = append(got, c.name)
+					_ = mon.runCollector(t.Context(), c)
+				}
+
+				if !reflect.DeepEqual(got, tt.want[i]) {
+					t.Errorf("pass %d: got %v, wanted %v", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRunCollectorTimeout(t *testing.T) {
+	_, log := testlog.New()
+
+	m := fakemetrics.NewFakeMetricsEmitter(t)
+
+	mon := &Monitor{
+		log: log,
+		m:   m,
+		now: time.Now,
+	}
+
+	err := mon.runCollector(t.Context(), collector{
+		name:    "slow",
+		timeout: time.Millisecond,
+		run: func(_ *Monitor, ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	})
+
+	utilerror.AssertErrorMatchesAll(t, err, []error{
+		&failureToRunClusterCollector{collectorName: "slow"},
+		context.DeadlineExceeded,
+	})
+
+	m.AssertFloats()
+	m.AssertGauges(fakemetrics.MetricsAssertion[int64]{
+		MetricName: "monitor.cluster.collector.error",
+		Value:      1,
+		Dimensions: map[string]string{"collector": "slow"},
+	})
+}
@@ -0,0 +1,63 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/etcdbackup"
+)
+
+const (
+	etcdBackupAgeMetricsTopic = "arooperator.etcdbackup.age"
+
+	// etcdBackupStaleIntervals is how many backup intervals may pass since
+	// the last etcd backup before it is stale
+	etcdBackupStaleIntervals = 2
+)
+
+// emitEtcdBackupAge emits how long ago, in seconds, the ARO operator last
+// backed up etcd, and records a stale or missing backup, or why etcd cannot be
+// backed up, in the health snapshot
+func (mon *Monitor) emitEtcdBackupAge(ctx context.Context) error {
+	cluster, err := mon.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	if !cluster.Spec.OperatorFlags.GetSimpleBoolean(operator.EtcdBackupEnabled) {
+		return nil
+	}
+
+	backup := cluster.Status.EtcdBackup
+	if backup.Message != "" {
+		recordHealthCondition(ctx, "etcd", "EtcdBackupFresh", "False", backup.Message)
+		return nil
+	}
+
+	if backup.LastBackupTime.IsZero() {
+		recordHealthCondition(ctx, "etcd", "EtcdBackupFresh", "False", "etcd has not been backed up")
+		return nil
+	}
+
+	age := mon.now().Sub(backup.LastBackupTime.Time).Truncate(time.Second)
+	stale := age > etcdBackupStaleIntervals*etcdbackup.Interval(cluster.Spec.OperatorFlags)
+
+	mon.emitGauge(etcdBackupAgeMetricsTopic, int64(age.Seconds()), map[string]string{
+		"stale": strconv.FormatBool(stale),
+	})
+
+	if stale {
+		recordHealthCondition(ctx, "etcd", "EtcdBackupFresh", "False", fmt.Sprintf("last backup %s was taken %s ago", backup.LastBackupName, age))
+	}
+
+	return nil
+}
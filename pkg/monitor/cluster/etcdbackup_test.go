@@ -0,0 +1,136 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitEtcdBackupAge(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	enabled := arov1alpha1.OperatorFlags{operator.EtcdBackupEnabled: operator.FlagTrue}
+
+	for _, tt := range []struct {
+		name           string
+		flags          arov1alpha1.OperatorFlags
+		backup         arov1alpha1.EtcdBackupStatus
+		mocks          func(*mock_metrics.MockEmitter)
+		wantConditions []api.ClusterHealthCondition
+	}{
+		{
+			name: "disabled",
+			backup: arov1alpha1.EtcdBackupStatus{
+				LastBackupTime: metav1.NewTime(now.Add(-72 * time.Hour)),
+			},
+		},
+		{
+			name:  "fresh",
+			flags: enabled,
+			backup: arov1alpha1.EtcdBackupStatus{
+				LastBackupTime: metav1.NewTime(now.Add(-time.Hour)),
+				LastBackupName: "20260102T020405Z",
+			},
+			mocks: func(m *mock_metrics.MockEmitter) {
+				m.EXPECT().EmitGauge(etcdBackupAgeMetricsTopic, int64(3600), map[string]string{"stale": "false"})
+			},
+		},
+		{
+			name:  "stale",
+			flags: enabled,
+			backup: arov1alpha1.EtcdBackupStatus{
+				LastBackupTime: metav1.NewTime(now.Add(-49 * time.Hour)),
+				LastBackupName: "20251231T020405Z",
+			},
+			mocks: func(m *mock_metrics.MockEmitter) {
+				m.EXPECT().EmitGauge(etcdBackupAgeMetricsTopic, int64(49*3600), map[string]string{"stale": "true"})
+			},
+			wantConditions: []api.ClusterHealthCondition{
+				{
+					Name:    "etcd",
+					Type:    "EtcdBackupFresh",
+					Status:  "False",
+					Message: "last backup 20251231T020405Z was taken 49h0m0s ago",
+				},
+			},
+		},
+		{
+			name:  "cannot be backed up",
+			flags: enabled,
+			backup: arov1alpha1.EtcdBackupStatus{
+				Message: "etcd backups are not supported on clusters using workload identity",
+			},
+			wantConditions: []api.ClusterHealthCondition{
+				{
+					Name:    "etcd",
+					Type:    "EtcdBackupFresh",
+					Status:  "False",
+					Message: "etcd backups are not supported on clusters using workload identity",
+				},
+			},
+		},
+		{
+			name:  "never backed up",
+			flags: enabled,
+			wantConditions: []api.ClusterHealthCondition{
+				{
+					Name:    "etcd",
+					Type:    "EtcdBackupFresh",
+					Status:  "False",
+					Message: "etcd has not been backed up",
+				},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+				Status: arov1alpha1.ClusterStatus{
+					EtcdBackup: tt.backup,
+				},
+			}
+
+			controller := gomock.NewController(t)
+			m := mock_metrics.NewMockEmitter(controller)
+			if tt.mocks != nil {
+				tt.mocks(m)
+			}
+
+			mon := &Monitor{
+				arocli: arofake.NewSimpleClientset(cluster),
+				m:      m,
+				now:    func() time.Time { return now },
+			}
+
+			r := &healthResult{}
+			err := mon.emitEtcdBackupAge(withHealthResult(ctx, r))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, diff := range deep.Equal(r.r.Conditions, tt.wantConditions) {
+				t.Error(diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+const guardrailsViolationsMetricsTopic = "guardrails.violations"
+
+// emitGuardRailsViolations emits the violations of each policy found by the
+// guardrails controller's last audit, so that customer-caused drift can be
+// seen before a policy's enforcement is changed to deny
+func (mon *Monitor) emitGuardRailsViolations(ctx context.Context) error {
+	cluster, err := mon.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	audit := cluster.Status.GuardRailsAudit
+	for _, p := range audit.Policies {
+		mon.emitGauge(guardrailsViolationsMetricsTopic, p.Violations, map[string]string{
+			"policy":      p.Name,
+			"method":      audit.Method,
+			"enforcement": p.Enforcement,
+		})
+	}
+
+	return nil
+}
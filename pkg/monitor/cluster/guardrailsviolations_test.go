@@ -0,0 +1,68 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitGuardRailsViolations(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Status: arov1alpha1.ClusterStatus{
+			GuardRailsAudit: arov1alpha1.GuardRailsAuditStatus{
+				Method: "gatekeeper",
+				Policies: []arov1alpha1.GuardRailsPolicyAudit{
+					{
+						Name:        "aro-machines-deny",
+						Enforcement: "dryrun",
+						Violations:  2,
+						Samples:     []string{"Machine openshift-machine-api/master-0: Main machine label is not allowed"},
+					},
+					{
+						Name:        "aro-nodes-deny",
+						Enforcement: "deny",
+					},
+				},
+			},
+		},
+	}
+
+	controller := gomock.NewController(t)
+	m := mock_metrics.NewMockEmitter(controller)
+
+	mon := &Monitor{
+		arocli: arofake.NewSimpleClientset(cluster),
+		m:      m,
+	}
+
+	m.EXPECT().EmitGauge(guardrailsViolationsMetricsTopic, int64(2), map[string]string{
+		"policy":      "aro-machines-deny",
+		"method":      "gatekeeper",
+		"enforcement": "dryrun",
+	})
+	m.EXPECT().EmitGauge(guardrailsViolationsMetricsTopic, int64(0), map[string]string{
+		"policy":      "aro-nodes-deny",
+		"method":      "gatekeeper",
+		"enforcement": "deny",
+	})
+
+	err := mon.emitGuardRailsViolations(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
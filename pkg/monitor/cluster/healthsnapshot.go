@@ -0,0 +1,149 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
+)
+
+// healthSnapshotCertificateExpiryDays is how soon a certificate must expire to
+// be included in the health snapshot
+const healthSnapshotCertificateExpiryDays = 30
+
+var _ monitoring.HealthSnapshotter = (*Monitor)(nil)
+
+type healthResultKey struct{}
+
+// healthResult collects what a single collector found wrong with the cluster,
+// and the states it observed. Collectors find it in their context.
+type healthResult struct {
+	mu     sync.Mutex
+	r      api.ClusterHealthCollectorResult
+	states map[stateKey]observedState
+}
+
+func withHealthResult(ctx context.Context, r *healthResult) context.Context {
+	return context.WithValue(ctx, healthResultKey{}, r)
+}
+
+// recordHealth adds to the result of the collector running with ctx, if any
+func recordHealth(ctx context.Context, f func(*api.ClusterHealthCollectorResult)) {
+	r, ok := ctx.Value(healthResultKey{}).(*healthResult)
+	if !ok {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f(&r.r)
+}
+
+func recordHealthCondition(ctx context.Context, name, conditionType, status, message string) {
+	recordHealth(ctx, func(r *api.ClusterHealthCollectorResult) {
+		r.Conditions = append(r.Conditions, api.ClusterHealthCondition{
+			Name:    name,
+			Type:    conditionType,
+			Status:  status,
+			Message: message,
+		})
+	})
+}
+
+// startHealthSnapshot discards the results of any previous pass
+func (mon *Monitor) startHealthSnapshot(start time.Time) {
+	mon.healthMu.Lock()
+	defer mon.healthMu.Unlock()
+
+	mon.health = map[string]api.ClusterHealthCollectorResult{}
+	mon.healthCollectedAt = start
+	mon.stateChanges = nil
+}
+
+// finishHealthResult records the outcome of a collector which started at
+// `start`, so that it is included in the snapshot
+func (mon *Monitor) finishHealthResult(name string, r *healthResult, start time.Time, err error) {
+	r.mu.Lock()
+	result := r.r
+	states := r.states
+	r.mu.Unlock()
+
+	result.Name = name
+	result.CollectedAt = start.Unix()
+	result.DurationSeconds = mon.now().Sub(start).Seconds()
+	// the collector's name is already in the result
+	var collectorErr *failureToRunClusterCollector
+	if errors.As(err, &collectorErr) && collectorErr.inner != nil {
+		err = collectorErr.inner
+	}
+	if err != nil {
+		result.Error = err.Error()
+	} else {
+		mon.addStateChanges(mon.schedule.updateStates(name, states))
+		mon.schedule.ran(name, mon.passStart())
+	}
+	sortHealthResult(&result)
+
+	mon.setHealthResult(result)
+	mon.schedule.setResult(result)
+}
+
+// passStart returns when the current pass started
+func (mon *Monitor) passStart() time.Time {
+	mon.healthMu.Lock()
+	defer mon.healthMu.Unlock()
+
+	return mon.healthCollectedAt
+}
+
+func (mon *Monitor) setHealthResult(result api.ClusterHealthCollectorResult) {
+	mon.healthMu.Lock()
+	defer mon.healthMu.Unlock()
+
+	if mon.health == nil {
+		mon.health = map[string]api.ClusterHealthCollectorResult{}
+	}
+	mon.health[result.Name] = result
+}
+
+// HealthSnapshot returns the results of the collectors which ran in the last
+// call to Monitor, along with the last results of collectors which were not
+// due to run.
+func (mon *Monitor) HealthSnapshot() *api.ClusterHealthSnapshot {
+	mon.healthMu.Lock()
+	defer mon.healthMu.Unlock()
+
+	s := &api.ClusterHealthSnapshot{
+		CollectedAt: mon.healthCollectedAt.Unix(),
+		Collectors:  make([]api.ClusterHealthCollectorResult, 0, len(mon.health)),
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(mon.health)) {
+		s.Collectors = append(s.Collectors, mon.health[name])
+	}
+
+	return s
+}
+
+// sortHealthResult orders what a collector found, so that snapshots only
+// differ if the health of the cluster does
+func sortHealthResult(r *api.ClusterHealthCollectorResult) {
+	slices.SortFunc(r.Conditions, func(a, b api.ClusterHealthCondition) int {
+		return strings.Compare(a.Name+"\x00"+a.Type, b.Name+"\x00"+b.Type)
+	})
+	slices.SortFunc(r.Certificates, func(a, b api.ClusterHealthCertificate) int {
+		return strings.Compare(a.Namespace+"/"+a.Name, b.Namespace+"/"+b.Name)
+	})
+	slices.SortFunc(r.Alerts, func(a, b api.ClusterHealthAlert) int {
+		return strings.Compare(a.Name+"\x00"+a.Target+"\x00"+a.SecondaryTarget, b.Name+"\x00"+b.Target+"\x00"+b.SecondaryTarget)
+	})
+}
@@ -0,0 +1,113 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestHealthSnapshot(t *testing.T) {
+	_, log := testlog.New()
+
+	registered := []collector{
+		{
+			name: "conditions",
+			run: func(_ *Monitor, ctx context.Context) error {
+				recordHealthCondition(ctx, "worker-2", "Ready", "False", "kubelet stopped posting node status")
+				recordHealthCondition(ctx, "worker-1", "DiskPressure", "True", "")
+				return nil
+			},
+		},
+		{
+			name: "failing",
+			run: func(_ *Monitor, ctx context.Context) error {
+				return errors.New("broken")
+			},
+		},
+		{
+			name:     "fiveMinutely",
+			interval: 5 * time.Minute,
+			run: func(_ *Monitor, ctx context.Context) error {
+				recordHealth(ctx, func(r *api.ClusterHealthCollectorResult) {
+					r.Certificates = append(r.Certificates, api.ClusterHealthCertificate{
+						Namespace:           "openshift-etcd",
+						Name:                "etcd-peer-master-0",
+						DaysUntilExpiration: 3,
+					})
+				})
+				return nil
+			},
+		},
+	}
+
+	schedule := NewCollectorSchedule()
+
+	// pass runs the due collectors as Monitor would, with a fresh Monitor
+	// sharing the schedule as the monitoring worker does
+	pass := func(now time.Time) *api.ClusterHealthSnapshot {
+		mon := &Monitor{
+			log:        log,
+			m:          &noop.Noop{},
+			oc:         &api.OpenShiftCluster{},
+			collectors: registered,
+			schedule:   schedule,
+			now:        func() time.Time { return now },
+		}
+
+		mon.startHealthSnapshot(now)
+		for _, c := range mon.dueCollectors(t.Context()) {
+			_ = mon.runCollector(t.Context(), c)
+		}
+
+		return mon.HealthSnapshot()
+	}
+
+	first := time.Unix(1000, 0)
+	second := first.Add(time.Minute)
+
+	conditions := api.ClusterHealthCollectorResult{
+		Name: "conditions",
+		Conditions: []api.ClusterHealthCondition{
+			{Name: "worker-1", Type: "DiskPressure", Status: "True"},
+			{Name: "worker-2", Type: "Ready", Status: "False", Message: "kubelet stopped posting node status"},
+		},
+	}
+	failing := api.ClusterHealthCollectorResult{
+		Name:  "failing",
+		Error: "broken",
+	}
+	fiveMinutely := api.ClusterHealthCollectorResult{
+		Name:        "fiveMinutely",
+		CollectedAt: first.Unix(),
+		Certificates: []api.ClusterHealthCertificate{
+			{Namespace: "openshift-etcd", Name: "etcd-peer-master-0", DaysUntilExpiration: 3},
+		},
+	}
+
+	conditions.CollectedAt, failing.CollectedAt = first.Unix(), first.Unix()
+	for _, diff := range deep.Equal(pass(first), &api.ClusterHealthSnapshot{
+		CollectedAt: first.Unix(),
+		Collectors:  []api.ClusterHealthCollectorResult{conditions, failing, fiveMinutely},
+	}) {
+		t.Errorf("first pass: %s", diff)
+	}
+
+	// fiveMinutely is not due, so keeps its result from the first pass
+	conditions.CollectedAt, failing.CollectedAt = second.Unix(), second.Unix()
+	for _, diff := range deep.Equal(pass(second), &api.ClusterHealthSnapshot{
+		CollectedAt: second.Unix(),
+		Collectors:  []api.ClusterHealthCollectorResult{conditions, failing, fiveMinutely},
+	}) {
+		t.Errorf("second pass: %s", diff)
+	}
+}
@@ -0,0 +1,52 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+const (
+	internetCheckerEndpointMetricsTopic = "arooperator.internetchecker.endpoint"
+	internetCheckerLatencyMetricsTopic  = "arooperator.internetchecker.latency"
+)
+
+// emitInternetCheckerResults emits the result of each of the ARO operator's
+// egress checks, so that unreachable endpoints can be told apart by URL, node
+// and failure class
+func (mon *Monitor) emitInternetCheckerResults(ctx context.Context) error {
+	cluster, err := mon.arocli.AroV1alpha1().Clusters().Get(ctx, arov1alpha1.SingletonClusterName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range cluster.Status.InternetChecker.Results {
+		dims := map[string]string{
+			"url":          r.URL,
+			"role":         r.Role,
+			"node":         r.Node,
+			"reachable":    strconv.FormatBool(r.Reachable),
+			"failureClass": string(r.FailureClass),
+		}
+
+		mon.emitGauge(internetCheckerEndpointMetricsTopic, 1, dims)
+		mon.emitFloat(internetCheckerLatencyMetricsTopic, float64(r.LatencyMilliseconds), dims)
+
+		if !r.Reachable {
+			from := r.Role
+			if r.Node != "" {
+				from = r.Node
+			}
+			recordHealthCondition(ctx, r.URL, "InternetReachable", "False", fmt.Sprintf("from %s: %s: %s", from, r.FailureClass, r.Message))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-test/deep"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	arofake "github.com/Azure/ARO-RP/pkg/operator/clientset/versioned/fake"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+)
+
+func TestEmitInternetCheckerResults(t *testing.T) {
+	ctx := context.Background()
+
+	cluster := &arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Status: arov1alpha1.ClusterStatus{
+			InternetChecker: arov1alpha1.InternetCheckerStatus{
+				Results: []arov1alpha1.InternetCheckResult{
+					{
+						URL:                 "https://management.azure.com",
+						Role:                "master",
+						Reachable:           true,
+						StatusCode:          200,
+						LatencyMilliseconds: 25,
+					},
+					{
+						URL:                 "https://login.microsoftonline.com",
+						Role:                "worker",
+						Node:                "worker-0",
+						FailureClass:        arov1alpha1.InternetCheckFailureTLS,
+						Message:             "x509: certificate signed by unknown authority",
+						LatencyMilliseconds: 40,
+					},
+				},
+			},
+		},
+	}
+
+	controller := gomock.NewController(t)
+	m := mock_metrics.NewMockEmitter(controller)
+
+	mon := &Monitor{
+		arocli: arofake.NewSimpleClientset(cluster),
+		m:      m,
+	}
+
+	reachableDims := map[string]string{
+		"url":          "https://management.azure.com",
+		"role":         "master",
+		"node":         "",
+		"reachable":    "true",
+		"failureClass": "",
+	}
+	unreachableDims := map[string]string{
+		"url":          "https://login.microsoftonline.com",
+		"role":         "worker",
+		"node":         "worker-0",
+		"reachable":    "false",
+		"failureClass": "TLS",
+	}
+
+	m.EXPECT().EmitGauge(internetCheckerEndpointMetricsTopic, int64(1), reachableDims)
+	m.EXPECT().EmitFloat(internetCheckerLatencyMetricsTopic, float64(25), reachableDims)
+	m.EXPECT().EmitGauge(internetCheckerEndpointMetricsTopic, int64(1), unreachableDims)
+	m.EXPECT().EmitFloat(internetCheckerLatencyMetricsTopic, float64(40), unreachableDims)
+
+	r := &healthResult{}
+	err := mon.emitInternetCheckerResults(withHealthResult(ctx, r))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantConditions := []api.ClusterHealthCondition{
+		{
+			Name:    "https://login.microsoftonline.com",
+			Type:    "InternetReachable",
+			Status:  "False",
+			Message: "from worker-0: TLS: x509: certificate signed by unknown authority",
+		},
+	}
+	for _, diff := range deep.Equal(r.r.Conditions, wantConditions) {
+		t.Error(diff)
+	}
+}
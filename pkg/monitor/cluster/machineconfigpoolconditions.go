@@ -13,6 +13,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
 )
 
 var machineConfigPoolConditionsExpected = map[mcv1.MachineConfigPoolConditionType]corev1.ConditionStatus{
@@ -38,6 +40,10 @@ func (mon *Monitor) emitMachineConfigPoolConditions(ctx context.Context) error {
 
 		for _, mcp := range l.Items {
 			for _, c := range mcp.Status.Conditions {
+				if c.Type == mcv1.MachineConfigPoolDegraded {
+					recordState(ctx, events.KindMachineConfigPool, mcp.Name, string(c.Type), string(c.Status), c.Message)
+				}
+
 				if c.Status == machineConfigPoolConditionsExpected[c.Type] {
 					continue
 				}
@@ -47,6 +53,7 @@ func (mon *Monitor) emitMachineConfigPoolConditions(ctx context.Context) error {
 					"status": string(c.Status),
 					"type":   string(c.Type),
 				})
+				recordHealthCondition(ctx, mcp.Name, string(c.Type), string(c.Status), c.Message)
 
 				if mon.hourlyRun {
 					mon.log.WithFields(logrus.Fields{
@@ -13,6 +13,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
 )
 
 const (
@@ -47,6 +49,10 @@ func (mon *Monitor) emitNodeConditions(ctx context.Context) error {
 		}
 
 		for _, c := range node.Status.Conditions {
+			if _, ok := nodeConditionsExpected[c.Type]; ok {
+				recordState(ctx, events.KindNode, node.Name, string(c.Type), string(c.Status), c.Message)
+			}
+
 			if c.Status == nodeConditionsExpected[c.Type] {
 				continue
 			}
@@ -59,6 +65,7 @@ func (mon *Monitor) emitNodeConditions(ctx context.Context) error {
 				"role":         role,
 				"machineset":   machineset,
 			})
+			recordHealthCondition(ctx, node.Name, string(c.Type), string(c.Status), c.Message)
 
 			if mon.hourlyRun {
 				mon.log.WithFields(logrus.Fields{
@@ -13,6 +13,7 @@ import (
 
 	"github.com/prometheus/common/model"
 
+	"github.com/Azure/ARO-RP/pkg/api"
 	"github.com/Azure/ARO-RP/pkg/util/namespace"
 	"github.com/Azure/ARO-RP/pkg/util/portforward"
 )
@@ -81,7 +82,7 @@ func (mon *Monitor) emitPrometheusAlerts(ctx context.Context) error {
 
 	mon.emitGauge("prometheus.alerts.count", int64(len(alerts)), nil)
 
-	mon.aggregateAndEmitAlerts(alerts)
+	mon.aggregateAndEmitAlerts(ctx, alerts)
 
 	return nil
 }
@@ -89,7 +90,7 @@ func (mon *Monitor) emitPrometheusAlerts(ctx context.Context) error {
 // Given a slice of model.Alert this func aggregates them in two metrics:
 // prometheus.alerts is used by many Geneva Monitors (example: MHCUnterminatedShortCircuit)
 // prometheus.targeted.alerts will be used as replacement for some Monitor metrics whose metrics already exist in Prometheus
-func (mon *Monitor) aggregateAndEmitAlerts(alerts []model.Alert) {
+func (mon *Monitor) aggregateAndEmitAlerts(ctx context.Context, alerts []model.Alert) {
 	collectedAlerts := map[string]struct {
 		count    int64
 		severity string
@@ -138,6 +139,13 @@ func (mon *Monitor) aggregateAndEmitAlerts(alerts []model.Alert) {
 			"alert":    alertName,
 			"severity": a.severity,
 		})
+		recordHealth(ctx, func(r *api.ClusterHealthCollectorResult) {
+			r.Alerts = append(r.Alerts, api.ClusterHealthAlert{
+				Name:     alertName,
+				Severity: a.severity,
+				Count:    a.count,
+			})
+		})
 	}
 
 	for alertKey, a := range targetedAlerts {
@@ -147,6 +155,15 @@ func (mon *Monitor) aggregateAndEmitAlerts(alerts []model.Alert) {
 			"target":           alertKey.target,
 			"secondary_target": alertKey.secondaryTarget,
 		})
+		recordHealth(ctx, func(r *api.ClusterHealthCollectorResult) {
+			r.Alerts = append(r.Alerts, api.ClusterHealthAlert{
+				Name:            alertKey.alertName,
+				Severity:        a.severity,
+				Target:          alertKey.target,
+				SecondaryTarget: alertKey.secondaryTarget,
+				Count:           a.count,
+			})
+		})
 	}
 }
 
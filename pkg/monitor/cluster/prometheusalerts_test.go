@@ -4,6 +4,7 @@ package cluster
 // Licensed under the Apache License 2.0.
 
 import (
+	"context"
 	"testing"
 
 	"github.com/prometheus/common/model"
@@ -460,7 +461,7 @@ func TestAggregateAndEmitAlerts(t *testing.T) {
 				m.EXPECT().EmitGauge(expected.metric, expected.count, expected.dims).Times(1)
 			}
 
-			mon.aggregateAndEmitAlerts(tt.alerts)
+			mon.aggregateAndEmitAlerts(context.Background(), tt.alerts)
 		})
 	}
 }
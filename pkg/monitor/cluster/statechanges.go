@@ -0,0 +1,120 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
+	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
+)
+
+var _ monitoring.StateChangeReporter = (*Monitor)(nil)
+
+// stateKey identifies a condition, or the version, of an object in the
+// cluster
+type stateKey struct {
+	kind          string
+	name          string
+	conditionType string
+}
+
+type observedState struct {
+	status  string
+	message string
+}
+
+type stateChange struct {
+	key      stateKey
+	previous observedState
+	current  observedState
+}
+
+// recordState records the state of an object observed by the collector
+// running with ctx, if any, so that changes to it can be reported
+func recordState(ctx context.Context, kind, name, conditionType, status, message string) {
+	r, ok := ctx.Value(healthResultKey{}).(*healthResult)
+	if !ok {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.states == nil {
+		r.states = map[stateKey]observedState{}
+	}
+	r.states[stateKey{kind: kind, name: name, conditionType: conditionType}] = observedState{status: status, message: message}
+}
+
+// updateStates records the states observed by a successful run of the
+// collector with the given name, and returns those which changed since its
+// previous successful run. Nothing is returned for the collector's first run,
+// nor for objects which appeared or disappeared, so a monitor which takes over
+// a cluster from another does not repeat changes the other already reported.
+// It is a no-op if s is nil.
+func (s *CollectorSchedule) updateStates(name string, states map[stateKey]observedState) []stateChange {
+	if s == nil {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous, ok := s.lastStates[name]
+	s.lastStates[name] = states
+	if !ok {
+		return nil
+	}
+
+	var changes []stateChange
+	for key, current := range states {
+		if p, ok := previous[key]; ok && p.status != current.status {
+			changes = append(changes, stateChange{key: key, previous: p, current: current})
+		}
+	}
+
+	return changes
+}
+
+func (mon *Monitor) addStateChanges(changes []stateChange) {
+	if len(changes) == 0 {
+		return
+	}
+
+	now := mon.now()
+
+	mon.healthMu.Lock()
+	defer mon.healthMu.Unlock()
+
+	for _, c := range changes {
+		mon.stateChanges = append(mon.stateChanges, events.Event{
+			Time:              now,
+			ClusterResourceID: mon.oc.ID,
+			Kind:              c.key.kind,
+			Name:              c.key.name,
+			Type:              c.key.conditionType,
+			Previous:          c.previous.status,
+			Current:           c.current.status,
+			Message:           c.current.message,
+		})
+	}
+}
+
+// StateChanges returns the changes to the state of the cluster seen by the
+// collectors in the last call to Monitor, compared with the previous time each
+// of them ran
+func (mon *Monitor) StateChanges() []events.Event {
+	mon.healthMu.Lock()
+	defer mon.healthMu.Unlock()
+
+	changes := slices.Clone(mon.stateChanges)
+	slices.SortFunc(changes, func(a, b events.Event) int {
+		return strings.Compare(a.Kind+"\x00"+a.Name+"\x00"+a.Type, b.Kind+"\x00"+b.Name+"\x00"+b.Type)
+	})
+
+	return changes
+}
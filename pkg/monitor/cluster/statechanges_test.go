@@ -0,0 +1,230 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
+	"github.com/Azure/ARO-RP/pkg/util/clienthelper"
+	"github.com/Azure/ARO-RP/pkg/util/version"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestStateChanges(t *testing.T) {
+	_, log := testlog.New()
+
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster"
+
+	// each pass, the collector observes these node states, or fails if err
+	// is set
+	type node struct {
+		name, status, message string
+	}
+	var nodes []node
+	var err error
+
+	registered := []collector{
+		{
+			name: "nodes",
+			run: func(_ *Monitor, ctx context.Context) error {
+				for _, n := range nodes {
+					recordState(ctx, events.KindNode, n.name, "Ready", n.status, n.message)
+				}
+				return err
+			},
+		},
+	}
+
+	schedule := NewCollectorSchedule()
+
+	pass := func(now time.Time) []events.Event {
+		mon := &Monitor{
+			log:        log,
+			m:          &noop.Noop{},
+			oc:         &api.OpenShiftCluster{ID: resourceID},
+			collectors: registered,
+			schedule:   schedule,
+			now:        func() time.Time { return now },
+		}
+
+		mon.startHealthSnapshot(now)
+		for _, c := range mon.dueCollectors(t.Context()) {
+			_ = mon.runCollector(t.Context(), c)
+		}
+
+		return mon.StateChanges()
+	}
+
+	now := time.Unix(1000, 0)
+	for _, tt := range []struct {
+		name  string
+		nodes []node
+		err   error
+		want  []events.Event
+	}{
+		{
+			name:  "the first pass is a baseline",
+			nodes: []node{{"master-0", "True", ""}, {"worker-0", "False", ""}},
+		},
+		{
+			name:  "unchanged",
+			nodes: []node{{"master-0", "True", ""}, {"worker-0", "False", ""}},
+		},
+		{
+			name:  "a failed collector keeps its previous states",
+			nodes: []node{{"master-0", "False", ""}},
+			err:   errors.New("broken"),
+		},
+		{
+			name:  "changed",
+			nodes: []node{{"master-0", "False", "kubelet stopped posting node status"}, {"worker-0", "True", ""}},
+			want: []events.Event{
+				{
+					ClusterResourceID: resourceID,
+					Kind:              events.KindNode,
+					Name:              "master-0",
+					Type:              "Ready",
+					Previous:          "True",
+					Current:           "False",
+					Message:           "kubelet stopped posting node status",
+				},
+				{
+					ClusterResourceID: resourceID,
+					Kind:              events.KindNode,
+					Name:              "worker-0",
+					Type:              "Ready",
+					Previous:          "False",
+					Current:           "True",
+				},
+			},
+		},
+		{
+			name:  "objects appearing and disappearing are not changes",
+			nodes: []node{{"master-0", "False", "kubelet stopped posting node status"}, {"worker-1", "False", ""}},
+		},
+	} {
+		nodes, err = tt.nodes, tt.err
+		now = now.Add(time.Minute)
+
+		for i := range tt.want {
+			tt.want[i].Time = now
+		}
+
+		for _, diff := range deep.Equal(pass(now), tt.want) {
+			t.Errorf("%s: %s", tt.name, diff)
+		}
+	}
+}
+
+func TestEmitClusterVersionConditionsStateChanges(t *testing.T) {
+	_, log := testlog.New()
+
+	resourceID := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster"
+	schedule := NewCollectorSchedule()
+
+	pass := func(now time.Time, actualVersion version.Version, progressing configv1.ConditionStatus) []events.Event {
+		cv := &configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "version",
+			},
+			Status: configv1.ClusterVersionStatus{
+				Conditions: []configv1.ClusterOperatorStatusCondition{
+					{
+						Type:   configv1.OperatorAvailable,
+						Status: configv1.ConditionTrue,
+					},
+					{
+						Type:    configv1.OperatorProgressing,
+						Status:  progressing,
+						Message: "Working towards 4.16.2",
+					},
+				},
+			},
+		}
+
+		mon := &Monitor{
+			log:                  log,
+			m:                    &noop.Noop{},
+			oc:                   &api.OpenShiftCluster{ID: resourceID},
+			ocpclientset:         clienthelper.NewWithClient(log, fake.NewClientBuilder().WithObjects(cv).Build()),
+			clusterActualVersion: actualVersion,
+			schedule:             schedule,
+			now:                  func() time.Time { return now },
+			collectors: []collector{
+				{name: "emitClusterVersionConditions", run: (*Monitor).emitClusterVersionConditions},
+			},
+		}
+
+		mon.startHealthSnapshot(now)
+		for _, c := range mon.dueCollectors(t.Context()) {
+			err := mon.runCollector(t.Context(), c)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		return mon.StateChanges()
+	}
+
+	first := time.Unix(1000, 0)
+	second := first.Add(time.Minute)
+	third := second.Add(time.Minute)
+
+	if changes := pass(first, version.NewVersion(4, 15, 1), configv1.ConditionFalse); len(changes) != 0 {
+		t.Errorf("first pass: unexpected changes %v", changes)
+	}
+
+	for _, diff := range deep.Equal(pass(second, version.NewVersion(4, 15, 1), configv1.ConditionTrue), []events.Event{
+		{
+			Time:              second,
+			ClusterResourceID: resourceID,
+			Kind:              events.KindClusterVersion,
+			Name:              "version",
+			Type:              "Progressing",
+			Previous:          "False",
+			Current:           "True",
+			Message:           "Working towards 4.16.2",
+		},
+	}) {
+		t.Errorf("upgrade started: %s", diff)
+	}
+
+	for _, diff := range deep.Equal(pass(third, version.NewVersion(4, 16, 2), configv1.ConditionFalse), []events.Event{
+		{
+			Time:              third,
+			ClusterResourceID: resourceID,
+			Kind:              events.KindClusterVersion,
+			Name:              "version",
+			Type:              "Progressing",
+			Previous:          "True",
+			Current:           "False",
+			Message:           "Working towards 4.16.2",
+		},
+		{
+			Time:              third,
+			ClusterResourceID: resourceID,
+			Kind:              events.KindClusterVersion,
+			Name:              "version",
+			Type:              events.TypeVersion,
+			Previous:          "4.15.1",
+			Current:           "4.16.2",
+		},
+	}) {
+		t.Errorf("upgrade finished: %s", diff)
+	}
+}
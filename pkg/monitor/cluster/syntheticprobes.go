@@ -0,0 +1,517 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Azure/ARO-RP/pkg/util/restconfig"
+)
+
+const (
+	syntheticProbeMetricName         = "synthetic.probe"
+	syntheticProbeDurationMetricName = "synthetic.probe.duration"
+
+	syntheticProbeConsole     = "console"
+	syntheticProbeOAuth       = "oauth"
+	syntheticProbeCanaryRoute = "canaryroute"
+	syntheticProbeImagePull   = "imagepull"
+
+	// syntheticProbeTimeout bounds each probe, including every request it
+	// makes. The probes run concurrently, so that together they finish well
+	// within the collector's timeout.
+	syntheticProbeTimeout = 10 * time.Second
+
+	// syntheticProbeMaxBodyBytes is how much of a response body the probes
+	// read
+	syntheticProbeMaxBodyBytes = 1 << 20
+)
+
+// systemCertPool returns the certificate authorities trusted by the monitor's
+// host. It is overridden in tests.
+var systemCertPool = x509.SystemCertPool
+
+type syntheticProbe struct {
+	name string
+	run  func(context.Context) (int, error)
+}
+
+// emitSyntheticProbes follows the paths a customer takes to use the cluster:
+// loading the console, discovering the OAuth server and logging in through
+// it, reaching an application served by the default ingress controller, and
+// pulling the cluster's release image. Requests are made through the
+// monitor's dialer to the API server's private endpoint, the default ingress
+// controller's IP and the release image's registry, and the success and
+// latency of each probe are emitted. The probes therefore test the monitor's
+// network path to the cluster and the registry, not the cluster's own.
+func (mon *Monitor) emitSyntheticProbes(ctx context.Context) error {
+	if mon.dialer == nil {
+		return errors.New("synthetic probes require a dialer")
+	}
+
+	ingressIP := mon.defaultIngressIP()
+	if ingressIP == "" {
+		return errors.New("default ingress profile has no IP")
+	}
+
+	apiServerRootCAs, err := mon.apiServerRootCAs()
+	if err != nil {
+		return err
+	}
+
+	ingressRootCAs, err := mon.ingressRootCAs(ctx)
+	if err != nil {
+		return err
+	}
+
+	registryRootCAs, err := systemCertPool()
+	if err != nil {
+		return err
+	}
+
+	apiServerCli := newSyntheticProbeClient(restconfig.DialContext(mon.dialer, mon.oc), apiServerRootCAs)
+	defer apiServerCli.CloseIdleConnections()
+
+	ingressCli := newSyntheticProbeClient(mon.ingressDialContext(ingressIP), ingressRootCAs)
+	defer ingressCli.CloseIdleConnections()
+
+	registryCli := newSyntheticProbeClient(mon.dialer.DialContext, registryRootCAs)
+	defer registryCli.CloseIdleConnections()
+
+	probes := []syntheticProbe{
+		{
+			name: syntheticProbeConsole,
+			run: func(ctx context.Context) (int, error) {
+				code, _, err := probeGet(ctx, ingressCli, mon.oc.Properties.ConsoleProfile.URL)
+				return code, err
+			},
+		},
+		{
+			name: syntheticProbeOAuth,
+			run: func(ctx context.Context) (int, error) {
+				return mon.probeOAuth(ctx, apiServerCli, ingressCli)
+			},
+		},
+		{
+			name: syntheticProbeCanaryRoute,
+			run: func(ctx context.Context) (int, error) {
+				return mon.probeCanaryRoute(ctx, ingressCli)
+			},
+		},
+		{
+			name: syntheticProbeImagePull,
+			run: func(ctx context.Context) (int, error) {
+				return mon.probeImagePull(ctx, registryCli)
+			},
+		},
+	}
+
+	errs := make([]error, len(probes))
+	var wg sync.WaitGroup
+	for i, p := range probes {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			probeCtx, cancel := context.WithTimeout(ctx, syntheticProbeTimeout)
+			defer cancel()
+
+			start := time.Now()
+			code, err := p.run(probeCtx)
+			duration := time.Since(start)
+
+			mon.emitGauge(syntheticProbeMetricName, 1, map[string]string{
+				"probe":   p.name,
+				"success": strconv.FormatBool(err == nil),
+				"code":    strconv.Itoa(code),
+			})
+			mon.emitFloat(syntheticProbeDurationMetricName, duration.Seconds(), map[string]string{
+				"probe": p.name,
+			})
+
+			if err != nil {
+				recordHealthCondition(ctx, p.name, "SyntheticProbe", "False", err.Error())
+				errs[i] = fmt.Errorf("%s probe: %w", p.name, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// apiServerRootCAs returns the certificate authorities trusted to serve the
+// API server: those trusted by the monitor's host, which sign the API
+// server's certificate on clusters with a managed domain, and those of the
+// cluster's kubeconfig
+func (mon *Monitor) apiServerRootCAs() (*x509.CertPool, error) {
+	pool, err := systemCertPool()
+	if err != nil {
+		return nil, err
+	}
+
+	if mon.restconfig != nil && len(mon.restconfig.TLSClientConfig.CAData) != 0 &&
+		!pool.AppendCertsFromPEM(mon.restconfig.TLSClientConfig.CAData) {
+		return nil, errors.New("kubeconfig has no valid certificate authorities")
+	}
+
+	return pool, nil
+}
+
+// ingressRootCAs returns the certificate authorities trusted to serve routes
+// through the default ingress controller: those trusted by the monitor's
+// host, which sign the default certificate on clusters with a managed
+// domain, and the one the ingress operator publishes for the default
+// certificate it generates otherwise
+func (mon *Monitor) ingressRootCAs(ctx context.Context) (*x509.CertPool, error) {
+	pool, err := systemCertPool()
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := mon.cli.CoreV1().ConfigMaps("openshift-config-managed").Get(ctx, "default-ingress-cert", metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if caBundle := cm.Data["ca-bundle.crt"]; caBundle != "" && !pool.AppendCertsFromPEM([]byte(caBundle)) {
+		return nil, errors.New("default ingress certificate authority bundle has no valid certificates")
+	}
+
+	return pool, nil
+}
+
+// probeOAuth discovers the OAuth server from the API server's well-known
+// endpoint, as `oc login` does, and checks that the OAuth server is healthy
+func (mon *Monitor) probeOAuth(ctx context.Context, apiServerCli, ingressCli *http.Client) (int, error) {
+	code, b, err := probeGet(ctx, apiServerCli, strings.TrimSuffix(mon.oc.Properties.APIServerProfile.URL, "/")+"/.well-known/oauth-authorization-server")
+	if err != nil {
+		return code, fmt.Errorf("discovery: %w", err)
+	}
+
+	var metadata struct {
+		Issuer                string `json:"issuer"`
+		AuthorizationEndpoint string `json:"authorization_endpoint"`
+	}
+	err = json.Unmarshal(b, &metadata)
+	if err != nil {
+		return code, fmt.Errorf("discovery: %w", err)
+	}
+	if metadata.Issuer == "" || metadata.AuthorizationEndpoint == "" {
+		return code, errors.New("discovery: issuer or authorization endpoint missing")
+	}
+
+	code, _, err = probeGet(ctx, ingressCli, strings.TrimSuffix(metadata.Issuer, "/")+"/healthz")
+	if err != nil {
+		return code, fmt.Errorf("oauth server: %w", err)
+	}
+
+	return code, nil
+}
+
+// probeCanaryRoute resolves the host of the route the ingress operator
+// creates for its canary from the cluster's ingress domain, and checks that
+// the canary answers through the default ingress controller
+func (mon *Monitor) probeCanaryRoute(ctx context.Context, ingressCli *http.Client) (int, error) {
+	ingress, err := mon.configcli.ConfigV1().Ingresses().Get(ctx, "cluster", metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+	if ingress.Spec.Domain == "" {
+		return 0, errors.New("ingress domain is empty")
+	}
+
+	code, _, err := probeGet(ctx, ingressCli, "https://canary-openshift-ingress-canary."+ingress.Spec.Domain+"/")
+	return code, err
+}
+
+// probeImagePull fetches the manifest of the cluster's release image from
+// its registry, as the kubelet does before pulling an image. If the registry
+// requires it, it authenticates with the credentials of the cluster's
+// registry profiles, which the RP adds to the cluster's pull secret, so that
+// the customer's pull secret is not read.
+func (mon *Monitor) probeImagePull(ctx context.Context, registryCli *http.Client) (int, error) {
+	cv, err := mon.configcli.ConfigV1().ClusterVersions().Get(ctx, "version", metav1.GetOptions{})
+	if err != nil {
+		return 0, err
+	}
+
+	registry, repository, reference, err := parseImage(cv.Status.Desired.Image)
+	if err != nil {
+		return 0, err
+	}
+
+	manifestURL := "https://" + registry + "/v2/" + repository + "/manifests/" + reference
+
+	code, challenge, err := probeManifest(ctx, registryCli, manifestURL, "")
+	if code != http.StatusUnauthorized {
+		return code, err
+	}
+
+	// the registry does not allow anonymous pulls
+	authorization, err := registryAuthorization(ctx, registryCli, challenge, registry, repository, mon.registryAuths())
+	if err != nil {
+		return code, fmt.Errorf("authentication: %w", err)
+	}
+
+	code, _, err = probeManifest(ctx, registryCli, manifestURL, authorization)
+	return code, err
+}
+
+// registryAuths returns the base64 encoded credentials of the cluster's
+// registry profiles, keyed by the registry host they are for
+func (mon *Monitor) registryAuths() map[string]string {
+	auths := map[string]string{}
+	for _, rp := range mon.oc.Properties.RegistryProfiles {
+		auths[rp.Name] = base64.StdEncoding.EncodeToString([]byte(rp.Username + ":" + string(rp.Password)))
+	}
+	return auths
+}
+
+// parseImage splits an image pull spec such as
+// quay.io/openshift-release-dev/ocp-release@sha256:... into its registry,
+// repository and tag or digest
+func parseImage(image string) (registry, repository, reference string, err error) {
+	registry, name, ok := strings.Cut(image, "/")
+	if !ok || !strings.ContainsAny(registry, ".:") {
+		return "", "", "", fmt.Errorf("image %q has no registry", image)
+	}
+
+	if repository, reference, ok = strings.Cut(name, "@"); ok {
+		return registry, repository, reference, nil
+	}
+
+	if i := strings.LastIndexByte(name, ':'); i != -1 && !strings.Contains(name[i:], "/") {
+		return registry, name[:i], name[i+1:], nil
+	}
+
+	return registry, name, "latest", nil
+}
+
+// probeManifest checks that the manifest at manifestURL can be fetched, returning
+// the status code and the registry's authentication challenge, if any
+func probeManifest(ctx context.Context, cli *http.Client, manifestURL, authorization string) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, manifestURL, nil)
+	if err != nil {
+		return 0, "", err
+	}
+
+	req.Header.Set("Accept", strings.Join([]string{
+		"application/vnd.oci.image.index.v1+json",
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.docker.distribution.manifest.list.v2+json",
+		"application/vnd.docker.distribution.manifest.v2+json",
+	}, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, resp.Header.Get("WWW-Authenticate"), fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, "", nil
+}
+
+// registryAuthorization answers the authentication challenge of registry,
+// returning the Authorization header with which to retry. auths are the base64
+// encoded credentials keyed by the host they are for, and are only sent to
+// that host over https. Bearer challenges are answered with a token for
+// pulling from repository, which is requested anonymously if there are no
+// credentials for the host of the challenge's realm.
+func registryAuthorization(ctx context.Context, cli *http.Client, challenge, registry, repository string, auths map[string]string) (string, error) {
+	scheme, params, _ := strings.Cut(challenge, " ")
+
+	switch strings.ToLower(scheme) {
+	case "basic":
+		auth := auths[registry]
+		if auth == "" {
+			return "", errors.New("no credentials for the registry")
+		}
+		return "Basic " + auth, nil
+
+	case "bearer":
+		p := challengeParams(params)
+		realm, service := p["realm"], p["service"]
+		if realm == "" {
+			return "", errors.New("bearer challenge has no realm")
+		}
+
+		u, err := url.Parse(realm)
+		if err != nil {
+			return "", err
+		}
+		if u.Scheme != "https" {
+			return "", fmt.Errorf("bearer challenge realm %q is not https", realm)
+		}
+		q := u.Query()
+		if service != "" {
+			q.Set("service", service)
+		}
+		q.Set("scope", "repository:"+repository+":pull")
+		u.RawQuery = q.Encode()
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+		if err != nil {
+			return "", err
+		}
+		if auth := auths[u.Host]; auth != "" {
+			req.Header.Set("Authorization", "Basic "+auth)
+		}
+
+		resp, err := cli.Do(req)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return "", fmt.Errorf("unexpected status code %d", resp.StatusCode)
+		}
+
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		err = json.NewDecoder(io.LimitReader(resp.Body, syntheticProbeMaxBodyBytes)).Decode(&token)
+		if err != nil {
+			return "", err
+		}
+
+		if token.Token != "" {
+			return "Bearer " + token.Token, nil
+		}
+		if token.AccessToken != "" {
+			return "Bearer " + token.AccessToken, nil
+		}
+		return "", errors.New("no token returned")
+
+	default:
+		return "", fmt.Errorf("unsupported challenge %q", challenge)
+	}
+}
+
+// challengeParams parses the comma separated key="value" parameters of an
+// authentication challenge, whose values may themselves contain commas
+func challengeParams(params string) map[string]string {
+	m := map[string]string{}
+
+	for params != "" {
+		key, rest, ok := strings.Cut(params, "=")
+		if !ok {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+			rest = "," + rest
+		}
+		m[key] = value
+
+		_, params, _ = strings.Cut(rest, ",")
+	}
+
+	return m
+}
+
+// defaultIngressIP returns the IP of the cluster's default ingress profile
+func (mon *Monitor) defaultIngressIP() string {
+	for _, p := range mon.oc.Properties.IngressProfiles {
+		if p.Name == "default" {
+			return p.IP
+		}
+	}
+	return ""
+}
+
+// ingressDialContext dials ingressIP through the monitor's dialer, whichever
+// route host is requested
+func (mon *Monitor) ingressDialContext(ingressIP string) func(ctx context.Context, network, address string) (net.Conn, error) {
+	return func(ctx context.Context, network, address string) (net.Conn, error) {
+		if network != "tcp" {
+			return nil, fmt.Errorf("unimplemented network %q", network)
+		}
+
+		_, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, err
+		}
+
+		return mon.dialer.DialContext(ctx, network, net.JoinHostPort(ingressIP, port))
+	}
+}
+
+func newSyntheticProbeClient(dialContext func(ctx context.Context, network, address string) (net.Conn, error), rootCAs *x509.CertPool) *http.Client {
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext: dialContext,
+			TLSClientConfig: &tls.Config{
+				RootCAs: rootCAs,
+			},
+			DisableKeepAlives: true,
+		},
+		// a redirect is a response, e.g. the console redirecting to log in,
+		// and is not followed, so that credentials are only sent to the
+		// host they were meant for
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// probeGet gets url, returning the status code and body of the response. A
+// status code other than 2xx or 3xx is an error.
+func probeGet(ctx context.Context, cli *http.Client, url string) (int, []byte, error) {
+	if url == "" {
+		return 0, nil, errors.New("URL is empty")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	resp, err := cli.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, syntheticProbeMaxBodyBytes))
+	if err != nil {
+		return resp.StatusCode, nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusBadRequest {
+		return resp.StatusCode, b, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, b, nil
+}
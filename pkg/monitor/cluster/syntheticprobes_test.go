@@ -0,0 +1,407 @@
+package cluster
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+
+	configv1 "github.com/openshift/api/config/v1"
+	configfake "github.com/openshift/client-go/config/clientset/versioned/fake"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	mock_proxy "github.com/Azure/ARO-RP/pkg/util/mocks/proxy"
+	utiltls "github.com/Azure/ARO-RP/pkg/util/tls"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+func TestEmitSyntheticProbes(t *testing.T) {
+	const (
+		apiServerIP = "10.0.0.1"
+		ingressIP   = "10.0.0.2"
+
+		wellKnown = `{"issuer":"https://oauth-openshift.apps.contoso.aroapp.io","authorization_endpoint":"https://oauth-openshift.apps.contoso.aroapp.io/oauth/authorize"}`
+	)
+
+	// the cluster's certificates are signed by its own certificate
+	// authority, and the registries' by one trusted by the monitor's host
+	clusterCAKey, clusterCA, err := utiltls.GenerateKeyAndCertificate("cluster-ca", nil, nil, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicCAKey, publicCA, err := utiltls.GenerateKeyAndCertificate("public-ca", nil, nil, true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serverCertificate := func(caKey *rsa.PrivateKey, ca *x509.Certificate, dnsNames ...string) tls.Certificate {
+		key, certs, err := utiltls.GenerateTestKeyAndCertificate(dnsNames[0], caKey, ca, false, false, func(template *x509.Certificate) {
+			template.DNSNames = dnsNames
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return tls.Certificate{Certificate: [][]byte{certs[0].Raw}, PrivateKey: key}
+	}
+
+	apiServerCert := serverCertificate(clusterCAKey, clusterCA[0], "api.contoso.aroapp.io")
+	ingressCert := serverCertificate(clusterCAKey, clusterCA[0], "*.apps.contoso.aroapp.io")
+	registryCert := serverCertificate(publicCAKey, publicCA[0], "quay.io", "arosvc.azurecr.io", "auth.example.com")
+	untrustedRegistryCert := serverCertificate(clusterCAKey, clusterCA[0], "quay.io")
+
+	clusterCAPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: clusterCA[0].Raw})
+
+	oldSystemCertPool := systemCertPool
+	defer func() { systemCertPool = oldSystemCertPool }()
+	systemCertPool = func() (*x509.CertPool, error) {
+		pool := x509.NewCertPool()
+		pool.AddCert(publicCA[0])
+		return pool, nil
+	}
+
+	type response struct {
+		code int
+		body string
+
+		// requests without the authorization are refused with the
+		// challenge
+		authorization string
+		challenge     string
+	}
+
+	for _, tt := range []struct {
+		name              string
+		ingressProfiles   []api.IngressProfile
+		releaseImage      string
+		untrustedRegistry bool
+		responses         map[string]response
+		wantGauges        []map[string]string
+		wantDialed        []string
+		wantErr           string
+		wantNoDialerUsed  bool
+	}{
+		{
+			name: "all probes succeed",
+			responses: map[string]response{
+				"console-openshift-console.apps.contoso.aroapp.io/":                 {code: http.StatusOK},
+				"api.contoso.aroapp.io:6443/.well-known/oauth-authorization-server": {code: http.StatusOK, body: wellKnown},
+				"oauth-openshift.apps.contoso.aroapp.io/healthz":                    {code: http.StatusOK, body: "ok"},
+				"canary-openshift-ingress-canary.apps.contoso.aroapp.io/":           {code: http.StatusOK, body: "Healthcheck requested"},
+				"quay.io/v2/openshift-release-dev/ocp-release/manifests/sha256:abc": {code: http.StatusOK},
+			},
+			wantGauges: []map[string]string{
+				{"probe": "console", "success": "true", "code": "200"},
+				{"probe": "oauth", "success": "true", "code": "200"},
+				{"probe": "canaryroute", "success": "true", "code": "200"},
+				{"probe": "imagepull", "success": "true", "code": "200"},
+			},
+			wantDialed: []string{apiServerIP + ":6443", ingressIP + ":443", "quay.io:443"},
+		},
+		{
+			name:         "image pull authenticates with the registry profile",
+			releaseImage: "arosvc.azurecr.io/openshift-release-dev/ocp-release:4.16.30-x86_64",
+			responses: map[string]response{
+				"console-openshift-console.apps.contoso.aroapp.io/":                 {code: http.StatusOK},
+				"api.contoso.aroapp.io:6443/.well-known/oauth-authorization-server": {code: http.StatusOK, body: wellKnown},
+				"oauth-openshift.apps.contoso.aroapp.io/healthz":                    {code: http.StatusOK, body: "ok"},
+				"canary-openshift-ingress-canary.apps.contoso.aroapp.io/":           {code: http.StatusOK, body: "Healthcheck requested"},
+				"arosvc.azurecr.io/v2/openshift-release-dev/ocp-release/manifests/4.16.30-x86_64": {
+					code:          http.StatusOK,
+					authorization: "Bearer token",
+					challenge:     `Bearer realm="https://arosvc.azurecr.io/oauth2/token",service="arosvc.azurecr.io"`,
+				},
+				"arosvc.azurecr.io/oauth2/token": {
+					code:          http.StatusOK,
+					body:          `{"access_token":"token"}`,
+					authorization: "Basic dXNlcjpwYXNz",
+					challenge:     `Basic realm="arosvc.azurecr.io"`,
+				},
+			},
+			wantGauges: []map[string]string{
+				{"probe": "console", "success": "true", "code": "200"},
+				{"probe": "oauth", "success": "true", "code": "200"},
+				{"probe": "canaryroute", "success": "true", "code": "200"},
+				{"probe": "imagepull", "success": "true", "code": "200"},
+			},
+			wantDialed: []string{apiServerIP + ":6443", ingressIP + ":443", "arosvc.azurecr.io:443"},
+		},
+		{
+			name:         "credentials are not sent to a token realm on another host",
+			releaseImage: "arosvc.azurecr.io/openshift-release-dev/ocp-release:4.16.30-x86_64",
+			responses: map[string]response{
+				"console-openshift-console.apps.contoso.aroapp.io/":                 {code: http.StatusOK},
+				"api.contoso.aroapp.io:6443/.well-known/oauth-authorization-server": {code: http.StatusOK, body: wellKnown},
+				"oauth-openshift.apps.contoso.aroapp.io/healthz":                    {code: http.StatusOK, body: "ok"},
+				"canary-openshift-ingress-canary.apps.contoso.aroapp.io/":           {code: http.StatusOK, body: "Healthcheck requested"},
+				"arosvc.azurecr.io/v2/openshift-release-dev/ocp-release/manifests/4.16.30-x86_64": {
+					code:          http.StatusOK,
+					authorization: "Bearer token",
+					challenge:     `Bearer realm="https://auth.example.com/token",service="arosvc.azurecr.io"`,
+				},
+				"auth.example.com/token": {
+					code:          http.StatusOK,
+					body:          `{"access_token":"token"}`,
+					authorization: "Basic dXNlcjpwYXNz",
+					challenge:     `Basic realm="auth.example.com"`,
+				},
+			},
+			wantGauges: []map[string]string{
+				{"probe": "console", "success": "true", "code": "200"},
+				{"probe": "oauth", "success": "true", "code": "200"},
+				{"probe": "canaryroute", "success": "true", "code": "200"},
+				{"probe": "imagepull", "success": "false", "code": "401"},
+			},
+			wantDialed: []string{apiServerIP + ":6443", ingressIP + ":443", "arosvc.azurecr.io:443", "auth.example.com:443"},
+			wantErr:    "imagepull probe: authentication: unexpected status code 401",
+		},
+		{
+			name: "untrusted registry certificate",
+			responses: map[string]response{
+				"console-openshift-console.apps.contoso.aroapp.io/":                 {code: http.StatusOK},
+				"api.contoso.aroapp.io:6443/.well-known/oauth-authorization-server": {code: http.StatusOK, body: wellKnown},
+				"oauth-openshift.apps.contoso.aroapp.io/healthz":                    {code: http.StatusOK, body: "ok"},
+				"canary-openshift-ingress-canary.apps.contoso.aroapp.io/":           {code: http.StatusOK, body: "Healthcheck requested"},
+				"quay.io/v2/openshift-release-dev/ocp-release/manifests/sha256:abc": {code: http.StatusOK},
+			},
+			untrustedRegistry: true,
+			wantGauges: []map[string]string{
+				{"probe": "console", "success": "true", "code": "200"},
+				{"probe": "oauth", "success": "true", "code": "200"},
+				{"probe": "canaryroute", "success": "true", "code": "200"},
+				{"probe": "imagepull", "success": "false", "code": "0"},
+			},
+			wantDialed: []string{apiServerIP + ":6443", ingressIP + ":443", "quay.io:443"},
+			wantErr:    `imagepull probe: Head "https://quay.io/v2/openshift-release-dev/ocp-release/manifests/sha256:abc": tls: failed to verify certificate: x509: certificate signed by unknown authority`,
+		},
+		{
+			name: "failing probes",
+			responses: map[string]response{
+				"console-openshift-console.apps.contoso.aroapp.io/":                 {code: http.StatusFound},
+				"api.contoso.aroapp.io:6443/.well-known/oauth-authorization-server": {code: http.StatusOK, body: "{}"},
+				"canary-openshift-ingress-canary.apps.contoso.aroapp.io/":           {code: http.StatusServiceUnavailable},
+			},
+			wantGauges: []map[string]string{
+				{"probe": "console", "success": "true", "code": "302"},
+				{"probe": "oauth", "success": "false", "code": "200"},
+				{"probe": "canaryroute", "success": "false", "code": "503"},
+				{"probe": "imagepull", "success": "false", "code": "404"},
+			},
+			wantDialed: []string{apiServerIP + ":6443", ingressIP + ":443", "quay.io:443"},
+			wantErr:    "oauth probe: discovery: issuer or authorization endpoint missing\ncanaryroute probe: unexpected status code 503\nimagepull probe: unexpected status code 404",
+		},
+		{
+			name:             "no default ingress IP",
+			ingressProfiles:  []api.IngressProfile{{Name: "other", IP: ingressIP}},
+			wantErr:          "default ingress profile has no IP",
+			wantNoDialerUsed: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				resp, ok := tt.responses[r.Host+r.URL.Path]
+				if !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				if resp.authorization != "" && r.Header.Get("Authorization") != resp.authorization {
+					w.Header().Set("WWW-Authenticate", resp.challenge)
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.WriteHeader(resp.code)
+				_, _ = w.Write([]byte(resp.body))
+			}))
+			server.TLS = &tls.Config{
+				GetCertificate: func(hello *tls.ClientHelloInfo) (*tls.Certificate, error) {
+					switch {
+					case hello.ServerName == "api.contoso.aroapp.io":
+						return &apiServerCert, nil
+					case strings.HasSuffix(hello.ServerName, ".apps.contoso.aroapp.io"):
+						return &ingressCert, nil
+					case tt.untrustedRegistry:
+						return &untrustedRegistryCert, nil
+					default:
+						return &registryCert, nil
+					}
+				},
+			}
+			server.StartTLS()
+			defer server.Close()
+
+			controller := gomock.NewController(t)
+			m := mock_metrics.NewMockEmitter(controller)
+			dialer := mock_proxy.NewMockDialer(controller)
+			_, log := testlog.New()
+
+			// every connection goes to the test server, recording the
+			// address the probes dialed
+			var mu sync.Mutex
+			dialed := map[string]bool{}
+			if !tt.wantNoDialerUsed {
+				dialer.EXPECT().DialContext(gomock.Any(), "tcp", gomock.Any()).DoAndReturn(func(ctx context.Context, network, address string) (net.Conn, error) {
+					mu.Lock()
+					dialed[address] = true
+					mu.Unlock()
+					return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+				}).AnyTimes()
+			}
+
+			ingressProfiles := tt.ingressProfiles
+			if ingressProfiles == nil {
+				ingressProfiles = []api.IngressProfile{{Name: "default", IP: ingressIP}}
+			}
+
+			releaseImage := tt.releaseImage
+			if releaseImage == "" {
+				releaseImage = "quay.io/openshift-release-dev/ocp-release@sha256:abc"
+			}
+
+			mon := &Monitor{
+				log: log,
+				m:   m,
+				oc: &api.OpenShiftCluster{
+					Properties: api.OpenShiftClusterProperties{
+						APIServerProfile: api.APIServerProfile{
+							URL: "https://api.contoso.aroapp.io:6443/",
+						},
+						ConsoleProfile: api.ConsoleProfile{
+							URL: "https://console-openshift-console.apps.contoso.aroapp.io/",
+						},
+						NetworkProfile: api.NetworkProfile{
+							APIServerPrivateEndpointIP: apiServerIP,
+						},
+						IngressProfiles: ingressProfiles,
+						RegistryProfiles: []*api.RegistryProfile{
+							{Name: "arosvc.azurecr.io", Username: "user", Password: "pass"},
+						},
+					},
+				},
+				restconfig: &rest.Config{
+					TLSClientConfig: rest.TLSClientConfig{CAData: clusterCAPEM},
+				},
+				dialer: dialer,
+				cli: kubernetesfake.NewSimpleClientset(&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "default-ingress-cert",
+						Namespace: "openshift-config-managed",
+					},
+					Data: map[string]string{
+						"ca-bundle.crt": string(clusterCAPEM),
+					},
+				}),
+				configcli: configfake.NewSimpleClientset(&configv1.Ingress{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster",
+					},
+					Spec: configv1.IngressSpec{
+						Domain: "apps.contoso.aroapp.io",
+					},
+				}, &configv1.ClusterVersion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "version",
+					},
+					Status: configv1.ClusterVersionStatus{
+						Desired: configv1.Release{
+							Image: releaseImage,
+						},
+					},
+				}),
+			}
+
+			for _, dims := range tt.wantGauges {
+				m.EXPECT().EmitGauge(syntheticProbeMetricName, int64(1), dims)
+				m.EXPECT().EmitFloat(syntheticProbeDurationMetricName, gomock.Any(), map[string]string{"probe": dims["probe"]})
+			}
+
+			err := mon.emitSyntheticProbes(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			for _, address := range tt.wantDialed {
+				if !dialed[address] {
+					t.Errorf("wanted %s to be dialed, got %v", address, dialed)
+				}
+			}
+			if len(dialed) != len(tt.wantDialed) {
+				t.Errorf("wanted %v to be dialed, got %v", tt.wantDialed, dialed)
+			}
+		})
+	}
+}
+
+func TestParseImage(t *testing.T) {
+	for _, tt := range []struct {
+		image          string
+		wantRegistry   string
+		wantRepository string
+		wantReference  string
+		wantErr        string
+	}{
+		{
+			image:          "quay.io/openshift-release-dev/ocp-release@sha256:abc",
+			wantRegistry:   "quay.io",
+			wantRepository: "openshift-release-dev/ocp-release",
+			wantReference:  "sha256:abc",
+		},
+		{
+			image:          "arosvc.azurecr.io/openshift-release-dev/ocp-release:4.16.30-x86_64",
+			wantRegistry:   "arosvc.azurecr.io",
+			wantRepository: "openshift-release-dev/ocp-release",
+			wantReference:  "4.16.30-x86_64",
+		},
+		{
+			image:          "localhost:5000/release",
+			wantRegistry:   "localhost:5000",
+			wantRepository: "release",
+			wantReference:  "latest",
+		},
+		{
+			image:   "openshift-release-dev/ocp-release:4.16.30",
+			wantErr: `image "openshift-release-dev/ocp-release:4.16.30" has no registry`,
+		},
+	} {
+		t.Run(tt.image, func(t *testing.T) {
+			registry, repository, reference, err := parseImage(tt.image)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if registry != tt.wantRegistry || repository != tt.wantRepository || reference != tt.wantReference {
+				t.Errorf("got %s, %s, %s", registry, repository, reference)
+			}
+		})
+	}
+}
+
+func TestChallengeParams(t *testing.T) {
+	got := challengeParams(`realm="https://auth.example.com/token",service="registry.example.com",scope="repository:a:pull,push",error=invalid_token`)
+
+	want := map[string]string{
+		"realm":   "https://auth.example.com/token",
+		"service": "registry.example.com",
+		"scope":   "repository:a:pull,push",
+		"error":   "invalid_token",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, wanted %v", got, want)
+	}
+}
@@ -8,6 +8,7 @@ const (
 	ClusterResourceGroup = "resourceGroup"
 	ResourceID           = "resourceId"
 	ResourceName         = "resourceName"
+	ResourceType         = "resourceType"
 	Subnet               = "subnet"
 	SubscriptionID       = "subscriptionId"
 	Vnet                 = "vNet"
@@ -19,4 +20,6 @@ const (
 	NSGRuleName         = "rulename"
 	NSGRulePriority     = "priority"
 	NSGRuleSources      = "sources"
+
+	DriftReason = "reason"
 )
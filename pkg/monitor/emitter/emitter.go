@@ -24,3 +24,13 @@ func EmitFloat(emitter metrics.Emitter, name string, value float64, existing map
 	}
 	emitter.EmitFloat(name, value, additional)
 }
+
+func EmitHistogram(emitter metrics.Emitter, name string, value float64, buckets []float64, existing map[string]string, additional map[string]string) {
+	if additional == nil {
+		additional = map[string]string{}
+	}
+	for k, v := range existing {
+		additional[k] = v
+	}
+	emitter.EmitHistogram(name, value, buckets, additional)
+}
@@ -0,0 +1,64 @@
+package events
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Kinds of object whose state changes are reported
+const (
+	KindClusterOperator   = "ClusterOperator"
+	KindClusterVersion    = "ClusterVersion"
+	KindMachineConfigPool = "MachineConfigPool"
+	KindNode              = "Node"
+)
+
+// TypeVersion is the Type of events reporting a change of the cluster's
+// version, rather than of a condition
+const TypeVersion = "Version"
+
+// Event reports that the monitor saw the state of an object in a cluster
+// change between two monitoring passes, e.g. a cluster operator's Degraded
+// condition going from False to True.
+type Event struct {
+	Time              time.Time `json:"time"`
+	ClusterResourceID string    `json:"clusterResourceID"`
+
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Type is the condition type, or TypeVersion
+	Type string `json:"type"`
+
+	Previous string `json:"previous"`
+	Current  string `json:"current"`
+	Message  string `json:"message,omitempty"`
+}
+
+// Sink receives the events of the clusters monitored by this monitor. Send is
+// called concurrently by each cluster's monitoring worker.
+type Sink interface {
+	Send(ctx context.Context, events []Event) error
+}
+
+type multiSink []Sink
+
+// NewMultiSink returns a Sink which sends events to each of sinks
+func NewMultiSink(sinks ...Sink) Sink {
+	return multiSink(sinks)
+}
+
+func (ms multiSink) Send(ctx context.Context, events []Event) error {
+	var errs []error
+	for _, s := range ms {
+		err := s.Send(ctx, events)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
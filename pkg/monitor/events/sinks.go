@@ -0,0 +1,110 @@
+package events
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+type logSink struct {
+	log *logrus.Entry
+}
+
+// NewLogSink returns a Sink which logs each event, so that they reach the
+// monitor's log stream
+func NewLogSink(log *logrus.Entry) Sink {
+	return &logSink{log: log}
+}
+
+func (s *logSink) Send(ctx context.Context, events []Event) error {
+	for _, e := range events {
+		s.log.WithFields(logrus.Fields{
+			"resource_id": e.ClusterResourceID,
+			"kind":        e.Kind,
+			"name":        e.Name,
+			"type":        e.Type,
+			"previous":    e.Previous,
+			"current":     e.Current,
+			"message":     e.Message,
+		}).Infof("%s %s %s changed from %q to %q", e.Kind, e.Name, e.Type, e.Previous, e.Current)
+	}
+
+	return nil
+}
+
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink returns a Sink which POSTs each batch of events to url as a
+// JSON array
+func NewWebhookSink(url string, client *http.Client) Sink {
+	return &webhookSink{url: url, client: client}
+}
+
+func (s *webhookSink) Send(ctx context.Context, events []Event) error {
+	b, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("unexpected status code %d from event webhook", resp.StatusCode)
+	}
+
+	return nil
+}
+
+type fileSink struct {
+	mu   sync.Mutex
+	path string
+}
+
+// NewFileSink returns a Sink which appends each event to the file at path as
+// a line of JSON. It is intended for local development and testing.
+func NewFileSink(path string) Sink {
+	return &fileSink{path: path}
+}
+
+func (s *fileSink) Send(ctx context.Context, events []Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	e := json.NewEncoder(f)
+	for _, event := range events {
+		err = e.Encode(event)
+		if err != nil {
+			f.Close()
+			return err
+		}
+	}
+
+	return f.Close()
+}
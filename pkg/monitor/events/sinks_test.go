@@ -0,0 +1,140 @@
+package events
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+var testEvents = []Event{
+	{
+		Time:              time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		ClusterResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster",
+		Kind:              KindClusterOperator,
+		Name:              "ingress",
+		Type:              "Degraded",
+		Previous:          "False",
+		Current:           "True",
+		Message:           "one or more ingress controllers are degraded",
+	},
+	{
+		Time:              time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		ClusterResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster",
+		Kind:              KindClusterVersion,
+		Name:              "version",
+		Type:              TypeVersion,
+		Previous:          "4.15.1",
+		Current:           "4.16.2",
+	},
+}
+
+func TestLogSink(t *testing.T) {
+	h, log := testlog.New()
+
+	err := NewLogSink(log).Send(t.Context(), testEvents)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = testlog.AssertLoggingOutput(h, []testlog.ExpectedLogEntry{
+		{
+			"level": gomega.Equal(logrus.InfoLevel),
+			"msg":   gomega.Equal(`ClusterOperator ingress Degraded changed from "False" to "True"`),
+		},
+		{
+			"level": gomega.Equal(logrus.InfoLevel),
+			"msg":   gomega.Equal(`ClusterVersion version Version changed from "4.15.1" to "4.16.2"`),
+		},
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		statusCode int
+		wantErr    string
+	}{
+		{
+			name:       "accepted",
+			statusCode: http.StatusAccepted,
+		},
+		{
+			name:       "rejected",
+			statusCode: http.StatusBadGateway,
+			wantErr:    "unexpected status code 502 from event webhook",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Event
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("unexpected request %s %s", r.Method, r.Header.Get("Content-Type"))
+				}
+				err := json.NewDecoder(r.Body).Decode(&got)
+				if err != nil {
+					t.Error(err)
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer srv.Close()
+
+			err := NewWebhookSink(srv.URL, srv.Client()).Send(t.Context(), testEvents)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			for _, l := range deep.Equal(got, testEvents) {
+				t.Error(l)
+			}
+		})
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.jsonl")
+	s := NewFileSink(path)
+
+	for _, e := range testEvents {
+		err := s.Send(t.Context(), []Event{e})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var got []Event
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Event
+		err = json.Unmarshal(scanner.Bytes(), &e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, e)
+	}
+
+	for _, l := range deep.Equal(got, testEvents) {
+		t.Error(l)
+	}
+}
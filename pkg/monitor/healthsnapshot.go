@@ -0,0 +1,145 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
+	"github.com/Azure/ARO-RP/pkg/monitor/slo"
+)
+
+// healthSnapshotRefreshInterval is how often a cluster's health snapshot is
+// saved when the health of the cluster has not changed, so that its age shows
+// that the cluster is still being monitored
+var healthSnapshotRefreshInterval = 10 * time.Minute
+
+// healthSnapshotSaveTimeout bounds how long saving a health snapshot may take
+const healthSnapshotSaveTimeout = 10 * time.Second
+
+// healthSnapshotHistory records the last health snapshot saved for a
+// cluster, the cluster's SLO tracker whose state is saved with it, and the
+// last drift report, which is saved with every snapshot as the drift monitor
+// runs less often than the cluster monitor. The cluster's monitoring worker
+// keeps it across passes.
+type healthSnapshotHistory struct {
+	saved   *api.ClusterHealthSnapshot
+	savedAt time.Time
+
+	drift *api.ClusterHealthDriftReport
+
+	slo         *slo.Tracker
+	sloRestored bool
+}
+
+// saveHealthSnapshot saves the health snapshot of m, if it has one, unless it
+// shows the same health as the snapshot last saved for the cluster within
+// healthSnapshotRefreshInterval. Every snapshot is saved if history is nil.
+func (mon *monitor) saveHealthSnapshot(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument, m monitoring.Monitor, history *healthSnapshotHistory) {
+	snapshotter, ok := m.(monitoring.HealthSnapshotter)
+	if !ok {
+		return
+	}
+
+	snapshot := snapshotter.HealthSnapshot()
+	if history != nil && history.drift != nil {
+		s := *snapshot
+		s.Drift = history.drift
+		snapshot = &s
+	}
+	now := mon.env.Now()
+
+	if history != nil && history.saved != nil &&
+		now.Sub(history.savedAt) < healthSnapshotRefreshInterval &&
+		sameHealth(history.saved, snapshot) {
+		return
+	}
+
+	if mon.putHealthSnapshot(ctx, log, doc, snapshot, history) && history != nil {
+		history.saved = snapshot
+		history.savedAt = now
+	}
+}
+
+// putHealthSnapshot saves snapshot, along with the state of the cluster's SLO
+// tracker if it has one, returning whether it succeeded
+func (mon *monitor) putHealthSnapshot(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument, snapshot *api.ClusterHealthSnapshot, history *healthSnapshotHistory) bool {
+	dbClusterHealthSnapshots, err := mon.dbGroup.ClusterHealthSnapshots()
+	if err != nil {
+		log.Error(err)
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthSnapshotSaveTimeout)
+	defer cancel()
+
+	healthSnapshotDoc := &api.ClusterHealthSnapshotDocument{
+		ID:                    doc.ID,
+		ClusterResourceID:     doc.OpenShiftCluster.ID,
+		ClusterHealthSnapshot: *snapshot,
+	}
+	if history != nil && history.slo != nil {
+		healthSnapshotDoc.SLOState = history.slo.State()
+	}
+
+	_, err = dbClusterHealthSnapshots.Put(ctx, healthSnapshotDoc)
+	if err != nil {
+		log.Errorf("failed to save health snapshot: %s", err)
+		mon.m.EmitGauge("monitor.healthsnapshot.failed", 1, nil)
+		return false
+	}
+
+	return true
+}
+
+// recordDrift keeps the drift report of m, if it ran on this pass, to be
+// saved with the cluster's health snapshots
+func (mon *monitor) recordDrift(m monitoring.Monitor, history *healthSnapshotHistory) {
+	reporter, ok := m.(monitoring.DriftReporter)
+	if !ok || history == nil {
+		return
+	}
+
+	if report := reporter.DriftReport(); report != nil {
+		history.drift = report
+	}
+}
+
+// sameHealth returns whether two snapshots have the same collector results
+// and drift, disregarding when the collectors and drift monitor ran and how
+// long they took
+func sameHealth(a, b *api.ClusterHealthSnapshot) bool {
+	if len(a.Collectors) != len(b.Collectors) {
+		return false
+	}
+
+	if (a.Drift == nil) != (b.Drift == nil) {
+		return false
+	}
+	if a.Drift != nil {
+		da, db := *a.Drift, *b.Drift
+		da.CheckedAt, db.CheckedAt = 0, 0
+
+		if !reflect.DeepEqual(da, db) {
+			return false
+		}
+	}
+
+	for i := range a.Collectors {
+		ra, rb := a.Collectors[i], b.Collectors[i]
+		ra.CollectedAt, rb.CollectedAt = 0, 0
+		ra.DurationSeconds, rb.DurationSeconds = 0, 0
+
+		if !reflect.DeepEqual(ra, rb) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,108 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+type fakeSnapshotMonitor struct {
+	snapshot *api.ClusterHealthSnapshot
+}
+
+func (m *fakeSnapshotMonitor) Monitor(context.Context) error { return nil }
+
+func (m *fakeSnapshotMonitor) MonitorName() string { return "fakesnapshotmonitor" }
+
+func (m *fakeSnapshotMonitor) HealthSnapshot() *api.ClusterHealthSnapshot { return m.snapshot }
+
+type fakeDriftMonitor struct {
+	report *api.ClusterHealthDriftReport
+}
+
+func (m *fakeDriftMonitor) Monitor(context.Context) error { return nil }
+
+func (m *fakeDriftMonitor) MonitorName() string { return "fakedriftmonitor" }
+
+func (m *fakeDriftMonitor) DriftReport() *api.ClusterHealthDriftReport { return m.report }
+
+func TestSaveHealthSnapshot(t *testing.T) {
+	env := SetupTestEnvironment(t)
+	defer env.Cleanup()
+
+	mon := env.CreateTestMonitor("healthsnapshot")
+	log := env.TestLogger
+
+	doc := &api.OpenShiftClusterDocument{
+		ID: "00000000-0000-0000-0000-000000000001",
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster",
+		},
+	}
+
+	snapshot := func(collectedAt int64, err string) *api.ClusterHealthSnapshot {
+		return &api.ClusterHealthSnapshot{
+			CollectedAt: collectedAt,
+			Collectors: []api.ClusterHealthCollectorResult{
+				{Name: "emitNodeConditions", CollectedAt: collectedAt, DurationSeconds: float64(collectedAt), Error: err},
+			},
+		}
+	}
+
+	want := func(s *api.ClusterHealthSnapshot) {
+		t.Helper()
+
+		checker := testdatabase.NewChecker()
+		checker.AddClusterHealthSnapshotDocuments(&api.ClusterHealthSnapshotDocument{
+			ID:                    doc.ID,
+			ClusterResourceID:     doc.OpenShiftCluster.ID,
+			ClusterHealthSnapshot: *s,
+		})
+		for _, err := range checker.CheckClusterHealthSnapshots(env.ClusterHealthSnapshotClient) {
+			t.Error(err)
+		}
+	}
+
+	history := &healthSnapshotHistory{}
+
+	mon.saveHealthSnapshot(t.Context(), log, doc, &fakeSnapshotMonitor{snapshot: snapshot(1, "")}, history)
+	want(snapshot(1, ""))
+
+	// the same health is not saved again until the refresh interval passes
+	mon.saveHealthSnapshot(t.Context(), log, doc, &fakeSnapshotMonitor{snapshot: snapshot(2, "")}, history)
+	want(snapshot(1, ""))
+
+	mon.saveHealthSnapshot(t.Context(), log, doc, &fakeSnapshotMonitor{snapshot: snapshot(3, "broken")}, history)
+	want(snapshot(3, "broken"))
+
+	history.savedAt = history.savedAt.Add(-healthSnapshotRefreshInterval)
+	mon.saveHealthSnapshot(t.Context(), log, doc, &fakeSnapshotMonitor{snapshot: snapshot(4, "broken")}, history)
+	want(snapshot(4, "broken"))
+
+	// drift is saved as soon as it is found, and kept on passes where the
+	// drift monitor does not run
+	drift := &api.ClusterHealthDriftReport{
+		CheckedAt: 5,
+		Resources: []api.ClusterHealthDriftedResource{
+			{ResourceType: "LoadBalancer", ResourceName: "aro-internal", Reason: "Missing", Message: "load balancer not found"},
+		},
+	}
+	withDrift := func(s *api.ClusterHealthSnapshot) *api.ClusterHealthSnapshot {
+		s.Drift = drift
+		return s
+	}
+
+	mon.recordDrift(&fakeDriftMonitor{report: drift}, history)
+	mon.saveHealthSnapshot(t.Context(), log, doc, &fakeSnapshotMonitor{snapshot: snapshot(5, "broken")}, history)
+	want(withDrift(snapshot(5, "broken")))
+
+	mon.recordDrift(&fakeDriftMonitor{}, history)
+	history.savedAt = history.savedAt.Add(-healthSnapshotRefreshInterval)
+	mon.saveHealthSnapshot(t.Context(), log, doc, &fakeSnapshotMonitor{snapshot: snapshot(6, "broken")}, history)
+	want(withDrift(snapshot(6, "broken")))
+}
@@ -18,8 +18,10 @@ import (
 	"github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/hive"
 	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/monitor/azure/drift"
 	"github.com/Azure/ARO-RP/pkg/monitor/azure/nsg"
 	"github.com/Azure/ARO-RP/pkg/monitor/cluster"
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
 	hivemon "github.com/Azure/ARO-RP/pkg/monitor/hive"
 	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
 	"github.com/Azure/ARO-RP/pkg/proxy"
@@ -34,6 +36,7 @@ type monitorDBs interface {
 	database.DatabaseGroupWithPoolWorkers
 	database.DatabaseGroupWithOpenShiftClusters
 	database.DatabaseGroupWithSubscriptions
+	database.DatabaseGroupWithClusterHealthSnapshots
 }
 
 // Defaults for the different durations. We use different values in tests to speed them up.
@@ -54,22 +57,28 @@ type monitor struct {
 
 	dbGroup monitorDBs
 
-	m        metrics.Emitter
-	clusterm metrics.Emitter
-	clusters *clusterChangeFeedResponder
-	subs     changefeed.SubscriptionsCache
-	env      env.Interface
+	m         metrics.Emitter
+	clusterm  metrics.Emitter
+	eventSink events.Sink
+	clusters  *clusterChangeFeedResponder
+	subs      changefeed.SubscriptionsCache
+	env       env.Interface
 
 	bucketCount      int
 	workerCount      *atomic.Int32
 	lastBucketUpdate atomic.Value // time.Time
 
+	// runBudget holds a slot for each monitoring pass in progress, or is nil
+	// if passes are unlimited
+	runBudget chan struct{}
+
 	startTime time.Time
 
 	hiveClusterManagers map[int]hive.ClusterManager
 
-	clusterMonitorBuilder func(log *logrus.Entry, restConfig *rest.Config, oc *api.OpenShiftCluster, env env.Interface, tenantID string, m metrics.Emitter, hourlyRun bool) (monitoring.Monitor, error)
+	clusterMonitorBuilder func(log *logrus.Entry, restConfig *rest.Config, dialer proxy.Dialer, oc *api.OpenShiftCluster, env env.Interface, tenantID string, m metrics.Emitter, hourlyRun bool, schedule *cluster.CollectorSchedule) (monitoring.Monitor, error)
 	nsgMonitorBuilder     func(log *logrus.Entry, oc *api.OpenShiftCluster, e env.Interface, subscriptionID string, tenantID string, emitter metrics.Emitter, dims map[string]string, trigger <-chan time.Time) monitoring.Monitor
+	driftMonitorBuilder   func(log *logrus.Entry, oc *api.OpenShiftCluster, e env.Interface, subscriptionID string, tenantID string, emitter metrics.Emitter, dims map[string]string, trigger <-chan time.Time) monitoring.Monitor
 	hiveMonitorBuilder    func(log *logrus.Entry, oc *api.OpenShiftCluster, m metrics.Emitter, hourlyRun bool, hiveClusterManager hive.ClusterManager) (monitoring.Monitor, error)
 
 	workerMaxStartupDelay          time.Duration // Time until monitor workers start running
@@ -86,17 +95,20 @@ type Runnable interface {
 	Run(ctx context.Context, stop <-chan struct{}, done chan<- struct{}) error
 }
 
-func NewMonitor(log *logrus.Entry, dialer proxy.Dialer, dbGroup monitorDBs, m, clusterm metrics.Emitter, e env.Interface) Runnable {
+// NewMonitor returns a monitor which runs up to maxConcurrentRuns monitoring
+// passes at once, or any number if maxConcurrentRuns is 0
+func NewMonitor(log *logrus.Entry, dialer proxy.Dialer, dbGroup monitorDBs, m, clusterm metrics.Emitter, eventSink events.Sink, e env.Interface, maxConcurrentRuns int) Runnable {
 	mon := &monitor{
 		baseLog: log,
 		dialer:  dialer,
 
 		dbGroup: dbGroup,
 
-		m:        m,
-		clusterm: clusterm,
-		subs:     changefeed.NewSubscriptionsChangefeedCache(m, true),
-		env:      e,
+		m:         m,
+		clusterm:  clusterm,
+		eventSink: eventSink,
+		subs:      changefeed.NewSubscriptionsChangefeedCache(m, true),
+		env:       e,
 
 		bucketCount: bucket.Buckets,
 		workerCount: &atomic.Int32{},
@@ -107,6 +119,7 @@ func NewMonitor(log *logrus.Entry, dialer proxy.Dialer, dbGroup monitorDBs, m, c
 
 		clusterMonitorBuilder: cluster.NewMonitor,
 		nsgMonitorBuilder:     nsg.NewMonitor,
+		driftMonitorBuilder:   drift.NewMonitor,
 		hiveMonitorBuilder:    hivemon.NewHiveMonitor,
 
 		workerMaxStartupDelay:          defaultWorkerMaxStartupDelay,
@@ -119,6 +132,10 @@ func NewMonitor(log *logrus.Entry, dialer proxy.Dialer, dbGroup monitorDBs, m, c
 		readyDelay:                     defaultMonitorReadinessDelay,
 	}
 
+	if maxConcurrentRuns > 0 {
+		mon.runBudget = make(chan struct{}, maxConcurrentRuns)
+	}
+
 	mon.clusters = NewClusterChangefeedResponder(log, e.Now, mon.worker)
 	return mon
 }
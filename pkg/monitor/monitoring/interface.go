@@ -5,6 +5,9 @@ package monitoring
 
 import (
 	"context"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
 )
 
 // Monitor represents a consistent interface for different monitoring components
@@ -32,3 +35,21 @@ func (no *NoOpMonitor) MonitorName() string {
 type Closeable interface {
 	Close()
 }
+
+// HealthSnapshotter is implemented by monitors which summarise the health of
+// the cluster as found by their last call to Monitor.
+type HealthSnapshotter interface {
+	HealthSnapshot() *api.ClusterHealthSnapshot
+}
+
+// DriftReporter is implemented by monitors which report the drift of the
+// cluster's Azure resources found by their last call to Monitor.
+type DriftReporter interface {
+	DriftReport() *api.ClusterHealthDriftReport
+}
+
+// StateChangeReporter is implemented by monitors which report the changes to
+// the state of the cluster seen by their last call to Monitor.
+type StateChangeReporter interface {
+	StateChanges() []events.Event
+}
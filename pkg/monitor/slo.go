@@ -0,0 +1,72 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
+	"github.com/Azure/ARO-RP/pkg/monitor/slo"
+)
+
+// recordSLO records the service level indicators observed by m's last pass
+// and emits the cluster's availability and burn rates. Until it succeeds, it
+// first restores the observations saved by the cluster's previous owner.
+func (mon *monitor) recordSLO(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument, m monitoring.Monitor, history *healthSnapshotHistory, dims map[string]string) {
+	snapshotter, ok := m.(monitoring.HealthSnapshotter)
+	if !ok || history == nil {
+		return
+	}
+
+	if history.slo == nil {
+		history.slo = slo.NewTracker()
+	}
+
+	if !history.sloRestored {
+		history.sloRestored = mon.restoreSLO(ctx, log, doc, history.slo)
+	}
+
+	history.slo.Observe(snapshotter.HealthSnapshot())
+	history.slo.Emit(mon.clusterm, dims, mon.env.Now())
+}
+
+// restoreSLO adds the observations saved with the cluster's health snapshot
+// to tracker, returning whether there was no error
+func (mon *monitor) restoreSLO(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument, tracker *slo.Tracker) bool {
+	dbClusterHealthSnapshots, err := mon.dbGroup.ClusterHealthSnapshots()
+	if err != nil {
+		log.Error(err)
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, healthSnapshotSaveTimeout)
+	defer cancel()
+
+	saved, err := dbClusterHealthSnapshots.Get(ctx, doc.ID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		return true
+	case err != nil:
+		log.Errorf("failed to restore SLO state: %s", err)
+		return false
+	}
+
+	tracker.Restore(saved.SLOState, mon.env.Now())
+	return true
+}
+
+// handOffSLO saves the cluster's last health snapshot along with its latest
+// SLO state, for the monitor which takes over the cluster's bucket
+func (mon *monitor) handOffSLO(log *logrus.Entry, doc *api.OpenShiftClusterDocument, history *healthSnapshotHistory) {
+	if history == nil || history.saved == nil || history.slo == nil {
+		return
+	}
+
+	mon.putHealthSnapshot(context.Background(), log, doc, history.saved, history)
+}
@@ -0,0 +1,65 @@
+package slo
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"slices"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+)
+
+// Names of the cluster monitor collectors and synthetic probes whose results
+// in the health snapshot are the indicators' observations
+const (
+	apiServerHealthzCollector = "emitAPIServerHealthzCode"
+	syntheticProbesCollector  = "emitSyntheticProbes"
+
+	syntheticProbeConditionType = "SyntheticProbe"
+)
+
+// ingressProbes are the synthetic probes served by the default ingress
+// controller
+var ingressProbes = []string{"console", "canaryroute"}
+
+// Observe records the indicators observed by the monitoring pass which
+// produced snapshot. Indicators whose collectors did not run are not
+// recorded.
+func (t *Tracker) Observe(snapshot *api.ClusterHealthSnapshot) {
+	if snapshot == nil {
+		return
+	}
+
+	at := time.Unix(snapshot.CollectedAt, 0)
+
+	for _, r := range snapshot.Collectors {
+		// results kept from earlier passes were already observed
+		if r.CollectedAt < snapshot.CollectedAt {
+			continue
+		}
+
+		switch r.Name {
+		case apiServerHealthzCollector:
+			t.Record(IndicatorAPIServer, at, r.Error == "")
+
+		case syntheticProbesCollector:
+			var probed, failed bool
+			for _, c := range r.Conditions {
+				if c.Type != syntheticProbeConditionType {
+					continue
+				}
+				probed = true
+				if slices.Contains(ingressProbes, c.Name) {
+					failed = true
+				}
+			}
+
+			// the collector fails without probing if, e.g., the cluster has
+			// no default ingress IP
+			if r.Error == "" || probed {
+				t.Record(IndicatorIngress, at, !failed)
+			}
+		}
+	}
+}
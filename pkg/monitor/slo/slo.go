@@ -0,0 +1,314 @@
+package slo
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/monitor/emitter"
+)
+
+const (
+	MetricAvailability = "monitor.slo.availability"
+	MetricBurnRate     = "monitor.slo.burnrate"
+)
+
+// Service level indicators
+const (
+	// IndicatorAPIServer is whether the API server's healthz endpoint
+	// answers
+	IndicatorAPIServer = "apiserver"
+	// IndicatorIngress is whether the console and the ingress canary route
+	// answer through the default ingress controller
+	IndicatorIngress = "ingress"
+)
+
+// Objectives are the target availability of each indicator
+var Objectives = map[string]float64{
+	IndicatorAPIServer: 0.999,
+	IndicatorIngress:   0.999,
+}
+
+// Window is a rolling window over which availability is computed
+type Window struct {
+	Name   string
+	Length time.Duration
+}
+
+// Windows are the windows over which availability and burn rates are
+// emitted, for alerting on a short and a long window together
+var Windows = []Window{
+	{Name: "1h", Length: time.Hour},
+	{Name: "6h", Length: 6 * time.Hour},
+	{Name: "30d", Length: 30 * 24 * time.Hour},
+}
+
+// Observations are counted in fine buckets, for the 1h and 6h windows, and in
+// coarse buckets, for the 30d window, so that the state of a cluster stays
+// small enough to save every few minutes
+const (
+	fineResolution   = 5 * time.Minute
+	fineRetention    = 6 * time.Hour
+	coarseResolution = time.Hour
+	coarseRetention  = 30 * 24 * time.Hour
+)
+
+// Observations are weighted by the time since the previous observation, as
+// the monitor observes clusters which need attention more often than healthy
+// ones. The first observation is weighted as a pass at the default monitoring
+// interval, and gaps longer than maxObservationWeight, e.g. while the cluster
+// was not monitored, are not attributed to the observation which ends them.
+const (
+	defaultObservationWeight = time.Minute
+	maxObservationWeight     = 5 * time.Minute
+)
+
+// Tracker keeps rolling availability windows of a cluster's service level
+// indicators. The cluster's monitoring worker keeps it across passes.
+type Tracker struct {
+	mu         sync.Mutex
+	indicators map[string]*indicator
+}
+
+type indicator struct {
+	last   time.Time
+	fine   series
+	coarse series
+}
+
+// series are buckets of a fixed resolution, ordered by start
+type series struct {
+	resolution time.Duration
+	retention  time.Duration
+	buckets    []api.ClusterSLOBucket
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		indicators: map[string]*indicator{},
+	}
+}
+
+func newIndicator() *indicator {
+	return &indicator{
+		fine:   series{resolution: fineResolution, retention: fineRetention},
+		coarse: series{resolution: coarseResolution, retention: coarseRetention},
+	}
+}
+
+// Record records an observation of the indicator called `name` at `at`,
+// weighted by the time since its previous observation
+func (t *Tracker) Record(name string, at time.Time, good bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	i := t.indicator(name)
+
+	weight := defaultObservationWeight
+	if !i.last.IsZero() && at.After(i.last) {
+		weight = min(at.Sub(i.last), maxObservationWeight)
+	}
+
+	var g int64
+	if good {
+		g = int64(weight / time.Second)
+	}
+	i.add(at, g, int64(weight/time.Second))
+
+	if at.After(i.last) {
+		i.last = at
+	}
+}
+
+// Restore adds the observations in state, saved by the previous owner of the
+// cluster, to those already recorded
+func (t *Tracker) Restore(state *api.ClusterSLOState, now time.Time) {
+	if state == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, in := range state.Indicators {
+		i := t.indicator(in.Name)
+		if last := time.Unix(in.LastObservedAt, 0); in.LastObservedAt != 0 && last.After(i.last) {
+			i.last = last
+		}
+		for _, b := range in.FineBuckets {
+			i.fine.add(time.Unix(b.Start, 0), b.Good, b.Total)
+		}
+		for _, b := range in.CoarseBuckets {
+			i.coarse.add(time.Unix(b.Start, 0), b.Good, b.Total)
+		}
+		i.prune(now)
+	}
+}
+
+// State returns the observations recorded, to be saved
+func (t *Tracker) State() *api.ClusterSLOState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	state := &api.ClusterSLOState{}
+	for name, i := range t.indicators {
+		indicator := api.ClusterSLOIndicator{
+			Name:          name,
+			FineBuckets:   slices.Clone(i.fine.buckets),
+			CoarseBuckets: slices.Clone(i.coarse.buckets),
+		}
+		if !i.last.IsZero() {
+			indicator.LastObservedAt = i.last.Unix()
+		}
+		state.Indicators = append(state.Indicators, indicator)
+	}
+	slices.SortFunc(state.Indicators, func(a, b api.ClusterSLOIndicator) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return state
+}
+
+// Availability returns the weighted fraction of good observations of the
+// indicator called `name` within `window` of `now`, to the resolution of its
+// buckets, and whether there were any observations
+func (t *Tracker) Availability(name string, window time.Duration, now time.Time) (float64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	i, ok := t.indicators[name]
+	if !ok {
+		return 0, false
+	}
+
+	i.prune(now)
+
+	s := &i.coarse
+	if window <= i.fine.retention {
+		s = &i.fine
+	}
+
+	good, total := s.sum(window, now)
+	if total == 0 {
+		return 0, false
+	}
+
+	return float64(good) / float64(total), true
+}
+
+// BurnRate returns how many times faster than its objective allows the
+// indicator's error budget is being spent at the given availability
+func BurnRate(name string, availability float64) float64 {
+	objective, ok := Objectives[name]
+	if !ok || objective >= 1 {
+		return 0
+	}
+
+	return (1 - availability) / (1 - objective)
+}
+
+// Emit emits the availability and burn rate of each indicator over each
+// window in which it was observed
+func (t *Tracker) Emit(m metrics.Emitter, dims map[string]string, now time.Time) {
+	t.mu.Lock()
+	names := make([]string, 0, len(t.indicators))
+	for name := range t.indicators {
+		names = append(names, name)
+	}
+	t.mu.Unlock()
+	slices.Sort(names)
+
+	for _, name := range names {
+		for _, w := range Windows {
+			availability, ok := t.Availability(name, w.Length, now)
+			if !ok {
+				continue
+			}
+
+			sloDims := map[string]string{
+				"sli":    name,
+				"window": w.Name,
+			}
+			emitter.EmitFloat(m, MetricAvailability, availability, dims, sloDims)
+			emitter.EmitFloat(m, MetricBurnRate, BurnRate(name, availability), dims, sloDims)
+		}
+	}
+}
+
+// indicator returns the indicator called `name`, creating it if needed. The
+// caller must hold t.mu.
+func (t *Tracker) indicator(name string) *indicator {
+	i, ok := t.indicators[name]
+	if !ok {
+		i = newIndicator()
+		t.indicators[name] = i
+	}
+	return i
+}
+
+func (i *indicator) add(at time.Time, good, total int64) {
+	i.fine.add(at, good, total)
+	i.coarse.add(at, good, total)
+	i.prune(at)
+}
+
+func (i *indicator) prune(now time.Time) {
+	i.fine.prune(now)
+	i.coarse.prune(now)
+}
+
+// add counts observations in the bucket containing `at`
+func (s *series) add(at time.Time, good, total int64) {
+	start := at.Truncate(s.resolution).Unix()
+
+	// observations normally arrive in order
+	idx, found := len(s.buckets)-1, false
+	if idx >= 0 && s.buckets[idx].Start == start {
+		found = true
+	} else {
+		idx, found = slices.BinarySearchFunc(s.buckets, start, func(b api.ClusterSLOBucket, start int64) int {
+			return cmp.Compare(b.Start, start)
+		})
+	}
+
+	if !found {
+		s.buckets = slices.Insert(s.buckets, idx, api.ClusterSLOBucket{Start: start})
+	}
+
+	s.buckets[idx].Good += good
+	s.buckets[idx].Total += total
+}
+
+// prune drops the buckets which end outside the retention of s
+func (s *series) prune(now time.Time) {
+	cutoff := now.Add(-s.retention).Unix()
+	resolution := int64(s.resolution / time.Second)
+
+	idx := 0
+	for idx < len(s.buckets) && s.buckets[idx].Start+resolution <= cutoff {
+		idx++
+	}
+	s.buckets = slices.Delete(s.buckets, 0, idx)
+}
+
+// sum returns the observations in the buckets which overlap `window` of `now`
+func (s *series) sum(window time.Duration, now time.Time) (good, total int64) {
+	cutoff := now.Add(-window).Unix()
+	resolution := int64(s.resolution / time.Second)
+
+	for _, b := range s.buckets {
+		if b.Start+resolution <= cutoff || b.Start > now.Unix() {
+			continue
+		}
+		good += b.Good
+		total += b.Total
+	}
+
+	return good, total
+}
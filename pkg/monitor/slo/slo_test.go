@@ -0,0 +1,295 @@
+package slo
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+	"go.uber.org/mock/gomock"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/pointerutils"
+)
+
+func TestAvailability(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	tracker := NewTracker()
+
+	// a day of observations every minute, failing for the last half hour
+	for i := range 24 * 60 {
+		at := start.Add(time.Duration(i) * time.Minute)
+		tracker.Record(IndicatorAPIServer, at, i < 24*60-30)
+	}
+	// windows are counted to the resolution of their buckets, so are exact
+	// on a bucket boundary
+	now := start.Add(24 * time.Hour)
+
+	for _, tt := range []struct {
+		window time.Duration
+		want   float64
+	}{
+		{window: time.Hour, want: 30.0 / 60},
+		{window: 6 * time.Hour, want: (6*60 - 30.0) / (6 * 60)},
+		{window: 30 * 24 * time.Hour, want: (24*60 - 30.0) / (24 * 60)},
+	} {
+		t.Run(tt.window.String(), func(t *testing.T) {
+			got, ok := tracker.Availability(IndicatorAPIServer, tt.window, now)
+			if !ok {
+				t.Fatal("no observations")
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("got %f, wanted %f", got, tt.want)
+			}
+		})
+	}
+
+	_, ok := tracker.Availability(IndicatorIngress, time.Hour, now)
+	if ok {
+		t.Error("unexpected observations of an unrecorded indicator")
+	}
+
+	// observations older than the window are pruned
+	_, ok = tracker.Availability(IndicatorAPIServer, time.Hour, now.Add(2*time.Hour))
+	if ok {
+		t.Error("unexpected observations after the window passed")
+	}
+}
+
+func TestAvailabilityWeighting(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tracker := NewTracker()
+	tracker.Record(IndicatorAPIServer, start, true)
+
+	// the cluster fails for half an hour, observed every 30 seconds, then is
+	// healthy for half an hour, observed every 2 minutes
+	at := start
+	for range 60 {
+		at = at.Add(30 * time.Second)
+		tracker.Record(IndicatorAPIServer, at, false)
+	}
+	for range 15 {
+		at = at.Add(2 * time.Minute)
+		tracker.Record(IndicatorAPIServer, at, true)
+	}
+
+	// counting passes would give 16/76
+	want := (60 + 30*60.0) / (60 + 60*60)
+
+	got, ok := tracker.Availability(IndicatorAPIServer, time.Hour, at)
+	if !ok {
+		t.Fatal("no observations")
+	}
+	if math.Abs(got-want) > 1e-9 {
+		t.Errorf("got %f, wanted %f", got, want)
+	}
+}
+
+func TestBurnRate(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		indicator    string
+		availability float64
+		want         float64
+	}{
+		{name: "meeting objective exactly", indicator: IndicatorAPIServer, availability: 0.999, want: 1},
+		{name: "fully available", indicator: IndicatorAPIServer, availability: 1, want: 0},
+		{name: "fast burn", indicator: IndicatorAPIServer, availability: 0.9856, want: 14.4},
+		{name: "unknown indicator", indicator: "unknown", availability: 0.5, want: 0},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BurnRate(tt.indicator, tt.availability)
+			if math.Abs(got-tt.want) > 1e-6 {
+				t.Errorf("got %f, wanted %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStateAndRestore(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	previous := NewTracker()
+	previous.Record(IndicatorIngress, now.Add(-2*time.Hour), false)
+	previous.Record(IndicatorAPIServer, now.Add(-2*time.Hour), true)
+	previous.Record(IndicatorAPIServer, now.Add(-10*time.Minute), false)
+
+	state := previous.State()
+	for _, diff := range deep.Equal(state, &api.ClusterSLOState{
+		Indicators: []api.ClusterSLOIndicator{
+			{
+				Name:           IndicatorAPIServer,
+				LastObservedAt: now.Add(-10 * time.Minute).Unix(),
+				// the second observation ends a gap longer than the maximum
+				// weight
+				FineBuckets: []api.ClusterSLOBucket{
+					{Start: now.Add(-2 * time.Hour).Unix(), Good: 60, Total: 60},
+					{Start: now.Add(-10 * time.Minute).Unix(), Total: 300},
+				},
+				CoarseBuckets: []api.ClusterSLOBucket{
+					{Start: now.Add(-2 * time.Hour).Unix(), Good: 60, Total: 60},
+					{Start: now.Add(-time.Hour).Unix(), Total: 300},
+				},
+			},
+			{
+				Name:           IndicatorIngress,
+				LastObservedAt: now.Add(-2 * time.Hour).Unix(),
+				FineBuckets: []api.ClusterSLOBucket{
+					{Start: now.Add(-2 * time.Hour).Unix(), Total: 60},
+				},
+				CoarseBuckets: []api.ClusterSLOBucket{
+					{Start: now.Add(-2 * time.Hour).Unix(), Total: 60},
+				},
+			},
+		},
+	}) {
+		t.Error(diff)
+	}
+
+	// the new owner has already observed the cluster once
+	tracker := NewTracker()
+	tracker.Record(IndicatorAPIServer, now, true)
+	tracker.Restore(state, now)
+
+	got, ok := tracker.Availability(IndicatorAPIServer, 6*time.Hour, now)
+	if !ok {
+		t.Fatal("no observations")
+	}
+	if math.Abs(got-2.0/7) > 1e-9 {
+		t.Errorf("got %f, wanted %f", got, 2.0/7)
+	}
+
+	// the next observation is weighted by the time since the latest one,
+	// whichever owner made it
+	tracker.Record(IndicatorAPIServer, now.Add(time.Minute), true)
+
+	got, ok = tracker.Availability(IndicatorAPIServer, 6*time.Hour, now.Add(time.Minute))
+	if !ok {
+		t.Fatal("no observations")
+	}
+	if math.Abs(got-3.0/8) > 1e-9 {
+		t.Errorf("got %f, wanted %f", got, 3.0/8)
+	}
+
+	// restoring nil state is a no-op
+	tracker.Restore(nil, now)
+}
+
+func TestObserve(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		name          string
+		collectors    []api.ClusterHealthCollectorResult
+		wantAPIServer *float64
+		wantIngress   *float64
+	}{
+		{
+			name: "healthy",
+			collectors: []api.ClusterHealthCollectorResult{
+				{Name: "emitAPIServerHealthzCode", CollectedAt: now.Unix()},
+				{Name: "emitSyntheticProbes", CollectedAt: now.Unix()},
+			},
+			wantAPIServer: pointerutils.ToPtr(1.0),
+			wantIngress:   pointerutils.ToPtr(1.0),
+		},
+		{
+			name: "API server and ingress failing",
+			collectors: []api.ClusterHealthCollectorResult{
+				{Name: "emitAPIServerHealthzCode", CollectedAt: now.Unix(), Error: "error fetching APIServer healthz endpoint"},
+				{
+					Name:        "emitSyntheticProbes",
+					CollectedAt: now.Unix(),
+					Error:       "canaryroute probe: unexpected status code 503",
+					Conditions: []api.ClusterHealthCondition{
+						{Name: "canaryroute", Type: "SyntheticProbe", Status: "False"},
+					},
+				},
+			},
+			wantAPIServer: pointerutils.ToPtr(0.0),
+			wantIngress:   pointerutils.ToPtr(0.0),
+		},
+		{
+			name: "only the OAuth probe failing",
+			collectors: []api.ClusterHealthCollectorResult{
+				{
+					Name:        "emitSyntheticProbes",
+					CollectedAt: now.Unix(),
+					Error:       "oauth probe: discovery: unexpected status code 500",
+					Conditions: []api.ClusterHealthCondition{
+						{Name: "oauth", Type: "SyntheticProbe", Status: "False"},
+					},
+				},
+			},
+			wantIngress: pointerutils.ToPtr(1.0),
+		},
+		{
+			name: "probes not run",
+			collectors: []api.ClusterHealthCollectorResult{
+				{Name: "emitSyntheticProbes", CollectedAt: now.Unix(), Error: "default ingress profile has no IP"},
+			},
+		},
+		{
+			name: "result from an earlier pass",
+			collectors: []api.ClusterHealthCollectorResult{
+				{Name: "emitAPIServerHealthzCode", CollectedAt: now.Add(-time.Minute).Unix()},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewTracker()
+			tracker.Observe(&api.ClusterHealthSnapshot{
+				CollectedAt: now.Unix(),
+				Collectors:  tt.collectors,
+			})
+
+			for indicator, want := range map[string]*float64{
+				IndicatorAPIServer: tt.wantAPIServer,
+				IndicatorIngress:   tt.wantIngress,
+			} {
+				got, ok := tracker.Availability(indicator, time.Hour, now)
+				if want == nil {
+					if ok {
+						t.Errorf("%s: unexpected observation", indicator)
+					}
+					continue
+				}
+				if !ok || got != *want {
+					t.Errorf("%s: got %f (%t), wanted %f", indicator, got, ok, *want)
+				}
+			}
+		})
+	}
+}
+
+func TestEmit(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tracker := NewTracker()
+	tracker.Record(IndicatorAPIServer, now.Add(-3*time.Hour), false)
+	tracker.Record(IndicatorAPIServer, now, true)
+
+	controller := gomock.NewController(t)
+	m := mock_metrics.NewMockEmitter(controller)
+
+	dims := map[string]string{"resourceId": "cluster"}
+	for _, tt := range []struct {
+		window       string
+		availability float64
+	}{
+		{window: "1h", availability: 1},
+		{window: "6h", availability: 5.0 / 6},
+		{window: "30d", availability: 5.0 / 6},
+	} {
+		wantDims := map[string]string{"resourceId": "cluster", "sli": IndicatorAPIServer, "window": tt.window}
+		m.EXPECT().EmitFloat(MetricAvailability, tt.availability, wantDims)
+		m.EXPECT().EmitFloat(MetricBurnRate, BurnRate(IndicatorAPIServer, tt.availability), wantDims)
+	}
+
+	tracker.Emit(m, dims, now)
+}
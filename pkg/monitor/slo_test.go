@@ -0,0 +1,90 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/monitor/slo"
+	testdatabase "github.com/Azure/ARO-RP/test/database"
+)
+
+func TestSLOHandOff(t *testing.T) {
+	env := SetupTestEnvironment(t)
+	defer env.Cleanup()
+
+	mon := env.CreateTestMonitor("slo")
+	log := env.TestLogger
+
+	doc := &api.OpenShiftClusterDocument{
+		ID: "00000000-0000-0000-0000-000000000001",
+		OpenShiftCluster: &api.OpenShiftCluster{
+			ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster",
+		},
+	}
+
+	now := time.Now()
+
+	// the previous owner of the cluster saw its API server fail
+	previous := slo.NewTracker()
+	previous.Record(slo.IndicatorAPIServer, now.Add(-time.Minute), false)
+
+	fixture := testdatabase.NewFixture().WithClusterHealthSnapshots(env.ClusterHealthSnapshotDB)
+	fixture.AddClusterHealthSnapshotDocuments(&api.ClusterHealthSnapshotDocument{
+		ID:                doc.ID,
+		ClusterResourceID: doc.OpenShiftCluster.ID,
+		SLOState:          previous.State(),
+	})
+	err := fixture.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	snapshot := &api.ClusterHealthSnapshot{
+		CollectedAt: now.Unix(),
+		Collectors: []api.ClusterHealthCollectorResult{
+			{Name: "emitAPIServerHealthzCode", CollectedAt: now.Unix()},
+		},
+	}
+	m := &fakeSnapshotMonitor{snapshot: snapshot}
+	history := &healthSnapshotHistory{}
+
+	// the new owner restores the previous owner's observations
+	mon.recordSLO(t.Context(), log, doc, m, history, nil)
+	if !history.sloRestored {
+		t.Fatal("SLO state not restored")
+	}
+
+	availability, ok := history.slo.Availability(slo.IndicatorAPIServer, 6*time.Hour, now)
+	if !ok || availability != 0.5 {
+		t.Errorf("got availability %f (%t), wanted 0.5", availability, ok)
+	}
+
+	// the SLO state is saved with the health snapshot
+	want := func() {
+		t.Helper()
+
+		checker := testdatabase.NewChecker()
+		checker.AddClusterHealthSnapshotDocuments(&api.ClusterHealthSnapshotDocument{
+			ID:                    doc.ID,
+			ClusterResourceID:     doc.OpenShiftCluster.ID,
+			ClusterHealthSnapshot: *snapshot,
+			SLOState:              history.slo.State(),
+		})
+		for _, err := range checker.CheckClusterHealthSnapshots(env.ClusterHealthSnapshotClient) {
+			t.Error(err)
+		}
+	}
+
+	mon.saveHealthSnapshot(t.Context(), log, doc, m, history)
+	want()
+
+	// the latest SLO state is saved when the cluster's bucket is handed off,
+	// even though its health has not changed
+	history.slo.Record(slo.IndicatorAPIServer, now.Add(time.Minute), true)
+	mon.handOffSLO(log, doc, history)
+	want()
+}
@@ -0,0 +1,48 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
+)
+
+// stateChangesSendTimeout bounds how long sending a cluster's state changes
+// may take
+const stateChangesSendTimeout = 10 * time.Second
+
+// sendStateChanges sends the changes to the state of the cluster seen by m, if
+// any, to the event sink. Only the monitor which owns the cluster's bucket
+// runs its worker, so each change is sent by a single monitor.
+func (mon *monitor) sendStateChanges(ctx context.Context, log *logrus.Entry, m monitoring.Monitor) {
+	if mon.eventSink == nil {
+		return
+	}
+
+	reporter, ok := m.(monitoring.StateChangeReporter)
+	if !ok {
+		return
+	}
+
+	changes := reporter.StateChanges()
+	if len(changes) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, stateChangesSendTimeout)
+	defer cancel()
+
+	err := mon.eventSink.Send(ctx, changes)
+	if err != nil {
+		log.Errorf("failed to send state changes: %s", err)
+		mon.m.EmitGauge("monitor.events.failed", 1, nil)
+		return
+	}
+
+	mon.m.EmitGauge("monitor.events.sent", int64(len(changes)), nil)
+}
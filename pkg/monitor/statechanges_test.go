@@ -0,0 +1,89 @@
+package monitor
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/Azure/ARO-RP/pkg/monitor/events"
+)
+
+type fakeStateChangeMonitor struct {
+	changes []events.Event
+}
+
+func (m *fakeStateChangeMonitor) Monitor(context.Context) error { return nil }
+
+func (m *fakeStateChangeMonitor) MonitorName() string { return "fakestatechangemonitor" }
+
+func (m *fakeStateChangeMonitor) StateChanges() []events.Event { return m.changes }
+
+type fakeSink struct {
+	sent []events.Event
+	err  error
+}
+
+func (s *fakeSink) Send(ctx context.Context, events []events.Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, events...)
+	return nil
+}
+
+func TestSendStateChanges(t *testing.T) {
+	env := SetupTestEnvironment(t)
+	defer env.Cleanup()
+
+	changes := []events.Event{
+		{
+			Time:              time.Unix(1000, 0),
+			ClusterResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster",
+			Kind:              events.KindNode,
+			Name:              "worker-0",
+			Type:              "Ready",
+			Previous:          "True",
+			Current:           "False",
+		},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		sink     *fakeSink
+		changes  []events.Event
+		wantSent []events.Event
+	}{
+		{
+			name:     "changes are sent",
+			sink:     &fakeSink{},
+			changes:  changes,
+			wantSent: changes,
+		},
+		{
+			name: "nothing is sent without changes",
+			sink: &fakeSink{},
+		},
+		{
+			name:    "sink errors are not fatal",
+			sink:    &fakeSink{err: errors.New("unavailable")},
+			changes: changes,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			mon := env.CreateTestMonitor("statechanges")
+			mon.eventSink = tt.sink
+
+			mon.sendStateChanges(t.Context(), env.TestLogger, &fakeStateChangeMonitor{changes: tt.changes})
+
+			for _, l := range deep.Equal(tt.sink.sent, tt.wantSent) {
+				t.Error(l)
+			}
+		})
+	}
+}
@@ -24,7 +24,9 @@ import (
 	"github.com/Azure/ARO-RP/pkg/hive"
 	"github.com/Azure/ARO-RP/pkg/metrics"
 	"github.com/Azure/ARO-RP/pkg/metrics/noop"
+	"github.com/Azure/ARO-RP/pkg/monitor/cluster"
 	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
+	"github.com/Azure/ARO-RP/pkg/proxy"
 	mock_env "github.com/Azure/ARO-RP/pkg/util/mocks/env"
 	mock_proxy "github.com/Azure/ARO-RP/pkg/util/mocks/proxy"
 	testdatabase "github.com/Azure/ARO-RP/test/database"
@@ -36,19 +38,21 @@ var fakeClusterVisitMonitoringAttempts = xsync.NewMap[string, *atomic.Int64]()
 
 // TestEnvironment contains all the test setup components
 type TestEnvironment struct {
-	OpenShiftClusterDB      database.OpenShiftClusters
-	SubscriptionsDB         database.Subscriptions
-	PoolWorkersDB           database.PoolWorkers
-	OpenShiftClusterClient  *cosmosdb.FakeOpenShiftClusterDocumentClient
-	SubscriptionsClient     *cosmosdb.FakeSubscriptionDocumentClient
-	FakePoolWorkersDBClient *cosmosdb.FakePoolWorkerDocumentClient
-	Controller              *gomock.Controller
-	TestLogger              *logrus.Entry
-	Dialer                  *mock_proxy.MockDialer
-	MockEnv                 *mock_env.MockInterface
-	NoopMetricsEmitter      noop.Noop
-	NoopClusterMetrics      noop.Noop
-	DBGroup                 monitorDBs
+	OpenShiftClusterDB          database.OpenShiftClusters
+	SubscriptionsDB             database.Subscriptions
+	PoolWorkersDB               database.PoolWorkers
+	ClusterHealthSnapshotDB     database.ClusterHealthSnapshots
+	OpenShiftClusterClient      *cosmosdb.FakeOpenShiftClusterDocumentClient
+	SubscriptionsClient         *cosmosdb.FakeSubscriptionDocumentClient
+	FakePoolWorkersDBClient     *cosmosdb.FakePoolWorkerDocumentClient
+	ClusterHealthSnapshotClient *cosmosdb.FakeClusterHealthSnapshotDocumentClient
+	Controller                  *gomock.Controller
+	TestLogger                  *logrus.Entry
+	Dialer                      *mock_proxy.MockDialer
+	MockEnv                     *mock_env.MockInterface
+	NoopMetricsEmitter          noop.Noop
+	NoopClusterMetrics          noop.Noop
+	DBGroup                     monitorDBs
 }
 
 // SetupTestEnvironment creates a common test environment for monitor tests
@@ -57,6 +61,7 @@ func SetupTestEnvironment(t *testing.T) *TestEnvironment {
 	openShiftClusterDB, openShiftClusterClient := testdatabase.NewFakeOpenShiftClusters()
 	subscriptionsDB, subscriptionsClient := testdatabase.NewFakeSubscriptions()
 	poolWorkersDB, fakePoolMonitorsDBClient := testdatabase.NewFakePoolWorkers(time.Now, uuid.DefaultGenerator.Generate())
+	clusterHealthSnapshotDB, clusterHealthSnapshotClient := testdatabase.NewFakeClusterHealthSnapshots()
 
 	// Create mocks
 	ctrl := gomock.NewController(t)
@@ -75,26 +80,29 @@ func SetupTestEnvironment(t *testing.T) *TestEnvironment {
 	dbs := database.NewDBGroup().
 		WithPoolWorkers(poolWorkersDB).
 		WithOpenShiftClusters(openShiftClusterDB).
-		WithSubscriptions(subscriptionsDB)
+		WithSubscriptions(subscriptionsDB).
+		WithClusterHealthSnapshots(clusterHealthSnapshotDB)
 
 	// Initialize database fixtures
 	f := testdatabase.NewFixture().WithOpenShiftClusters(openShiftClusterDB)
 	f.Create()
 
 	return &TestEnvironment{
-		OpenShiftClusterDB:      openShiftClusterDB,
-		SubscriptionsDB:         subscriptionsDB,
-		PoolWorkersDB:           poolWorkersDB,
-		OpenShiftClusterClient:  openShiftClusterClient,
-		SubscriptionsClient:     subscriptionsClient,
-		FakePoolWorkersDBClient: fakePoolMonitorsDBClient,
-		Controller:              ctrl,
-		TestLogger:              testlogger,
-		Dialer:                  dialer,
-		MockEnv:                 mockEnv,
-		NoopMetricsEmitter:      noopMetricsEmitter,
-		NoopClusterMetrics:      noopClusterMetricsEmitter,
-		DBGroup:                 dbs,
+		OpenShiftClusterDB:          openShiftClusterDB,
+		SubscriptionsDB:             subscriptionsDB,
+		PoolWorkersDB:               poolWorkersDB,
+		ClusterHealthSnapshotDB:     clusterHealthSnapshotDB,
+		OpenShiftClusterClient:      openShiftClusterClient,
+		SubscriptionsClient:         subscriptionsClient,
+		FakePoolWorkersDBClient:     fakePoolMonitorsDBClient,
+		ClusterHealthSnapshotClient: clusterHealthSnapshotClient,
+		Controller:                  ctrl,
+		TestLogger:                  testlogger,
+		Dialer:                      dialer,
+		MockEnv:                     mockEnv,
+		NoopMetricsEmitter:          noopMetricsEmitter,
+		NoopClusterMetrics:          noopClusterMetricsEmitter,
+		DBGroup:                     dbs,
 	}
 }
 
@@ -103,7 +111,8 @@ func (env *TestEnvironment) CreateTestMonitor(loggerField string) *monitor {
 	uniquePoolWorkersDB := testdatabase.NewFakePoolWorkersWithExistingClient(env.FakePoolWorkersDBClient)
 	nDBs := database.NewDBGroup().WithPoolWorkers(uniquePoolWorkersDB).
 		WithOpenShiftClusters(env.OpenShiftClusterDB).
-		WithSubscriptions(env.SubscriptionsDB)
+		WithSubscriptions(env.SubscriptionsDB).
+		WithClusterHealthSnapshots(env.ClusterHealthSnapshotDB)
 
 	mon := NewMonitor(
 		env.TestLogger.WithField("test", loggerField),
@@ -111,11 +120,14 @@ func (env *TestEnvironment) CreateTestMonitor(loggerField string) *monitor {
 		nDBs,
 		&env.NoopMetricsEmitter,
 		&env.NoopClusterMetrics,
+		nil,
 		env.MockEnv,
+		0,
 	).(*monitor)
 
 	// Apply test-specific configurations
 	mon.nsgMonitorBuilder = fakeNsgMonitoringBuilder
+	mon.driftMonitorBuilder = fakeDriftMonitoringBuilder
 	mon.hiveMonitorBuilder = fakeHiveMonitoringBuilder
 	mon.clusterMonitorBuilder = fakeClusterMonitorBuilder
 	mon.workerMaxStartupDelay = 0
@@ -133,7 +145,7 @@ func (env *TestEnvironment) Cleanup() {
 }
 
 // Fake monitoring builders for testing
-func fakeClusterMonitorBuilder(log *logrus.Entry, restConfig *rest.Config, oc *api.OpenShiftCluster, env env.Interface, tenantID string, m metrics.Emitter, hourlyRun bool) (monitoring.Monitor, error) {
+func fakeClusterMonitorBuilder(log *logrus.Entry, restConfig *rest.Config, dialer proxy.Dialer, oc *api.OpenShiftCluster, env env.Interface, tenantID string, m metrics.Emitter, hourlyRun bool, schedule *cluster.CollectorSchedule) (monitoring.Monitor, error) {
 	counter, ok := fakeClusterVisitMonitoringAttempts.Load(oc.ID)
 	if !ok {
 		return nil, fmt.Errorf("didn't find counter for %s", oc.ID)
@@ -153,6 +165,10 @@ func fakeNsgMonitoringBuilder(log *logrus.Entry, oc *api.OpenShiftCluster, e env
 	return &monitoring.NoOpMonitor{}
 }
 
+func fakeDriftMonitoringBuilder(log *logrus.Entry, oc *api.OpenShiftCluster, e env.Interface, subscriptionID, tenantID string, emitter metrics.Emitter, dims map[string]string, trigger <-chan time.Time) monitoring.Monitor {
+	return &monitoring.NoOpMonitor{}
+}
+
 type fakeMonitor struct {
 	timeout        time.Duration
 	clusterCounter *atomic.Int64
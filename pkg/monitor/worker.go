@@ -17,6 +17,7 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/monitor/cluster"
 	"github.com/Azure/ARO-RP/pkg/monitor/dimension"
 	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
 	"github.com/Azure/ARO-RP/pkg/util/buckets"
@@ -29,6 +30,10 @@ import (
 // nsgMonitoringFrequency is used for initializing NSG monitoring ticker
 var nsgMonitoringFrequency = 10 * time.Minute
 
+// driftMonitoringFrequency is used for initializing the managed resource group
+// drift monitoring ticker
+var driftMonitoringFrequency = 30 * time.Minute
+
 // subscriptionStateLogFrequency is used for initializing a ticker used to
 // send log messages when a cluster's subscription state is stopping us
 // from monitoring
@@ -165,15 +170,23 @@ func (mon *monitor) worker(stop <-chan struct{}, id string) {
 
 	nsgMonitoringTicker := time.NewTicker(nsgMonitoringFrequency)
 	defer nsgMonitoringTicker.Stop()
+	driftMonitoringTicker := time.NewTicker(driftMonitoringFrequency)
+	defer driftMonitoringTicker.Stop()
+	collectorSchedule := cluster.NewCollectorSchedule()
+	healthSnapshots := &healthSnapshotHistory{}
 	subscriptionStateLoggingTicker := time.NewTicker(subscriptionStateLogFrequency)
 	defer subscriptionStateLoggingTicker.Stop()
-	t := time.NewTicker(mon.interval)
+	clusterCadence := &cadence{}
+	t := time.NewTimer(mon.interval)
 	defer t.Stop()
 
 	h := mon.env.Now().Hour()
 
 out:
 	for {
+		start := time.Now()
+		next := mon.interval
+
 		func() {
 			mon.workerCount.Add(1)
 			mon.m.EmitGauge("monitor.workers.active.count", int64(mon.workerCount.Load()), nil)
@@ -200,15 +213,27 @@ out:
 				return
 			}
 
+			if !mon.acquireRun(stop) {
+				return
+			}
+			releaseRun := sync.OnceFunc(mon.releaseRun)
+			defer releaseRun()
+
 			newh := mon.env.Now().Hour()
 
-			// TODO: later can modify here to poll once per N minutes and re-issue
-			// cached metrics in the remaining minutes
-			mon.workOne(context.Background(), log, doc, subID, sub.TenantID, newh != h, nsgMonitoringTicker)
+			snapshot := mon.workOne(context.Background(), log, doc, subID, sub.TenantID, newh != h, collectorSchedule, healthSnapshots, nsgMonitoringTicker, driftMonitoringTicker, releaseRun)
+
+			var c string
+			c, next = clusterCadence.next(doc, snapshot, mon.interval)
+			log.Debugf("monitoring again in %s at %s cadence", next, c)
+			mon.m.EmitGauge("monitor.cadence", 1, map[string]string{"cadence": c})
 
 			h = newh
 		}()
 
+		// passes start every `next`, unless a pass overruns
+		t.Reset(max(next-time.Since(start), 0))
+
 		select {
 		case <-t.C:
 		case <-stop:
@@ -216,18 +241,26 @@ out:
 		}
 	}
 
+	// if the cluster still exists, its bucket has been handed to another
+	// monitor
+	if doc, ok := mon.clusters.GetCluster(id); ok {
+		mon.handOffSLO(log, doc, healthSnapshots)
+	}
+
 	log.Debug("stopping monitoring")
 }
 
-// workOne checks the API server health of a cluster
-func (mon *monitor) workOne(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument, subID string, tenantID string, hourlyRun bool, nsgMonTicker *time.Ticker) {
+// workOne checks the API server health of a cluster, returning the cluster
+// monitor's health snapshot, or nil if the pass did not finish. It calls
+// releaseRun once the monitors have finished, before saving the results.
+func (mon *monitor) workOne(ctx context.Context, log *logrus.Entry, doc *api.OpenShiftClusterDocument, subID string, tenantID string, hourlyRun bool, collectorSchedule *cluster.CollectorSchedule, healthSnapshots *healthSnapshotHistory, nsgMonTicker, driftMonTicker *time.Ticker, releaseRun func()) *api.ClusterHealthSnapshot {
 	monitorCtx, cancel := context.WithTimeout(ctx, 50*time.Second)
 	defer cancel()
 
 	restConfig, err := restconfig.RestConfig(mon.dialer, doc.OpenShiftCluster)
 	if err != nil {
 		log.Error(err)
-		return
+		return nil
 	}
 
 	dims := map[string]string{
@@ -252,15 +285,16 @@ func (mon *monitor) workOne(ctx context.Context, log *logrus.Entry, doc *api.Ope
 	}
 
 	nsgMon := mon.nsgMonitorBuilder(log, doc.OpenShiftCluster, mon.env, subID, tenantID, mon.clusterm, dims, nsgMonTicker.C)
+	driftMon := mon.driftMonitorBuilder(log, doc.OpenShiftCluster, mon.env, subID, tenantID, mon.clusterm, dims, driftMonTicker.C)
 
-	c, err := mon.clusterMonitorBuilder(log, restConfig, doc.OpenShiftCluster, mon.env, tenantID, mon.clusterm, hourlyRun)
+	c, err := mon.clusterMonitorBuilder(log, restConfig, mon.dialer, doc.OpenShiftCluster, mon.env, tenantID, mon.clusterm, hourlyRun, collectorSchedule)
 	if err != nil {
 		log.Error(err)
 		mon.m.EmitGauge("monitor.cluster.failedworker", 1, dims)
-		return
+		return nil
 	}
 
-	monitors = append(monitors, c, nsgMon)
+	monitors = append(monitors, c, nsgMon, driftMon)
 	defer func() {
 		closeMonitors(monitors)
 	}()
@@ -274,7 +308,17 @@ func (mon *monitor) workOne(ctx context.Context, log *logrus.Entry, doc *api.Ope
 
 	select {
 	case <-allJobsDone:
-		return
+		releaseRun()
+
+		mon.recordSLO(ctx, log, doc, c, healthSnapshots, dims)
+		mon.recordDrift(driftMon, healthSnapshots)
+		mon.saveHealthSnapshot(ctx, log, doc, c, healthSnapshots)
+		mon.sendStateChanges(ctx, log, c)
+
+		if snapshotter, ok := c.(monitoring.HealthSnapshotter); ok {
+			return snapshotter.HealthSnapshot()
+		}
+		return nil
 	case <-monitorCtx.Done():
 		if errors.Is(monitorCtx.Err(), context.DeadlineExceeded) {
 			log.Infof("The monitoring process for cluster %s has timed out.", doc.OpenShiftCluster.ID)
@@ -292,6 +336,8 @@ func (mon *monitor) workOne(ctx context.Context, log *logrus.Entry, doc *api.Ope
 	case <-gracePeriod.C:
 		mon.m.EmitGauge("monitor.main.forcedcleanup", int64(1), dims)
 	}
+
+	return nil
 }
 
 func closeMonitors(monitors []monitoring.Monitor) {
@@ -18,7 +18,9 @@ import (
 	"github.com/Azure/ARO-RP/pkg/api"
 	pkgenv "github.com/Azure/ARO-RP/pkg/env"
 	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/monitor/cluster"
 	"github.com/Azure/ARO-RP/pkg/monitor/monitoring"
+	"github.com/Azure/ARO-RP/pkg/proxy"
 	testlog "github.com/Azure/ARO-RP/test/util/log"
 )
 
@@ -159,7 +161,7 @@ func TestWorkOneWaitsForMonitorCompletionWithinGracePeriod(t *testing.T) {
 	})
 
 	mon := env.CreateTestMonitor("workone-graceful")
-	mon.clusterMonitorBuilder = func(log *logrus.Entry, restConfig *rest.Config, oc *api.OpenShiftCluster, _ pkgenv.Interface, tenantID string, m metrics.Emitter, hourlyRun bool) (monitoring.Monitor, error) {
+	mon.clusterMonitorBuilder = func(log *logrus.Entry, restConfig *rest.Config, _ proxy.Dialer, oc *api.OpenShiftCluster, _ pkgenv.Interface, tenantID string, m metrics.Emitter, hourlyRun bool, schedule *cluster.CollectorSchedule) (monitoring.Monitor, error) {
 		return clusterMon, nil
 	}
 
@@ -171,7 +173,7 @@ func TestWorkOneWaitsForMonitorCompletionWithinGracePeriod(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel()
 
-	mon.workOne(ctx, env.TestLogger, clusterDoc, subDoc.ResourceID, subDoc.Subscription.Properties.TenantID, false, ticker)
+	mon.workOne(ctx, env.TestLogger, clusterDoc, subDoc.ResourceID, subDoc.Subscription.Properties.TenantID, false, nil, nil, ticker, ticker, func() {})
 
 	assert.True(t, channelClosed(clusterMon.doneChan), "monitor should finish before workOne returns")
 	assert.True(t, channelClosed(clusterMon.closedChan), "closeable monitor should be closed when workOne returns")
@@ -193,7 +195,7 @@ func TestWorkOneForcedCleanupAfterGracePeriod(t *testing.T) {
 	})
 
 	mon := env.CreateTestMonitor("workone-forced-cleanup")
-	mon.clusterMonitorBuilder = func(log *logrus.Entry, restConfig *rest.Config, oc *api.OpenShiftCluster, _ pkgenv.Interface, tenantID string, m metrics.Emitter, hourlyRun bool) (monitoring.Monitor, error) {
+	mon.clusterMonitorBuilder = func(log *logrus.Entry, restConfig *rest.Config, _ proxy.Dialer, oc *api.OpenShiftCluster, _ pkgenv.Interface, tenantID string, m metrics.Emitter, hourlyRun bool, schedule *cluster.CollectorSchedule) (monitoring.Monitor, error) {
 		return clusterMon, nil
 	}
 
@@ -206,7 +208,7 @@ func TestWorkOneForcedCleanupAfterGracePeriod(t *testing.T) {
 	cancel()
 
 	start := time.Now()
-	mon.workOne(ctx, env.TestLogger, clusterDoc, subDoc.ResourceID, subDoc.Subscription.Properties.TenantID, false, ticker)
+	mon.workOne(ctx, env.TestLogger, clusterDoc, subDoc.ResourceID, subDoc.Subscription.Properties.TenantID, false, nil, nil, ticker, ticker, func() {})
 	elapsed := time.Since(start)
 
 	assert.True(t, channelClosed(clusterMon.closedChan), "closeable monitor should be closed on forced cleanup")
@@ -21,6 +21,8 @@ const (
 	SingletonClusterName        = "cluster"
 	InternetReachableFromMaster = "InternetReachableFromMaster"
 	InternetReachableFromWorker = "InternetReachableFromWorker"
+	DNSResolutionFromMaster     = "DNSResolutionFromMaster"
+	DNSResolutionFromWorker     = "DNSResolutionFromWorker"
 	MachineValid                = "MachineValid"
 	ServicePrincipalValid       = "ServicePrincipalValid"
 
@@ -30,6 +32,8 @@ const (
 	DefaultIngressCertificate = "DefaultIngressCertificate"
 	DefaultClusterDNS         = "DefaultClusterDNS"
 	GuardRailsStatus          = "GuardRailsStatus"
+
+	GuardRailsPolicyViolations = "GuardRailsPolicyViolations"
 )
 
 // AllConditionTypes is a operator conditions currently in use, any condition not in this list is not
@@ -38,12 +42,15 @@ func AllConditionTypes() []string {
 	return []string{
 		InternetReachableFromMaster,
 		InternetReachableFromWorker,
+		DNSResolutionFromMaster,
+		DNSResolutionFromWorker,
 		MachineValid,
 		ServicePrincipalValid,
 		ManagedUpgradeOperatorStatus,
 		DefaultIngressCertificate,
 		DefaultClusterDNS,
 		GuardRailsStatus,
+		GuardRailsPolicyViolations,
 	}
 }
 
@@ -63,6 +70,101 @@ type InternetCheckerSpec struct {
 	URLs []string `json:"urls,omitempty"`
 }
 
+// InternetCheckFailureClass is why a URL could not be reached
+type InternetCheckFailureClass string
+
+const (
+	InternetCheckFailureDNS     InternetCheckFailureClass = "DNS"
+	InternetCheckFailureTCP     InternetCheckFailureClass = "TCP"
+	InternetCheckFailureTLS     InternetCheckFailureClass = "TLS"
+	InternetCheckFailureHTTP    InternetCheckFailureClass = "HTTP"
+	InternetCheckFailureProxy   InternetCheckFailureClass = "Proxy"
+	InternetCheckFailureTimeout InternetCheckFailureClass = "Timeout"
+	InternetCheckFailureUnknown InternetCheckFailureClass = "Unknown"
+)
+
+// InternetCheckerStatus holds the results of the internet checker's last
+// checks
+type InternetCheckerStatus struct {
+	// Results holds the result of checking each URL from the operator of each
+	// role, and the URLs which could not be reached from each node if the
+	// internet checker DaemonSets are enabled
+	Results []InternetCheckResult `json:"results,omitempty"`
+}
+
+// InternetCheckResult is the result of checking a URL
+type InternetCheckResult struct {
+	URL string `json:"url"`
+	// Role is the role of the nodes the check ran from
+	Role string `json:"role"`
+	// Node is the node the check ran from, if it ran from an internet checker
+	// DaemonSet rather than the operator
+	Node string `json:"node,omitempty"`
+
+	Reachable    bool                      `json:"reachable"`
+	FailureClass InternetCheckFailureClass `json:"failureClass,omitempty"`
+	Message      string                    `json:"message,omitempty"`
+	// StatusCode is the HTTP status code of the response, if there was one
+	StatusCode int `json:"statusCode,omitempty"`
+	// LatencyMilliseconds is how long the last attempt took
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
+	// Proxy is the cluster-wide proxy the check went through, if any
+	Proxy string `json:"proxy,omitempty"`
+
+	LastCheckTime metav1.Time `json:"lastCheckTime,omitempty"`
+}
+
+// DNSResolutionCheckerStatus holds what the DNS resolution checker found
+// about the cluster's DNS configuration
+type DNSResolutionCheckerStatus struct {
+	// VnetDNSServers are the custom DNS servers of the cluster's virtual
+	// network, or Azure's DNS server if it has none. They are recorded by
+	// the master operator, so that the worker operator can query them too.
+	VnetDNSServers []string `json:"vnetDNSServers,omitempty"`
+}
+
+// GuardRailsAuditStatus holds the guardrails controller's last audit of the
+// policies it manages
+type GuardRailsAuditStatus struct {
+	// Method is the enforcement engine the policies were audited on, either
+	// "gatekeeper" or "vap"
+	Method   string                  `json:"method,omitempty"`
+	Policies []GuardRailsPolicyAudit `json:"policies,omitempty"`
+
+	LastAuditTime metav1.Time `json:"lastAuditTime,omitempty"`
+}
+
+// GuardRailsPolicyAudit summarizes the violations of a guardrails policy
+type GuardRailsPolicyAudit struct {
+	Name string `json:"name"`
+	// Enforcement is the enforcement the policy is deployed with, e.g.
+	// "dryrun", "warn" or "deny"
+	Enforcement string `json:"enforcement"`
+	// Violations is the number of objects which violated the Gatekeeper
+	// constraint at its last audit, or the number of requests which failed
+	// the ValidatingAdmissionPolicy in the last day
+	Violations int64 `json:"violations"`
+	// Samples describe some of the violations, when the enforcement engine
+	// reports them
+	Samples []string `json:"samples,omitempty"`
+}
+
+// EtcdBackupStatus records the latest etcd backup uploaded to the cluster
+// storage account
+type EtcdBackupStatus struct {
+	// LastBackupTime is when the latest backup was uploaded
+	LastBackupTime metav1.Time `json:"lastBackupTime,omitempty"`
+	// LastBackupName is the name of the latest backup, which prefixes the
+	// names of its blobs
+	LastBackupName string `json:"lastBackupName,omitempty"`
+	// RetainedBackups is how many backups were kept once the latest one was
+	// uploaded
+	RetainedBackups int `json:"retainedBackups,omitempty"`
+	// Message describes why etcd is not being backed up, when backups are
+	// enabled but cannot be taken on the cluster
+	Message string `json:"message,omitempty"`
+}
+
 type OperatorFlags map[string]string
 
 func (f OperatorFlags) GetWithDefault(key string, sentinel string) string {
@@ -123,6 +225,10 @@ type ClusterStatus struct {
 	OperatorVersion   string                         `json:"operatorVersion,omitempty"`
 	Conditions        []operatorv1.OperatorCondition `json:"conditions,omitempty"`
 	RedHatKeysPresent []string                       `json:"redHatKeysPresent,omitempty"`
+	InternetChecker   InternetCheckerStatus          `json:"internetChecker,omitempty"`
+	DNSResolution     DNSResolutionCheckerStatus     `json:"dnsResolution,omitempty"`
+	GuardRailsAudit   GuardRailsAuditStatus          `json:"guardRailsAudit,omitempty"`
+	EtcdBackup        EtcdBackupStatus               `json:"etcdBackup,omitempty"`
 }
 
 // Cluster is the Schema for the clusters API
@@ -134,6 +134,10 @@ func (in *ClusterStatus) DeepCopyInto(out *ClusterStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.InternetChecker.DeepCopyInto(&out.InternetChecker)
+	in.DNSResolution.DeepCopyInto(&out.DNSResolution)
+	in.GuardRailsAudit.DeepCopyInto(&out.GuardRailsAudit)
+	in.EtcdBackup.DeepCopyInto(&out.EtcdBackup)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterStatus.
@@ -146,6 +150,42 @@ func (in *ClusterStatus) DeepCopy() *ClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSResolutionCheckerStatus) DeepCopyInto(out *DNSResolutionCheckerStatus) {
+	*out = *in
+	if in.VnetDNSServers != nil {
+		in, out := &in.VnetDNSServers, &out.VnetDNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSResolutionCheckerStatus.
+func (in *DNSResolutionCheckerStatus) DeepCopy() *DNSResolutionCheckerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSResolutionCheckerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdBackupStatus) DeepCopyInto(out *EtcdBackupStatus) {
+	*out = *in
+	in.LastBackupTime.DeepCopyInto(&out.LastBackupTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdBackupStatus.
+func (in *EtcdBackupStatus) DeepCopy() *EtcdBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GenevaLoggingSpec) DeepCopyInto(out *GenevaLoggingSpec) {
 	*out = *in
@@ -161,6 +201,65 @@ func (in *GenevaLoggingSpec) DeepCopy() *GenevaLoggingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardRailsAuditStatus) DeepCopyInto(out *GuardRailsAuditStatus) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]GuardRailsPolicyAudit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.LastAuditTime.DeepCopyInto(&out.LastAuditTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardRailsAuditStatus.
+func (in *GuardRailsAuditStatus) DeepCopy() *GuardRailsAuditStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardRailsAuditStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuardRailsPolicyAudit) DeepCopyInto(out *GuardRailsPolicyAudit) {
+	*out = *in
+	if in.Samples != nil {
+		in, out := &in.Samples, &out.Samples
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuardRailsPolicyAudit.
+func (in *GuardRailsPolicyAudit) DeepCopy() *GuardRailsPolicyAudit {
+	if in == nil {
+		return nil
+	}
+	out := new(GuardRailsPolicyAudit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternetCheckResult) DeepCopyInto(out *InternetCheckResult) {
+	*out = *in
+	in.LastCheckTime.DeepCopyInto(&out.LastCheckTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternetCheckResult.
+func (in *InternetCheckResult) DeepCopy() *InternetCheckResult {
+	if in == nil {
+		return nil
+	}
+	out := new(InternetCheckResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InternetCheckerSpec) DeepCopyInto(out *InternetCheckerSpec) {
 	*out = *in
@@ -181,6 +280,28 @@ func (in *InternetCheckerSpec) DeepCopy() *InternetCheckerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InternetCheckerStatus) DeepCopyInto(out *InternetCheckerStatus) {
+	*out = *in
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]InternetCheckResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternetCheckerStatus.
+func (in *InternetCheckerStatus) DeepCopy() *InternetCheckerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(InternetCheckerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in OperatorFlags) DeepCopyInto(out *OperatorFlags) {
 	{
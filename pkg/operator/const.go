@@ -13,4 +13,9 @@ const (
 	OperatorIdentityName       = "aro-operator"
 	OperatorIdentitySecretName = "azure-cloud-credentials"
 	OperatorTokenFile          = "/var/run/secrets/openshift/serviceaccount/token"
+
+	// EtcdBackupKeySecretName is the Secret the RP deploys the key etcd
+	// backups are encrypted with to, from the cluster document
+	EtcdBackupKeySecretName = "aro-etcd-backup-encryption-key"
+	EtcdBackupKeySecretKey  = "key"
 )
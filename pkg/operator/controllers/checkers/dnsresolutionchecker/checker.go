@@ -0,0 +1,214 @@
+package dnsresolutionchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// nodeResolver is the name of the resolver of the node the operator's pod
+// runs on: dnsmasq, which the checker queries on the node's IP
+const nodeResolver = "node"
+
+// appsProbeLabel is queried under *.apps, as any name under it resolves to the
+// default ingress controller
+const appsProbeLabel = "aro-dns-resolution-check"
+
+type outcome string
+
+const (
+	outcomeResolved outcome = "Resolved"
+	outcomeFailed   outcome = "Failed"
+	outcomeMismatch outcome = "Mismatch"
+)
+
+type resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+type dnsResolutionChecker interface {
+	Check(ctx context.Context, lookups []lookup, nodeIP string, servers []string) []result
+}
+
+// lookup is a name the checker resolves
+type lookup struct {
+	// name identifies the lookup in conditions and metrics, e.g. "api-int"
+	name string
+	host string
+
+	// expectedIP is the address dnsmasq answers the lookup with on the
+	// nodes, or "" if dnsmasq forwards it to the VNet DNS servers
+	expectedIP string
+}
+
+// result is the outcome of a lookup against a resolver
+type result struct {
+	lookup   lookup
+	resolver string
+
+	addresses []string
+	outcome   outcome
+	message   string
+}
+
+// failing returns whether the result shows a broken configuration.
+// The VNet DNS servers do not need to answer lookups which dnsmasq answers on
+// the nodes, so their results for them are only informational.
+func (r result) failing() bool {
+	if r.outcome == outcomeResolved {
+		return false
+	}
+	return r.resolver == nodeResolver || r.lookup.expectedIP == ""
+}
+
+func (r result) String() string {
+	return fmt.Sprintf("%s (%s) via %s: %s", r.lookup.name, r.lookup.host, r.resolver, r.message)
+}
+
+// lookups returns the names the cluster's nodes must be able to resolve
+func lookups(spec *arov1alpha1.ClusterSpec) []lookup {
+	var lookups []lookup
+
+	if spec.Domain != "" {
+		lookups = append(lookups,
+			lookup{name: "api", host: "api." + spec.Domain, expectedIP: spec.APIIntIP},
+			lookup{name: "api-int", host: "api-int." + spec.Domain, expectedIP: spec.APIIntIP},
+			lookup{name: "*.apps", host: appsProbeLabel + ".apps." + spec.Domain, expectedIP: spec.IngressIP},
+		)
+	}
+
+	if spec.ACRDomain != "" {
+		lookups = append(lookups, lookup{name: "acr", host: spec.ACRDomain})
+	}
+
+	if spec.GatewayPrivateEndpointIP != "" {
+		for _, domain := range spec.GatewayDomains {
+			lookups = append(lookups, lookup{name: "gateway", host: domain, expectedIP: spec.GatewayPrivateEndpointIP})
+		}
+	}
+
+	return lookups
+}
+
+type checker struct {
+	lookupTimeout time.Duration
+
+	// newServerResolver returns a resolver which queries only the given DNS
+	// server
+	newServerResolver func(server string) resolver
+}
+
+func newDNSResolutionChecker() *checker {
+	return &checker{
+		lookupTimeout: 10 * time.Second,
+
+		newServerResolver: func(server string) resolver {
+			return &net.Resolver{
+				PreferGo: true,
+				Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+					d := &net.Dialer{}
+					return d.DialContext(ctx, network, net.JoinHostPort(server, "53"))
+				},
+			}
+		},
+	}
+}
+
+// Check resolves each lookup against dnsmasq on the node with the IP nodeIP
+// and each of the VNet DNS servers, returning the results grouped by lookup in
+// the same order. Querying the node's IP checks the node's own resolver
+// rather than the cluster DNS, whose answers may be cached or forwarded
+// elsewhere by the DNS operator.
+func (r *checker) Check(ctx context.Context, lookups []lookup, nodeIP string, servers []string) []result {
+	resolvers := map[string]resolver{nodeResolver: r.newServerResolver(nodeIP)}
+	names := []string{nodeResolver}
+	for _, server := range servers {
+		resolvers[server] = r.newServerResolver(server)
+		names = append(names, server)
+	}
+
+	results := make([]result, len(lookups)*len(names))
+
+	var wg sync.WaitGroup
+	for i, l := range lookups {
+		for j, name := range names {
+			wg.Go(func() {
+				results[i*len(names)+j] = r.resolve(ctx, resolvers[name], name, l)
+			})
+		}
+	}
+	wg.Wait()
+
+	// the VNet DNS servers should give the same answers as dnsmasq for
+	// lookups which it forwards to them
+	for i := range lookups {
+		node := results[i*len(names)]
+		for j := 1; j < len(names); j++ {
+			server := &results[i*len(names)+j]
+			if server.lookup.expectedIP != "" || node.outcome != outcomeResolved || server.outcome != outcomeResolved {
+				continue
+			}
+			if !slices.ContainsFunc(server.addresses, func(a string) bool { return slices.Contains(node.addresses, a) }) {
+				server.outcome = outcomeMismatch
+				server.message = fmt.Sprintf("resolved to %s, but the node resolver resolved to %s", strings.Join(server.addresses, ", "), strings.Join(node.addresses, ", "))
+			}
+		}
+	}
+
+	return results
+}
+
+func (r *checker) resolve(ctx context.Context, res resolver, name string, l lookup) result {
+	ctx, cancel := context.WithTimeout(ctx, r.lookupTimeout)
+	defer cancel()
+
+	result := result{
+		lookup:   l,
+		resolver: name,
+	}
+
+	addresses, err := res.LookupHost(ctx, l.host)
+	if err != nil {
+		result.outcome = outcomeFailed
+		result.message = err.Error()
+		return result
+	}
+	slices.Sort(addresses)
+	result.addresses = addresses
+
+	if l.expectedIP != "" && !slices.Contains(addresses, l.expectedIP) {
+		result.outcome = outcomeMismatch
+		result.message = fmt.Sprintf("resolved to %s, expected %s", strings.Join(addresses, ", "), l.expectedIP)
+		return result
+	}
+
+	result.outcome = outcomeResolved
+	result.message = "resolved to " + strings.Join(addresses, ", ")
+	return result
+}
+
+// summarize returns a message describing the failing results, or "" if there
+// are none
+func summarize(results []result) string {
+	var failing []string
+	for _, r := range results {
+		if r.failing() {
+			failing = append(failing, r.String())
+		}
+	}
+
+	if len(failing) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%d of %d lookups failed: %s", len(failing), len(results), strings.Join(failing, "; "))
+}
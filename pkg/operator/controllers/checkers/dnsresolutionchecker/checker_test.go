@@ -0,0 +1,183 @@
+package dnsresolutionchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// fakeResolver resolves the hosts in its map, and fails to resolve any other
+type fakeResolver map[string][]string
+
+func (r fakeResolver) LookupHost(ctx context.Context, host string) ([]string, error) {
+	addresses, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return addresses, nil
+}
+
+func TestLookups(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		spec *arov1alpha1.ClusterSpec
+		want []lookup
+	}{
+		{
+			name: "empty",
+			spec: &arov1alpha1.ClusterSpec{},
+		},
+		{
+			name: "cluster without gateway",
+			spec: &arov1alpha1.ClusterSpec{
+				Domain:         "cluster.example.com",
+				ACRDomain:      "arosvc.azurecr.io",
+				APIIntIP:       "10.0.0.4",
+				IngressIP:      "10.0.1.4",
+				GatewayDomains: []string{"gateway.example.com"},
+			},
+			want: []lookup{
+				{name: "api", host: "api.cluster.example.com", expectedIP: "10.0.0.4"},
+				{name: "api-int", host: "api-int.cluster.example.com", expectedIP: "10.0.0.4"},
+				{name: "*.apps", host: "aro-dns-resolution-check.apps.cluster.example.com", expectedIP: "10.0.1.4"},
+				{name: "acr", host: "arosvc.azurecr.io"},
+			},
+		},
+		{
+			name: "cluster with gateway",
+			spec: &arov1alpha1.ClusterSpec{
+				Domain:                   "cluster.example.com",
+				APIIntIP:                 "10.0.0.4",
+				IngressIP:                "10.0.1.4",
+				GatewayDomains:           []string{"gateway.example.com", "telemetry.example.com"},
+				GatewayPrivateEndpointIP: "10.0.0.10",
+			},
+			want: []lookup{
+				{name: "api", host: "api.cluster.example.com", expectedIP: "10.0.0.4"},
+				{name: "api-int", host: "api-int.cluster.example.com", expectedIP: "10.0.0.4"},
+				{name: "*.apps", host: "aro-dns-resolution-check.apps.cluster.example.com", expectedIP: "10.0.1.4"},
+				{name: "gateway", host: "gateway.example.com", expectedIP: "10.0.0.10"},
+				{name: "gateway", host: "telemetry.example.com", expectedIP: "10.0.0.10"},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := lookups(tt.spec)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %+v, wanted %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	apiInt := lookup{name: "api-int", host: "api-int.cluster.example.com", expectedIP: "10.0.0.4"}
+	acr := lookup{name: "acr", host: "arosvc.azurecr.io"}
+	const nodeIP = "10.0.2.5"
+
+	for _, tt := range []struct {
+		name        string
+		lookups     []lookup
+		node        fakeResolver
+		servers     map[string]fakeResolver
+		want        []result
+		wantMessage string
+	}{
+		{
+			name:    "all resolved",
+			lookups: []lookup{apiInt, acr},
+			node: fakeResolver{
+				"api-int.cluster.example.com": {"10.0.0.4"},
+				"arosvc.azurecr.io":           {"20.0.0.1"},
+			},
+			servers: map[string]fakeResolver{
+				"10.1.0.4": {
+					"arosvc.azurecr.io": {"20.0.0.1"},
+				},
+			},
+			want: []result{
+				{lookup: apiInt, resolver: "node", addresses: []string{"10.0.0.4"}, outcome: outcomeResolved, message: "resolved to 10.0.0.4"},
+				// the VNet DNS servers need not know names dnsmasq answers
+				{lookup: apiInt, resolver: "10.1.0.4", outcome: outcomeFailed, message: "lookup api-int.cluster.example.com: no such host"},
+				{lookup: acr, resolver: "node", addresses: []string{"20.0.0.1"}, outcome: outcomeResolved, message: "resolved to 20.0.0.1"},
+				{lookup: acr, resolver: "10.1.0.4", addresses: []string{"20.0.0.1"}, outcome: outcomeResolved, message: "resolved to 20.0.0.1"},
+			},
+		},
+		{
+			name:    "node resolves a name dnsmasq should answer elsewhere",
+			lookups: []lookup{apiInt},
+			node: fakeResolver{
+				"api-int.cluster.example.com": {"52.0.0.1"},
+			},
+			want: []result{
+				{lookup: apiInt, resolver: "node", addresses: []string{"52.0.0.1"}, outcome: outcomeMismatch, message: "resolved to 52.0.0.1, expected 10.0.0.4"},
+			},
+			wantMessage: "1 of 1 lookups failed: api-int (api-int.cluster.example.com) via node: resolved to 52.0.0.1, expected 10.0.0.4",
+		},
+		{
+			name:    "VNet DNS server cannot resolve a forwarded name",
+			lookups: []lookup{acr},
+			node: fakeResolver{
+				"arosvc.azurecr.io": {"20.0.0.1"},
+			},
+			servers: map[string]fakeResolver{
+				"10.1.0.4": {},
+			},
+			want: []result{
+				{lookup: acr, resolver: "node", addresses: []string{"20.0.0.1"}, outcome: outcomeResolved, message: "resolved to 20.0.0.1"},
+				{lookup: acr, resolver: "10.1.0.4", outcome: outcomeFailed, message: "lookup arosvc.azurecr.io: no such host"},
+			},
+			wantMessage: "1 of 2 lookups failed: acr (arosvc.azurecr.io) via 10.1.0.4: lookup arosvc.azurecr.io: no such host",
+		},
+		{
+			name:    "VNet DNS server resolves a private endpoint dnsmasq does not",
+			lookups: []lookup{acr},
+			node: fakeResolver{
+				"arosvc.azurecr.io": {"20.0.0.1"},
+			},
+			servers: map[string]fakeResolver{
+				"10.1.0.4": {
+					"arosvc.azurecr.io": {"10.2.0.5"},
+				},
+			},
+			want: []result{
+				{lookup: acr, resolver: "node", addresses: []string{"20.0.0.1"}, outcome: outcomeResolved, message: "resolved to 20.0.0.1"},
+				{lookup: acr, resolver: "10.1.0.4", addresses: []string{"10.2.0.5"}, outcome: outcomeMismatch, message: "resolved to 10.2.0.5, but the node resolver resolved to 20.0.0.1"},
+			},
+			wantMessage: "1 of 2 lookups failed: acr (arosvc.azurecr.io) via 10.1.0.4: resolved to 10.2.0.5, but the node resolver resolved to 20.0.0.1",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &checker{
+				lookupTimeout: time.Second,
+				newServerResolver: func(server string) resolver {
+					if server == nodeIP {
+						return tt.node
+					}
+					return tt.servers[server]
+				},
+			}
+
+			var servers []string
+			for server := range tt.servers {
+				servers = append(servers, server)
+			}
+
+			results := c.Check(context.Background(), tt.lookups, nodeIP, servers)
+			if !reflect.DeepEqual(results, tt.want) {
+				t.Errorf("got %+v, wanted %+v", results, tt.want)
+			}
+
+			if message := summarize(results); message != tt.wantMessage {
+				t.Error(message)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package dnsresolutionchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/predicates"
+	"github.com/Azure/ARO-RP/pkg/util/conditions"
+)
+
+// This is the permissions that this controller needs to work.
+// "make generate" will run kubebuilder and cause operator/deploy/staticresources/*/role.yaml to be updated
+// from the annotation below.
+// +kubebuilder:rbac:groups=aro.openshift.io,resources=clusters,verbs=get;list;watch
+// +kubebuilder:rbac:groups=aro.openshift.io,resources=clusters/status,verbs=get;update;patch
+
+const (
+	ControllerName = "DNSResolutionChecker"
+
+	// nodeIPEnvVar holds the IP of the node the operator's pod runs on
+	nodeIPEnvVar = "NODE_IP"
+)
+
+// Reconciler checks that the nodes of its role, those the operator's pod runs
+// on, can resolve the names the cluster depends on
+type Reconciler struct {
+	log    *logrus.Entry
+	role   string
+	nodeIP string
+
+	checker        dnsResolutionChecker
+	vnetDNSServers func(context.Context, *arov1alpha1.ClusterSpec) ([]string, error)
+
+	client client.Client
+}
+
+func NewReconciler(log *logrus.Entry, client client.Client, role string) *Reconciler {
+	return &Reconciler{
+		log:    log,
+		role:   role,
+		nodeIP: os.Getenv(nodeIPEnvVar),
+
+		checker:        newDNSResolutionChecker(),
+		vnetDNSServers: vnetDNSServers,
+
+		client: client,
+	}
+}
+
+// Reconcile will keep checking that the cluster's names resolve, both through
+// dnsmasq on the node and through the VNet DNS servers.
+func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	instance := &arov1alpha1.Cluster{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !instance.Spec.OperatorFlags.GetSimpleBoolean(operator.CheckerEnabled) {
+		r.log.Debug("controller is disabled")
+		return r.reconcileDisabled(ctx)
+	}
+
+	r.log.Debug("running")
+	var servers []string
+	var checkErr error
+	if r.nodeIP == "" {
+		checkErr = fmt.Errorf("%s must be set", nodeIPEnvVar)
+	} else {
+		servers, checkErr = r.servers(ctx, instance)
+	}
+
+	var message string
+	if checkErr == nil {
+		results := r.checker.Check(ctx, lookups(&instance.Spec), r.nodeIP, servers)
+		recordMetrics(r.role, results)
+		message = summarize(results)
+	}
+	condition := r.condition(message, checkErr)
+
+	err = conditions.SetCondition(ctx, r.client, condition, r.role)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// We always requeue here:
+	// * Either immediately (with rate limiting) based on the error
+	//   when checkErr != nil.
+	// * Or based on RequeueAfter when err == nil.
+	return reconcile.Result{RequeueAfter: time.Hour}, checkErr
+}
+
+// servers returns the VNet DNS servers to check against. Only the master
+// operator has the credentials to look them up, so it records them in the
+// cluster's status for the worker operator.
+func (r *Reconciler) servers(ctx context.Context, instance *arov1alpha1.Cluster) ([]string, error) {
+	if r.role != operator.RoleMaster {
+		return instance.Status.DNSResolution.VnetDNSServers, nil
+	}
+
+	servers, err := r.vnetDNSServers(ctx, &instance.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return servers, retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster := &arov1alpha1.Cluster{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, cluster)
+		if err != nil {
+			return err
+		}
+
+		if slices.Equal(cluster.Status.DNSResolution.VnetDNSServers, servers) {
+			return nil
+		}
+
+		cluster.Status.DNSResolution.VnetDNSServers = servers
+		return r.client.Status().Update(ctx, cluster)
+	})
+}
+
+func (r *Reconciler) reconcileDisabled(ctx context.Context) (ctrl.Result, error) {
+	recordMetrics(r.role, nil)
+
+	condition := &operatorv1.OperatorCondition{
+		Type:   r.conditionType(),
+		Status: operatorv1.ConditionUnknown,
+	}
+
+	return reconcile.Result{}, conditions.SetCondition(ctx, r.client, condition, r.role)
+}
+
+func (r *Reconciler) condition(message string, checkErr error) *operatorv1.OperatorCondition {
+	if checkErr != nil {
+		return &operatorv1.OperatorCondition{
+			Type:    r.conditionType(),
+			Status:  operatorv1.ConditionFalse,
+			Message: checkErr.Error(),
+			Reason:  "CheckFailed",
+		}
+	}
+
+	if message != "" {
+		return &operatorv1.OperatorCondition{
+			Type:    r.conditionType(),
+			Status:  operatorv1.ConditionFalse,
+			Message: message,
+			Reason:  "CheckDone",
+		}
+	}
+
+	return &operatorv1.OperatorCondition{
+		Type:    r.conditionType(),
+		Status:  operatorv1.ConditionTrue,
+		Message: "DNS resolution successful",
+		Reason:  "CheckDone",
+	}
+}
+
+func (r *Reconciler) conditionType() string {
+	switch r.role {
+	case operator.RoleMaster:
+		return arov1alpha1.DNSResolutionFromMaster
+	case operator.RoleWorker:
+		return arov1alpha1.DNSResolutionFromWorker
+	default:
+		r.log.Warnf("unknown role %s, assuming worker role", r.role)
+		return arov1alpha1.DNSResolutionFromWorker
+	}
+}
+
+// SetupWithManager setup our manager
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(predicate.And(predicates.AROCluster, predicate.GenerationChangedPredicate{}))).
+		Named(ControllerName).
+		Complete(r)
+}
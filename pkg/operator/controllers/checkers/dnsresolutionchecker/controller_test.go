@@ -0,0 +1,214 @@
+package dnsresolutionchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/cmp"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	_ "github.com/Azure/ARO-RP/pkg/util/scheme"
+	testclienthelper "github.com/Azure/ARO-RP/test/util/clienthelper"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+type fakeChecker func(ctx context.Context, lookups []lookup, nodeIP string, servers []string) []result
+
+func (fc fakeChecker) Check(ctx context.Context, lookups []lookup, nodeIP string, servers []string) []result {
+	return fc(ctx, lookups, nodeIP, servers)
+}
+
+func TestReconcile(t *testing.T) {
+	ctx := context.Background()
+
+	acr := lookup{name: "acr", host: "arosvc.azurecr.io"}
+	resolved := result{lookup: acr, resolver: "node", addresses: []string{"20.0.0.1"}, outcome: outcomeResolved}
+	failed := result{lookup: acr, resolver: "10.1.0.4", outcome: outcomeFailed, message: "lookup arosvc.azurecr.io: no such host"}
+
+	for _, tt := range []struct {
+		name               string
+		role               string
+		noNodeIP           bool
+		controllerDisabled bool
+		savedServers       []string
+		vnetServers        []string
+		vnetErr            error
+		checkerResults     []result
+		wantServers        []string
+		wantSavedServers   []string
+		wantCondition      operatorv1.ConditionStatus
+		wantConditionType  string
+		wantMessage        string
+		wantMetrics        int
+		wantErr            string
+		wantResult         reconcile.Result
+	}{
+		{
+			name:              "master records the VNet DNS servers",
+			role:              operator.RoleMaster,
+			savedServers:      []string{"10.1.0.5"},
+			vnetServers:       []string{"10.1.0.4"},
+			checkerResults:    []result{resolved},
+			wantServers:       []string{"10.1.0.4"},
+			wantSavedServers:  []string{"10.1.0.4"},
+			wantConditionType: arov1alpha1.DNSResolutionFromMaster,
+			wantCondition:     operatorv1.ConditionTrue,
+			wantMessage:       "DNS resolution successful",
+			wantMetrics:       1,
+			wantResult:        reconcile.Result{RequeueAfter: time.Hour},
+		},
+		{
+			name:              "master fails to get the VNet DNS servers",
+			role:              operator.RoleMaster,
+			savedServers:      []string{"10.1.0.5"},
+			vnetErr:           errors.New("fake error from Azure"),
+			wantSavedServers:  []string{"10.1.0.5"},
+			wantConditionType: arov1alpha1.DNSResolutionFromMaster,
+			wantCondition:     operatorv1.ConditionFalse,
+			wantMessage:       "fake error from Azure",
+			wantErr:           "fake error from Azure",
+			wantResult:        reconcile.Result{RequeueAfter: time.Hour},
+		},
+		{
+			name:              "worker uses the recorded VNet DNS servers",
+			role:              operator.RoleWorker,
+			savedServers:      []string{"10.1.0.4"},
+			checkerResults:    []result{resolved, failed},
+			wantServers:       []string{"10.1.0.4"},
+			wantSavedServers:  []string{"10.1.0.4"},
+			wantConditionType: arov1alpha1.DNSResolutionFromWorker,
+			wantCondition:     operatorv1.ConditionFalse,
+			wantMessage:       "1 of 2 lookups failed: acr (arosvc.azurecr.io) via 10.1.0.4: lookup arosvc.azurecr.io: no such host",
+			wantMetrics:       2,
+			wantResult:        reconcile.Result{RequeueAfter: time.Hour},
+		},
+		{
+			name:              "node IP not set",
+			role:              operator.RoleWorker,
+			noNodeIP:          true,
+			savedServers:      []string{"10.1.0.4"},
+			wantSavedServers:  []string{"10.1.0.4"},
+			wantConditionType: arov1alpha1.DNSResolutionFromWorker,
+			wantCondition:     operatorv1.ConditionFalse,
+			wantMessage:       "NODE_IP must be set",
+			wantErr:           "NODE_IP must be set",
+			wantResult:        reconcile.Result{RequeueAfter: time.Hour},
+		},
+		{
+			name:               "controller disabled",
+			role:               operator.RoleWorker,
+			controllerDisabled: true,
+			wantConditionType:  arov1alpha1.DNSResolutionFromWorker,
+			wantCondition:      operatorv1.ConditionUnknown,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			dnsResolution.Reset()
+
+			instance := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					ACRDomain: "arosvc.azurecr.io",
+					OperatorFlags: arov1alpha1.OperatorFlags{
+						operator.CheckerEnabled: operator.FlagTrue,
+					},
+				},
+				Status: arov1alpha1.ClusterStatus{
+					DNSResolution: arov1alpha1.DNSResolutionCheckerStatus{
+						VnetDNSServers: tt.savedServers,
+					},
+				},
+			}
+			if tt.controllerDisabled {
+				instance.Spec.OperatorFlags[operator.CheckerEnabled] = operator.FlagFalse
+			}
+
+			clientFake := testclienthelper.NewAROFakeClientBuilder(instance).Build()
+
+			nodeIP := "10.0.2.5"
+			if tt.noNodeIP {
+				nodeIP = ""
+			}
+
+			r := &Reconciler{
+				log:    utillog.GetLogger(),
+				role:   tt.role,
+				nodeIP: nodeIP,
+				checker: fakeChecker(func(ctx context.Context, lookups []lookup, gotNodeIP string, servers []string) []result {
+					if !reflect.DeepEqual(lookups, []lookup{acr}) {
+						t.Errorf("got lookups %+v", lookups)
+					}
+					if gotNodeIP != nodeIP {
+						t.Errorf("got node IP %q", gotNodeIP)
+					}
+					if !reflect.DeepEqual(tt.wantServers, servers) {
+						t.Error(cmp.Diff(tt.wantServers, servers))
+					}
+					return tt.checkerResults
+				}),
+				vnetDNSServers: func(context.Context, *arov1alpha1.ClusterSpec) ([]string, error) {
+					if tt.role != operator.RoleMaster {
+						t.Error("worker looked up the VNet DNS servers")
+					}
+					return tt.vnetServers, tt.vnetErr
+				},
+				client: clientFake,
+			}
+
+			result, err := r.Reconcile(ctx, ctrl.Request{})
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if !reflect.DeepEqual(tt.wantResult, result) {
+				t.Error(cmp.Diff(tt.wantResult, result))
+			}
+
+			err = clientFake.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(tt.wantSavedServers, instance.Status.DNSResolution.VnetDNSServers) {
+				t.Error(cmp.Diff(tt.wantSavedServers, instance.Status.DNSResolution.VnetDNSServers))
+			}
+
+			var condition *operatorv1.OperatorCondition
+			for i := range instance.Status.Conditions {
+				if instance.Status.Conditions[i].Type == tt.wantConditionType {
+					condition = &instance.Status.Conditions[i]
+				}
+			}
+			if condition == nil {
+				t.Fatal("no condition found")
+			}
+			if condition.Status != tt.wantCondition {
+				t.Error(condition.Status)
+			}
+			if condition.Message != tt.wantMessage {
+				t.Error(condition.Message)
+			}
+
+			if got := testutil.CollectAndCount(dnsResolution); got != tt.wantMetrics {
+				t.Errorf("got %d metrics, wanted %d", got, tt.wantMetrics)
+			}
+		})
+	}
+}
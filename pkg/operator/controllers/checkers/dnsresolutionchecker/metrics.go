@@ -0,0 +1,31 @@
+package dnsresolutionchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var dnsResolution = prometheus.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "aro_dns_resolution",
+		Help: "Outcome of the last lookup of each name the cluster's nodes must resolve, by the role of the operator pod it ran from and the resolver it was made against",
+	},
+	[]string{"role", "name", "host", "resolver", "outcome"},
+)
+
+func init() {
+	metrics.Registry.MustRegister(dnsResolution)
+}
+
+// recordMetrics replaces the role's series with one for each result
+func recordMetrics(role string, results []result) {
+	dnsResolution.DeletePartialMatch(prometheus.Labels{"role": role})
+
+	for _, r := range results {
+		dnsResolution.WithLabelValues(role, r.lookup.name, r.lookup.host, r.resolver, string(r.outcome)).Set(1)
+	}
+}
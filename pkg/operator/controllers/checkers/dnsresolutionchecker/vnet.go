@@ -0,0 +1,61 @@
+package dnsresolutionchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/azuresdk/armnetwork"
+)
+
+// azureDNSServer is the DNS server of virtual networks without custom DNS
+// servers, which also resolves the private DNS zones linked to them
+const azureDNSServer = "168.63.129.16"
+
+// vnetDNSServers returns the DNS servers of the cluster's virtual network
+func vnetDNSServers(ctx context.Context, spec *arov1alpha1.ClusterSpec) ([]string, error) {
+	azEnv, err := azureclient.EnvironmentFromName(spec.AZEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := azure.ParseResourceID(spec.VnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, err := azEnv.NewTokenCredential()
+	if err != nil {
+		return nil, err
+	}
+
+	vnetClient, err := armnetwork.NewVirtualNetworksClient(resource.SubscriptionID, credential, azEnv.ArmClientOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	vnet, err := vnetClient.Get(ctx, resource.ResourceGroup, resource.ResourceName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var servers []string
+	if vnet.Properties != nil && vnet.Properties.DhcpOptions != nil {
+		for _, server := range vnet.Properties.DhcpOptions.DNSServers {
+			if server != nil {
+				servers = append(servers, *server)
+			}
+		}
+	}
+
+	if len(servers) == 0 {
+		return []string{azureDNSServer}, nil
+	}
+
+	return servers, nil
+}
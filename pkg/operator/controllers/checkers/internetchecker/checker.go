@@ -5,10 +5,19 @@ package internetchecker
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"net"
 	"net/http"
-	"strings"
+	"net/url"
+	"sync"
 	"time"
+
+	"golang.org/x/net/http/httpproxy"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 )
 
 type simpleHTTPClient interface {
@@ -16,9 +25,12 @@ type simpleHTTPClient interface {
 }
 
 type internetChecker interface {
-	Check(URLs []string) error
+	Check(URLs []string, proxy *httpproxy.Config) []arov1alpha1.InternetCheckResult
 }
 
+// proxyKey is the request context key of the proxy a check goes through
+type proxyKey struct{}
+
 // checker evaluates our capability to create new
 // connections to given internet endpoints.
 type checker struct {
@@ -44,68 +56,150 @@ func newInternetChecker() *checker {
 				// 2. We *want* to evaluate our capability to successfully create
 				// *new* connections to internet endpoints anyway.
 				DisableKeepAlives: true,
+				Proxy: func(req *http.Request) (*url.URL, error) {
+					proxyURL, _ := req.Context().Value(proxyKey{}).(*url.URL)
+					return proxyURL, nil
+				},
 			},
 		},
 	}
 }
 
-func (r *checker) Check(URLs []string) error {
-	ch := make(chan error)
-	checkCount := 0
-	for _, url := range URLs {
-		checkCount++
-		go func(urlToCheck string) {
-			ch <- r.checkWithRetry(urlToCheck)
-		}(url)
+// Check checks each of the URLs, through the cluster-wide proxy if one is
+// configured, returning a result for each in the same order
+func (r *checker) Check(URLs []string, proxy *httpproxy.Config) []arov1alpha1.InternetCheckResult {
+	proxyFunc := func(*url.URL) (*url.URL, error) { return nil, nil }
+	if proxy != nil {
+		proxyFunc = proxy.ProxyFunc()
 	}
 
-	errsAll := []string{}
-	for i := 0; i < checkCount; i++ {
-		if err := <-ch; err != nil {
-			errsAll = append(errsAll, err.Error())
-		}
-	}
-	if len(errsAll) != 0 {
-		// TODO: Consider replacing with multi error wrapping with Go 1.20: https://github.com/golang/go/issues/53435#issuecomment-1320343377
-		return fmt.Errorf("%s", strings.Join(errsAll, "\n"))
+	results := make([]arov1alpha1.InternetCheckResult, len(URLs))
+
+	var wg sync.WaitGroup
+	for i, u := range URLs {
+		wg.Go(func() {
+			results[i] = r.checkWithRetry(u, proxyFunc)
+		})
 	}
+	wg.Wait()
 
-	return nil
+	return results
 }
 
 // checkWithRetry checks the URL, retrying a failed query a few times
-func (r *checker) checkWithRetry(url string) error {
-	var err error
+func (r *checker) checkWithRetry(rawURL string, proxyFunc func(*url.URL) (*url.URL, error)) arov1alpha1.InternetCheckResult {
+	result := arov1alpha1.InternetCheckResult{
+		URL: rawURL,
+	}
+
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		result.FailureClass = arov1alpha1.InternetCheckFailureUnknown
+		result.Message = err.Error()
+		return result
+	}
+
+	proxyURL, err := proxyFunc(u)
+	if err != nil {
+		result.FailureClass = arov1alpha1.InternetCheckFailureProxy
+		result.Message = err.Error()
+		return result
+	}
 
 	for i := 0; i < 6; i++ {
-		err = r.checkOnce(url, r.checkTimeout/6)
-		if err == nil {
-			return nil
+		result = r.checkOnce(rawURL, proxyURL, r.checkTimeout/6)
+		if result.Reachable {
+			break
 		}
 	}
 
-	return err
+	return result
 }
 
 // checkOnce checks a given url.  The check both times out after a given timeout
 // *and* will wait for the timeout if it fails, so that we don't hit endpoints
 // too much.
-func (r *checker) checkOnce(url string, timeout time.Duration) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (r *checker) checkOnce(url string, proxyURL *url.URL, timeout time.Duration) arov1alpha1.InternetCheckResult {
+	result := arov1alpha1.InternetCheckResult{
+		URL: url,
+	}
+	if proxyURL != nil {
+		// the proxy's URL may include credentials
+		result.Proxy = proxyURL.Scheme + "://" + proxyURL.Host
+	}
+
+	ctx, cancel := context.WithTimeout(context.WithValue(context.Background(), proxyKey{}, proxyURL), timeout)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
 	if err != nil {
 		<-ctx.Done()
-		return err
+		result.FailureClass = arov1alpha1.InternetCheckFailureUnknown
+		result.Message = err.Error()
+		return result
 	}
 
+	start := time.Now()
 	resp, err := r.httpClient.Do(req)
+	result.LatencyMilliseconds = time.Since(start).Milliseconds()
 	if err != nil {
 		<-ctx.Done()
-		return fmt.Errorf("%s: %s", url, err)
+		result.FailureClass = classify(err, proxyURL != nil)
+		result.Message = fmt.Sprintf("%s: %s", url, err)
+		return result
 	}
-
 	resp.Body.Close()
-	return nil
+
+	result.StatusCode = resp.StatusCode
+
+	// any response shows that the endpoint can be reached, unless the server
+	// (or a proxy or firewall in front of it) is failing
+	if resp.StatusCode >= http.StatusInternalServerError {
+		<-ctx.Done()
+		result.FailureClass = arov1alpha1.InternetCheckFailureHTTP
+		result.Message = fmt.Sprintf("%s: %s", url, resp.Status)
+		return result
+	}
+
+	result.Reachable = true
+	return result
+}
+
+// classify returns why a request failed with err. Connections only go to the
+// proxy, if there is one, so failures to connect are the proxy's.
+func classify(err error, proxied bool) arov1alpha1.InternetCheckFailureClass {
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || errors.As(err, &netErr) && netErr.Timeout() {
+		return arov1alpha1.InternetCheckFailureTimeout
+	}
+
+	// a TLS failure through a proxy is usually a firewall inspecting TLS
+	var (
+		recordHeaderErr     tls.RecordHeaderError
+		alertErr            tls.AlertError
+		verificationErr     *tls.CertificateVerificationError
+		unknownAuthorityErr x509.UnknownAuthorityError
+		hostnameErr         x509.HostnameError
+		certificateErr      x509.CertificateInvalidError
+	)
+	if errors.As(err, &recordHeaderErr) || errors.As(err, &alertErr) || errors.As(err, &verificationErr) ||
+		errors.As(err, &unknownAuthorityErr) || errors.As(err, &hostnameErr) || errors.As(err, &certificateErr) {
+		return arov1alpha1.InternetCheckFailureTLS
+	}
+
+	if proxied {
+		return arov1alpha1.InternetCheckFailureProxy
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return arov1alpha1.InternetCheckFailureDNS
+	}
+
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return arov1alpha1.InternetCheckFailureTCP
+	}
+
+	return arov1alpha1.InternetCheckFailureUnknown
 }
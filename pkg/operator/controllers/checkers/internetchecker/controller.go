@@ -9,6 +9,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -30,6 +31,7 @@ import (
 // from the annotation below.
 // +kubebuilder:rbac:groups=aro.openshift.io,resources=clusters,verbs=get;list;watch
 // +kubebuilder:rbac:groups=aro.openshift.io,resources=clusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=config.openshift.io,resources=proxies,verbs=get;list;watch
 
 const (
 	ControllerName = "InternetChecker"
@@ -70,14 +72,31 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 	}
 
 	r.log.Debug("running")
-	checkErr := r.checker.Check(instance.Spec.InternetChecker.URLs)
-	condition := r.condition(checkErr)
+	proxy, err := clusterProxy(ctx, r.client)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	results := r.checker.Check(instance.Spec.InternetChecker.URLs, proxy)
+	now := metav1.Now()
+	for i := range results {
+		results[i].Role = r.role
+		results[i].LastCheckTime = now
+	}
+
+	message, checkErr := summarize(results)
+	condition := r.condition(message, checkErr)
 
 	err = conditions.SetCondition(ctx, r.client, condition, r.role)
 	if err != nil {
 		return reconcile.Result{}, err
 	}
 
+	err = setResults(ctx, r.client, r.role, "", results)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// We always requeue here:
 	// * Either immediately (with rate limiting) based on the error
 	//   when checkErr != nil.
@@ -91,15 +110,20 @@ func (r *Reconciler) reconcileDisabled(ctx context.Context) (ctrl.Result, error)
 		Status: operatorv1.ConditionUnknown,
 	}
 
-	return reconcile.Result{}, conditions.SetCondition(ctx, r.client, condition, r.role)
+	err := conditions.SetCondition(ctx, r.client, condition, r.role)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, setResults(ctx, r.client, r.role, "", nil)
 }
 
-func (r *Reconciler) condition(checkErr error) *operatorv1.OperatorCondition {
+func (r *Reconciler) condition(message string, checkErr error) *operatorv1.OperatorCondition {
 	if checkErr != nil {
 		return &operatorv1.OperatorCondition{
 			Type:    r.conditionType(),
 			Status:  operatorv1.ConditionFalse,
-			Message: checkErr.Error(),
+			Message: message,
 			Reason:  "CheckFailed",
 		}
 	}
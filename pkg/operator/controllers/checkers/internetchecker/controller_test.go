@@ -5,17 +5,21 @@ package internetchecker
 
 import (
 	"context"
-	"errors"
 	"reflect"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
+	"golang.org/x/net/http/httpproxy"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 
 	"github.com/Azure/ARO-RP/pkg/operator"
@@ -27,35 +31,71 @@ import (
 	utilerror "github.com/Azure/ARO-RP/test/util/error"
 )
 
-type fakeChecker func(URLs []string) error
+type fakeChecker func(URLs []string, proxy *httpproxy.Config) []arov1alpha1.InternetCheckResult
 
-func (fc fakeChecker) Check(URLs []string) error {
-	return fc(URLs)
+func (fc fakeChecker) Check(URLs []string, proxy *httpproxy.Config) []arov1alpha1.InternetCheckResult {
+	return fc(URLs, proxy)
 }
 
 func TestReconcile(t *testing.T) {
 	ctx := context.Background()
 	urlsToCheck := []string{"https://fake-url-for-test-only.xyz"}
 
+	reachable := arov1alpha1.InternetCheckResult{
+		URL:        urlsToCheck[0],
+		Reachable:  true,
+		StatusCode: 200,
+	}
+	unreachable := arov1alpha1.InternetCheckResult{
+		URL:          urlsToCheck[0],
+		FailureClass: arov1alpha1.InternetCheckFailureDNS,
+		Message:      "fake error from checker",
+	}
+
 	tests := []struct {
 		name               string
 		controllerDisabled bool
-		checkerReturnErr   error
+		proxy              *configv1.Proxy
+		checkerResult      arov1alpha1.InternetCheckResult
+		wantProxy          *httpproxy.Config
 		wantCondition      operatorv1.ConditionStatus
+		wantMessage        string
+		wantResults        bool
 		wantErr            string
 		wantResult         reconcile.Result
 	}{
 		{
 			name:          "no errors",
+			checkerResult: reachable,
 			wantCondition: operatorv1.ConditionTrue,
+			wantMessage:   "Outgoing connection successful",
+			wantResults:   true,
 			wantResult:    reconcile.Result{RequeueAfter: time.Hour},
 		},
 		{
-			name:             "error making a request",
-			checkerReturnErr: errors.New("fake error from checker"),
-			wantCondition:    operatorv1.ConditionFalse,
-			wantErr:          "fake error from checker",
-			wantResult:       reconcile.Result{RequeueAfter: time.Hour},
+			name:          "error making a request",
+			checkerResult: unreachable,
+			wantCondition: operatorv1.ConditionFalse,
+			wantMessage:   "1 of 1 URLs unreachable: https://fake-url-for-test-only.xyz (DNS)",
+			wantResults:   true,
+			wantErr:       "fake error from checker",
+			wantResult:    reconcile.Result{RequeueAfter: time.Hour},
+		},
+		{
+			name: "cluster-wide proxy",
+			proxy: &configv1.Proxy{
+				ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+				Status: configv1.ProxyStatus{
+					HTTPSProxy: "http://proxy.example.com:3128",
+					NoProxy:    ".cluster.local",
+				},
+			},
+			checkerResult: reachable,
+			wantProxy:     &httpproxy.Config{HTTPSProxy: "http://proxy.example.com:3128", NoProxy: ".cluster.local"},
+			wantCondition: operatorv1.ConditionTrue,
+			wantMessage:   "Outgoing connection successful",
+			wantResults:   true,
+			wantResult:    reconcile.Result{RequeueAfter: time.Hour},
 		},
 		{
 			name:               "controller disabled",
@@ -91,17 +131,33 @@ func TestReconcile(t *testing.T) {
 						instance.Spec.OperatorFlags[operator.CheckerEnabled] = operator.FlagFalse
 					}
 
-					clientFake := testclienthelper.NewAROFakeClientBuilder(instance).Build()
+					// results of the other role and nodes are kept
+					otherResults := []arov1alpha1.InternetCheckResult{
+						{URL: urlsToCheck[0], Role: "other-role", Reachable: true},
+						{URL: urlsToCheck[0], Role: testRole, Node: "node", FailureClass: arov1alpha1.InternetCheckFailureTCP},
+					}
+					instance.Status.InternetChecker.Results = append([]arov1alpha1.InternetCheckResult{
+						{URL: "https://stale-result.xyz", Role: testRole, Reachable: true},
+					}, otherResults...)
+
+					builder := testclienthelper.NewAROFakeClientBuilder(instance)
+					if tt.proxy != nil {
+						builder = builder.WithObjects(tt.proxy)
+					}
+					clientFake := builder.Build()
 
 					r := &Reconciler{
 						log:  utillog.GetLogger(),
 						role: testRole,
-						checker: fakeChecker(func(URLs []string) error {
+						checker: fakeChecker(func(URLs []string, proxy *httpproxy.Config) []arov1alpha1.InternetCheckResult {
 							if !reflect.DeepEqual(urlsToCheck, URLs) {
 								t.Error(cmp.Diff(urlsToCheck, URLs))
 							}
+							if !reflect.DeepEqual(tt.wantProxy, proxy) {
+								t.Error(cmp.Diff(tt.wantProxy, proxy))
+							}
 
-							return tt.checkerReturnErr
+							return []arov1alpha1.InternetCheckResult{tt.checkerResult}
 						}),
 						client: clientFake,
 					}
@@ -125,11 +181,35 @@ func TestReconcile(t *testing.T) {
 					}
 					if condition == nil {
 						t.Fatal("no condition found")
-					} else if condition.Status != tt.wantCondition {
+					}
+					if condition.Status != tt.wantCondition {
 						t.Error(condition.Status)
 					}
+					if condition.Message != tt.wantMessage {
+						t.Error(condition.Message)
+					}
+
+					wantResults := otherResults
+					if tt.wantResults {
+						want := tt.checkerResult
+						want.Role = testRole
+						wantResults = append([]arov1alpha1.InternetCheckResult{want}, otherResults...)
+					}
+					gotResults := instance.Status.InternetChecker.Results
+					for i := range gotResults {
+						gotResults[i].LastCheckTime = metav1.Time{}
+					}
+					slices.SortFunc(gotResults, compareResults)
+					slices.SortFunc(wantResults, compareResults)
+					if !reflect.DeepEqual(wantResults, gotResults) {
+						t.Error(cmp.Diff(wantResults, gotResults))
+					}
 				})
 			}
 		})
 	}
 }
+
+func compareResults(a, b arov1alpha1.InternetCheckResult) int {
+	return strings.Compare(a.Role+"/"+a.Node+"/"+a.URL, b.Role+"/"+b.Node+"/"+b.URL)
+}
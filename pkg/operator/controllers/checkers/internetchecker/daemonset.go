@@ -0,0 +1,244 @@
+package internetchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/predicates"
+	"github.com/Azure/ARO-RP/pkg/util/clienthelper"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	"github.com/Azure/ARO-RP/pkg/util/pointerutils"
+)
+
+const (
+	DaemonSetControllerName = "InternetCheckerDaemonSet"
+
+	// daemonSetServiceAccountName is the service account of the internet
+	// checker DaemonSets, which need the same access as the worker operator
+	daemonSetServiceAccountName = "aro-operator-worker"
+
+	// operatorDeploymentName is the deployment whose image the internet
+	// checker DaemonSets run
+	operatorDeploymentName = "aro-operator-master"
+)
+
+// DaemonSetReconciler deploys an internet checker DaemonSet on the nodes of
+// each role if the aro.checker.internet.daemonset.enabled flag is set, and
+// removes the results of nodes which no longer run one
+type DaemonSetReconciler struct {
+	log *logrus.Entry
+
+	client client.Client
+	ch     clienthelper.Interface
+}
+
+func NewDaemonSetReconciler(log *logrus.Entry, client client.Client, ch clienthelper.Interface) *DaemonSetReconciler {
+	return &DaemonSetReconciler{
+		log: log,
+
+		client: client,
+		ch:     ch,
+	}
+}
+
+func daemonSetName(role string) string {
+	return "aro-internet-checker-" + role
+}
+
+func (r *DaemonSetReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	instance := &arov1alpha1.Cluster{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !instance.Spec.OperatorFlags.GetSimpleBoolean(operator.CheckerEnabled) ||
+		!instance.Spec.OperatorFlags.GetSimpleBoolean(operator.InternetCheckerDaemonSetEnabled) {
+		r.log.Debug("controller is disabled")
+		return reconcile.Result{}, r.remove(ctx)
+	}
+
+	r.log.Debug("running")
+	resources, err := r.resources(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = dynamichelper.SetControllerReferences(resources, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = dynamichelper.Prepare(resources)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = r.ch.Ensure(ctx, resources...)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// requeue to remove the results of deleted nodes
+	return reconcile.Result{RequeueAfter: time.Hour}, r.pruneResults(ctx)
+}
+
+// remove deletes the DaemonSets and the results of their checks
+func (r *DaemonSetReconciler) remove(ctx context.Context) error {
+	for _, role := range []string{operator.RoleMaster, operator.RoleWorker} {
+		err := r.ch.EnsureDeleted(ctx, appsv1.SchemeGroupVersion.WithKind("DaemonSet"), types.NamespacedName{Namespace: operator.Namespace, Name: daemonSetName(role)})
+		if err != nil {
+			return err
+		}
+	}
+
+	return updateResults(ctx, r.client, func(results []arov1alpha1.InternetCheckResult) []arov1alpha1.InternetCheckResult {
+		return slices.DeleteFunc(results, func(r arov1alpha1.InternetCheckResult) bool {
+			return r.Node != ""
+		})
+	})
+}
+
+// pruneResults removes the results of checks from nodes which no longer exist
+func (r *DaemonSetReconciler) pruneResults(ctx context.Context) error {
+	nodes := &corev1.NodeList{}
+	err := r.client.List(ctx, nodes)
+	if err != nil {
+		return err
+	}
+
+	exists := map[string]bool{}
+	for _, node := range nodes.Items {
+		exists[node.Name] = true
+	}
+
+	return updateResults(ctx, r.client, func(results []arov1alpha1.InternetCheckResult) []arov1alpha1.InternetCheckResult {
+		return slices.DeleteFunc(results, func(r arov1alpha1.InternetCheckResult) bool {
+			return r.Node != "" && !exists[r.Node]
+		})
+	})
+}
+
+func (r *DaemonSetReconciler) resources(ctx context.Context) ([]kruntime.Object, error) {
+	deployment := &appsv1.Deployment{}
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: operator.Namespace, Name: operatorDeploymentName}, deployment)
+	if err != nil {
+		return nil, err
+	}
+	if len(deployment.Spec.Template.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("deployment %s has no containers", operatorDeploymentName)
+	}
+	image := deployment.Spec.Template.Spec.Containers[0].Image
+
+	resourceCPU, err := resource.ParseQuantity("10m")
+	if err != nil {
+		return nil, err
+	}
+	resourceMemory, err := resource.ParseQuantity("50Mi")
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []kruntime.Object
+	for _, role := range []string{operator.RoleMaster, operator.RoleWorker} {
+		name := daemonSetName(role)
+
+		resources = append(resources, &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: operator.Namespace,
+			},
+			Spec: appsv1.DaemonSetSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": name},
+				},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"app": name},
+					},
+					Spec: corev1.PodSpec{
+						ServiceAccountName: daemonSetServiceAccountName,
+						Containers: []corev1.Container{
+							{
+								Name:    "internet-checker",
+								Image:   image,
+								Command: []string{"aro"},
+								Args:    []string{"operator", role, "internetchecker"},
+								Env: []corev1.EnvVar{
+									{
+										Name: nodeNameEnvVar,
+										ValueFrom: &corev1.EnvVarSource{
+											FieldRef: &corev1.ObjectFieldSelector{
+												FieldPath: "spec.nodeName",
+											},
+										},
+									},
+								},
+								Resources: corev1.ResourceRequirements{
+									Requests: corev1.ResourceList{
+										corev1.ResourceCPU:    resourceCPU,
+										corev1.ResourceMemory: resourceMemory,
+									},
+								},
+								SecurityContext: &corev1.SecurityContext{
+									AllowPrivilegeEscalation: pointerutils.ToPtr(false),
+									Capabilities: &corev1.Capabilities{
+										Drop: []corev1.Capability{"ALL"},
+									},
+									RunAsNonRoot: pointerutils.ToPtr(true),
+								},
+							},
+						},
+						NodeSelector: map[string]string{
+							"node-role.kubernetes.io/" + role: "",
+							"kubernetes.io/arch":              "amd64",
+						},
+						Tolerations: []corev1.Toleration{
+							{
+								Effect:   corev1.TaintEffectNoExecute,
+								Operator: corev1.TolerationOpExists,
+							},
+							{
+								Effect:   corev1.TaintEffectNoSchedule,
+								Operator: corev1.TolerationOpExists,
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return resources, nil
+}
+
+// SetupWithManager setup our manager
+func (r *DaemonSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(predicate.And(predicates.AROCluster, predicate.GenerationChangedPredicate{}))).
+		Owns(&appsv1.DaemonSet{}).
+		Named(DaemonSetControllerName).
+		Complete(r)
+}
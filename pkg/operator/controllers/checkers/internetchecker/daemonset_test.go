@@ -0,0 +1,163 @@
+package internetchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/clienthelper"
+	"github.com/Azure/ARO-RP/pkg/util/cmp"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	testclienthelper "github.com/Azure/ARO-RP/test/util/clienthelper"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestDaemonSetReconcile(t *testing.T) {
+	const image = "arosvc.azurecr.io/aro:latest"
+
+	operatorResult := arov1alpha1.InternetCheckResult{URL: "https://fake-url-for-test-only.xyz", Role: operator.RoleWorker, Reachable: true}
+	nodeResult := arov1alpha1.InternetCheckResult{URL: "https://fake-url-for-test-only.xyz", Role: operator.RoleWorker, Node: "worker-0", FailureClass: arov1alpha1.InternetCheckFailureTCP}
+	deletedNodeResult := arov1alpha1.InternetCheckResult{URL: "https://fake-url-for-test-only.xyz", Role: operator.RoleWorker, Node: "worker-1", FailureClass: arov1alpha1.InternetCheckFailureTCP}
+
+	for _, tt := range []struct {
+		name           string
+		flags          arov1alpha1.OperatorFlags
+		objects        []client.Object
+		wantDaemonSets bool
+		wantResults    []arov1alpha1.InternetCheckResult
+		wantResult     reconcile.Result
+		wantErr        string
+	}{
+		{
+			name: "enabled",
+			flags: arov1alpha1.OperatorFlags{
+				operator.CheckerEnabled:                  operator.FlagTrue,
+				operator.InternetCheckerDaemonSetEnabled: operator.FlagTrue,
+			},
+			objects: []client.Object{
+				&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-0"}},
+				&appsv1.Deployment{
+					ObjectMeta: metav1.ObjectMeta{Name: operatorDeploymentName, Namespace: operator.Namespace},
+					Spec: appsv1.DeploymentSpec{
+						Template: corev1.PodTemplateSpec{
+							Spec: corev1.PodSpec{
+								Containers: []corev1.Container{{Name: "aro-operator", Image: image}},
+							},
+						},
+					},
+				},
+			},
+			wantDaemonSets: true,
+			wantResults:    []arov1alpha1.InternetCheckResult{operatorResult, nodeResult},
+			wantResult:     reconcile.Result{RequeueAfter: time.Hour},
+		},
+		{
+			name: "no operator deployment",
+			flags: arov1alpha1.OperatorFlags{
+				operator.CheckerEnabled:                  operator.FlagTrue,
+				operator.InternetCheckerDaemonSetEnabled: operator.FlagTrue,
+			},
+			wantResults: []arov1alpha1.InternetCheckResult{operatorResult, nodeResult, deletedNodeResult},
+			wantErr:     `deployments.apps "aro-operator-master" not found`,
+		},
+		{
+			name: "disabled",
+			flags: arov1alpha1.OperatorFlags{
+				operator.CheckerEnabled:                  operator.FlagTrue,
+				operator.InternetCheckerDaemonSetEnabled: operator.FlagFalse,
+			},
+			objects: []client.Object{
+				&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: daemonSetName(operator.RoleMaster), Namespace: operator.Namespace}},
+				&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: daemonSetName(operator.RoleWorker), Namespace: operator.Namespace}},
+			},
+			wantResults: []arov1alpha1.InternetCheckResult{operatorResult},
+		},
+		{
+			name: "checker disabled",
+			flags: arov1alpha1.OperatorFlags{
+				operator.CheckerEnabled:                  operator.FlagFalse,
+				operator.InternetCheckerDaemonSetEnabled: operator.FlagTrue,
+			},
+			wantResults: []arov1alpha1.InternetCheckResult{operatorResult},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			instance := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+				Status: arov1alpha1.ClusterStatus{
+					InternetChecker: arov1alpha1.InternetCheckerStatus{
+						Results: []arov1alpha1.InternetCheckResult{operatorResult, nodeResult, deletedNodeResult},
+					},
+				},
+			}
+
+			clientFake := testclienthelper.NewAROFakeClientBuilder(append(tt.objects, instance)...).Build()
+			log := utillog.GetLogger()
+
+			r := NewDaemonSetReconciler(log, clientFake, clienthelper.NewWithClient(log, clientFake))
+
+			result, err := r.Reconcile(ctx, ctrl.Request{})
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if !reflect.DeepEqual(tt.wantResult, result) {
+				t.Error(cmp.Diff(tt.wantResult, result))
+			}
+
+			for _, role := range []string{operator.RoleMaster, operator.RoleWorker} {
+				ds := &appsv1.DaemonSet{}
+				err := clientFake.Get(ctx, types.NamespacedName{Namespace: operator.Namespace, Name: daemonSetName(role)}, ds)
+				if !tt.wantDaemonSets {
+					if !kerrors.IsNotFound(err) {
+						t.Errorf("%s: wanted no DaemonSet, got %v", role, err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				c := ds.Spec.Template.Spec.Containers[0]
+				if c.Image != image {
+					t.Errorf("%s: got image %s", role, c.Image)
+				}
+				if !reflect.DeepEqual(c.Args, []string{"operator", role, "internetchecker"}) {
+					t.Errorf("%s: got args %v", role, c.Args)
+				}
+				if _, ok := ds.Spec.Template.Spec.NodeSelector["node-role.kubernetes.io/"+role]; !ok {
+					t.Errorf("%s: got node selector %v", role, ds.Spec.Template.Spec.NodeSelector)
+				}
+			}
+
+			err = clientFake.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tt.wantResults, instance.Status.InternetChecker.Results) {
+				t.Error(cmp.Diff(tt.wantResults, instance.Status.InternetChecker.Results))
+			}
+		})
+	}
+}
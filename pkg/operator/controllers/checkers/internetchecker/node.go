@@ -0,0 +1,132 @@
+package internetchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// nodeNameEnvVar holds the name of the node an internet checker DaemonSet pod
+// runs on
+const nodeNameEnvVar = "NODE_NAME"
+
+var (
+	// nodeCheckInterval is how often the internet checker DaemonSets check
+	// the URLs
+	nodeCheckInterval = 10 * time.Minute
+
+	// nodeResultRefreshInterval is how often a node's failed checks are saved
+	// when they have not changed
+	nodeResultRefreshInterval = time.Hour
+)
+
+// NodeChecker checks the URLs from a single node, as an internet checker
+// DaemonSet pod. Only the URLs which cannot be reached are saved in the
+// cluster's status, so that healthy nodes do not write to it.
+type NodeChecker struct {
+	log  *logrus.Entry
+	role string
+	node string
+
+	checker internetChecker
+
+	client client.Client
+}
+
+func NewNodeChecker(log *logrus.Entry, client client.Client, role string) (*NodeChecker, error) {
+	node := os.Getenv(nodeNameEnvVar)
+	if node == "" {
+		return nil, fmt.Errorf("%s must be set", nodeNameEnvVar)
+	}
+
+	return &NodeChecker{
+		log:  log,
+		role: role,
+		node: node,
+
+		checker: newInternetChecker(),
+
+		client: client,
+	}, nil
+}
+
+// Run checks the URLs every nodeCheckInterval until ctx is done
+func (n *NodeChecker) Run(ctx context.Context) error {
+	t := time.NewTicker(nodeCheckInterval)
+	defer t.Stop()
+
+	for {
+		err := n.checkOnce(ctx)
+		if err != nil {
+			n.log.Error(err)
+		}
+
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (n *NodeChecker) checkOnce(ctx context.Context) error {
+	instance := &arov1alpha1.Cluster{}
+	err := n.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+	if err != nil {
+		return err
+	}
+
+	if !instance.Spec.OperatorFlags.GetSimpleBoolean(operator.CheckerEnabled) {
+		n.log.Debug("checker is disabled")
+		return nil
+	}
+
+	proxy, err := clusterProxy(ctx, n.client)
+	if err != nil {
+		return err
+	}
+
+	failed := unreachable(n.checker.Check(instance.Spec.InternetChecker.URLs, proxy))
+	now := metav1.Now()
+	for i := range failed {
+		failed[i].Role = n.role
+		failed[i].Node = n.node
+		failed[i].LastCheckTime = now
+	}
+
+	saved := slices.DeleteFunc(slices.Clone(instance.Status.InternetChecker.Results), func(r arov1alpha1.InternetCheckResult) bool {
+		return r.Role != n.role || r.Node != n.node
+	})
+	if sameFailures(saved, failed) && (len(saved) == 0 || now.Sub(saved[0].LastCheckTime.Time) < nodeResultRefreshInterval) {
+		return nil
+	}
+
+	if len(failed) > 0 {
+		n.log.Warnf("%d URLs unreachable from node %s", len(failed), n.node)
+	}
+
+	return setResults(ctx, n.client, n.role, n.node, failed)
+}
+
+// sameFailures returns whether a and b are failures of the same URLs for the
+// same reasons
+func sameFailures(a, b []arov1alpha1.InternetCheckResult) bool {
+	return slices.EqualFunc(a, b, func(a, b arov1alpha1.InternetCheckResult) bool {
+		return a.URL == b.URL && a.FailureClass == b.FailureClass && a.StatusCode == b.StatusCode && a.Proxy == b.Proxy
+	})
+}
@@ -0,0 +1,150 @@
+package internetchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http/httpproxy"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	testclienthelper "github.com/Azure/ARO-RP/test/util/clienthelper"
+)
+
+func TestNodeCheckerCheckOnce(t *testing.T) {
+	const url = "https://fake-url-for-test-only.xyz"
+
+	reachable := arov1alpha1.InternetCheckResult{URL: url, Reachable: true, StatusCode: 200}
+	unreachable := arov1alpha1.InternetCheckResult{URL: url, FailureClass: arov1alpha1.InternetCheckFailureDNS, Message: "no such host"}
+
+	now := metav1.Now()
+	saved := arov1alpha1.InternetCheckResult{URL: url, Role: operator.RoleWorker, Node: "worker-0", FailureClass: arov1alpha1.InternetCheckFailureDNS, Message: "no such host", LastCheckTime: now}
+	operatorResult := arov1alpha1.InternetCheckResult{URL: url, Role: operator.RoleWorker, Reachable: true}
+
+	for _, tt := range []struct {
+		name        string
+		saved       []arov1alpha1.InternetCheckResult
+		result      arov1alpha1.InternetCheckResult
+		wantUpdated bool
+		wantSaved   *arov1alpha1.InternetCheckResult
+	}{
+		{
+			name:   "healthy node does not write",
+			result: reachable,
+		},
+		{
+			name:        "failure is saved",
+			result:      unreachable,
+			wantUpdated: true,
+			wantSaved:   &saved,
+		},
+		{
+			name:      "unchanged failure is not saved again",
+			saved:     []arov1alpha1.InternetCheckResult{saved},
+			result:    unreachable,
+			wantSaved: &saved,
+		},
+		{
+			name: "unchanged failure is refreshed",
+			saved: []arov1alpha1.InternetCheckResult{func() arov1alpha1.InternetCheckResult {
+				old := saved
+				old.LastCheckTime = metav1.NewTime(now.Add(-2 * time.Hour))
+				return old
+			}()},
+			result:      unreachable,
+			wantUpdated: true,
+			wantSaved:   &saved,
+		},
+		{
+			name:        "recovered node removes its failures",
+			saved:       []arov1alpha1.InternetCheckResult{saved},
+			result:      reachable,
+			wantUpdated: true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			instance := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					InternetChecker: arov1alpha1.InternetCheckerSpec{
+						URLs: []string{url},
+					},
+					OperatorFlags: arov1alpha1.OperatorFlags{
+						operator.CheckerEnabled: operator.FlagTrue,
+					},
+				},
+				Status: arov1alpha1.ClusterStatus{
+					InternetChecker: arov1alpha1.InternetCheckerStatus{
+						Results: append([]arov1alpha1.InternetCheckResult{operatorResult}, tt.saved...),
+					},
+				},
+			}
+
+			clientFake := testclienthelper.NewAROFakeClientBuilder(instance).WithStatusSubresource(instance).Build()
+
+			err := clientFake.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resourceVersion := instance.ResourceVersion
+
+			n := &NodeChecker{
+				log:  utillog.GetLogger(),
+				role: operator.RoleWorker,
+				node: "worker-0",
+				checker: fakeChecker(func(URLs []string, proxy *httpproxy.Config) []arov1alpha1.InternetCheckResult {
+					return []arov1alpha1.InternetCheckResult{tt.result}
+				}),
+				client: clientFake,
+			}
+
+			err = n.checkOnce(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = clientFake.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			updated := instance.ResourceVersion != resourceVersion
+			if updated != tt.wantUpdated {
+				t.Errorf("got updated %t, wanted %t", updated, tt.wantUpdated)
+			}
+
+			results := instance.Status.InternetChecker.Results
+			if results[0] != operatorResult {
+				t.Errorf("operator result changed to %v", results[0])
+			}
+
+			var got *arov1alpha1.InternetCheckResult
+			if len(results) > 1 {
+				got = &results[1]
+			}
+			switch {
+			case tt.wantSaved == nil && got != nil:
+				t.Errorf("unexpected saved result %v", got)
+			case tt.wantSaved != nil && got == nil:
+				t.Error("no saved result")
+			case tt.wantSaved != nil:
+				if got.URL != tt.wantSaved.URL || got.FailureClass != tt.wantSaved.FailureClass || got.Node != tt.wantSaved.Node ||
+					now.Sub(got.LastCheckTime.Time) > time.Minute {
+					t.Errorf("got saved result %v, wanted %v", got, tt.wantSaved)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package internetchecker
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"golang.org/x/net/http/httpproxy"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+)
+
+// clusterProxy returns the configuration of the cluster-wide proxy, or nil if
+// there is none
+func clusterProxy(ctx context.Context, c client.Client) (*httpproxy.Config, error) {
+	proxy := &configv1.Proxy{}
+	err := c.Get(ctx, types.NamespacedName{Name: "cluster"}, proxy)
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if proxy.Status.HTTPProxy == "" && proxy.Status.HTTPSProxy == "" {
+		return nil, nil
+	}
+
+	return &httpproxy.Config{
+		HTTPProxy:  proxy.Status.HTTPProxy,
+		HTTPSProxy: proxy.Status.HTTPSProxy,
+		NoProxy:    proxy.Status.NoProxy,
+	}, nil
+}
+
+// summarize returns a message summarizing the URLs in results which could not
+// be reached, and an error detailing why, or nil if they all could be
+func summarize(results []arov1alpha1.InternetCheckResult) (string, error) {
+	var unreachable, errs []string
+	for _, r := range results {
+		if r.Reachable {
+			continue
+		}
+		unreachable = append(unreachable, fmt.Sprintf("%s (%s)", r.URL, r.FailureClass))
+		errs = append(errs, r.Message)
+	}
+
+	if len(unreachable) == 0 {
+		return "", nil
+	}
+
+	message := fmt.Sprintf("%d of %d URLs unreachable: %s", len(unreachable), len(results), strings.Join(unreachable, ", "))
+	return message, errors.New(strings.Join(errs, "\n"))
+}
+
+// unreachable returns the results of URLs which could not be reached
+func unreachable(results []arov1alpha1.InternetCheckResult) []arov1alpha1.InternetCheckResult {
+	return slices.DeleteFunc(slices.Clone(results), func(r arov1alpha1.InternetCheckResult) bool {
+		return r.Reachable
+	})
+}
+
+// updateResults replaces the internet checker results in the cluster's
+// status with those returned by f, if they differ
+func updateResults(ctx context.Context, c client.Client, f func([]arov1alpha1.InternetCheckResult) []arov1alpha1.InternetCheckResult) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster := &arov1alpha1.Cluster{}
+		err := c.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, cluster)
+		if err != nil {
+			return err
+		}
+
+		results := f(slices.Clone(cluster.Status.InternetChecker.Results))
+		slices.SortStableFunc(results, func(a, b arov1alpha1.InternetCheckResult) int {
+			return cmp.Or(
+				cmp.Compare(a.Role, b.Role),
+				cmp.Compare(a.Node, b.Node),
+			)
+		})
+
+		if equality.Semantic.DeepEqual(results, cluster.Status.InternetChecker.Results) {
+			return nil
+		}
+
+		cluster.Status.InternetChecker.Results = results
+		return c.Status().Update(ctx, cluster)
+	})
+}
+
+// setResults replaces the results of the checks from role and node with
+// results
+func setResults(ctx context.Context, c client.Client, role, node string, results []arov1alpha1.InternetCheckResult) error {
+	return updateResults(ctx, c, func(existing []arov1alpha1.InternetCheckResult) []arov1alpha1.InternetCheckResult {
+		existing = slices.DeleteFunc(existing, func(r arov1alpha1.InternetCheckResult) bool {
+			return r.Role == role && r.Node == node
+		})
+		return append(existing, results...)
+	})
+}
@@ -0,0 +1,258 @@
+package etcdbackup
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/predicates"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+)
+
+const (
+	ControllerName = "EtcdBackup"
+
+	controllerIntervalHours = "aro.etcdbackup.intervalHours"
+	controllerRetention     = "aro.etcdbackup.retention"
+
+	defaultIntervalHours = "24"
+	defaultRetention     = "7"
+
+	// failedRetryInterval is how long to wait before retrying a backup Job
+	// which failed
+	failedRetryInterval = time.Hour
+
+	// workloadIdentityUnsupportedMessage is recorded in the cluster's status
+	// instead of backing up on clusters using workload identity
+	workloadIdentityUnsupportedMessage = "etcd backups are not supported on clusters using workload identity: the cluster storage account does not allow shared key access, and the operator's identity has no blob data role on it"
+)
+
+// Reconciler backs up etcd to the cluster storage account every
+// aro.etcdbackup.intervalHours if the aro.etcdbackup.enabled flag is set. Each
+// backup is taken by a Job on a master node, which records it in the
+// cluster's status once uploaded.
+type Reconciler struct {
+	log *logrus.Entry
+
+	client client.Client
+
+	usesWorkloadIdentity bool
+	now                  func() time.Time
+}
+
+func NewReconciler(log *logrus.Entry, client client.Client) *Reconciler {
+	return &Reconciler{
+		log: log,
+
+		client: client,
+
+		usesWorkloadIdentity: usesWorkloadIdentity(),
+		now:                  time.Now,
+	}
+}
+
+// Interval returns how often etcd is backed up
+func Interval(flags arov1alpha1.OperatorFlags) time.Duration {
+	hours, err := strconv.Atoi(flags.GetWithDefault(controllerIntervalHours, defaultIntervalHours))
+	if err != nil || hours <= 0 {
+		hours, _ = strconv.Atoi(defaultIntervalHours)
+	}
+	return time.Duration(hours) * time.Hour
+}
+
+// retention returns how many backups are kept in the cluster storage account
+func retention(flags arov1alpha1.OperatorFlags) int {
+	n, err := strconv.Atoi(flags.GetWithDefault(controllerRetention, defaultRetention))
+	if err != nil || n <= 0 {
+		n, _ = strconv.Atoi(defaultRetention)
+	}
+	return n
+}
+
+func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	instance := &arov1alpha1.Cluster{}
+	err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	jobs, err := r.jobs(ctx)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !instance.Spec.OperatorFlags.GetSimpleBoolean(operator.EtcdBackupEnabled) {
+		r.log.Debug("controller is disabled")
+		return reconcile.Result{}, errors.Join(r.deleteJobs(ctx, jobs), r.setMessage(ctx, ""))
+	}
+
+	// the Jobs would fail to upload, so don't take backups and say why
+	if r.usesWorkloadIdentity {
+		r.log.Debug(workloadIdentityUnsupportedMessage)
+		return reconcile.Result{}, errors.Join(r.deleteJobs(ctx, jobs), r.setMessage(ctx, workloadIdentityUnsupportedMessage))
+	}
+
+	r.log.Debug("running")
+	err = r.setMessage(ctx, "")
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	// the RP deploys the key from the cluster document, which keeps it so that
+	// the backups can be decrypted without the cluster
+	err = r.client.Get(ctx, types.NamespacedName{Namespace: operator.Namespace, Name: operator.EtcdBackupKeySecretName}, &corev1.Secret{})
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("getting backup encryption key: %w", err)
+	}
+
+	// keep the latest Job, so that its outcome can be inspected
+	var latest *batchv1.Job
+	if len(jobs) > 0 {
+		latest = &jobs[0]
+		err = r.deleteJobs(ctx, slices.DeleteFunc(jobs[1:], func(job batchv1.Job) bool { return !finished(&job) }))
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	// the Job is watched, so we will be called again once it finishes
+	if latest != nil && !finished(latest) {
+		return reconcile.Result{}, nil
+	}
+
+	// the cached status may not yet show the backup the latest Job recorded
+	last := instance.Status.EtcdBackup.LastBackupTime.Time
+	if latest != nil && !failed(latest) && latest.CreationTimestamp.After(last) {
+		last = latest.CreationTimestamp.Time
+	}
+
+	due := last.Add(Interval(instance.Spec.OperatorFlags))
+	if latest != nil && failed(latest) {
+		retry := latest.CreationTimestamp.Add(failedRetryInterval)
+		if retry.After(due) {
+			due = retry
+		}
+	}
+
+	now := r.now()
+	if now.Before(due) {
+		return reconcile.Result{RequeueAfter: due.Sub(now)}, nil
+	}
+
+	job, err := r.job(ctx, now)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	err = dynamichelper.SetControllerReferences([]kruntime.Object{job}, instance)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	r.log.Infof("creating backup job %s", job.Name)
+	err = r.client.Create(ctx, job)
+	if err != nil && !kerrors.IsAlreadyExists(err) {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// jobs returns the backup Jobs, latest first
+func (r *Reconciler) jobs(ctx context.Context) ([]batchv1.Job, error) {
+	jobs := &batchv1.JobList{}
+	err := r.client.List(ctx, jobs, client.InNamespace(operator.Namespace), client.MatchingLabels{jobLabel: jobLabelValue})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(jobs.Items, func(a, b batchv1.Job) int {
+		if c := b.CreationTimestamp.Compare(a.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		return strings.Compare(b.Name, a.Name)
+	})
+
+	return jobs.Items, nil
+}
+
+func (r *Reconciler) deleteJobs(ctx context.Context, jobs []batchv1.Job) error {
+	for i := range jobs {
+		err := r.client.Delete(ctx, &jobs[i], client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setMessage records why etcd is not being backed up in the cluster's status,
+// or clears it
+func (r *Reconciler) setMessage(ctx context.Context, message string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster := &arov1alpha1.Cluster{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, cluster)
+		if err != nil {
+			return err
+		}
+
+		if cluster.Status.EtcdBackup.Message == message {
+			return nil
+		}
+
+		cluster.Status.EtcdBackup.Message = message
+		return r.client.Status().Update(ctx, cluster)
+	})
+}
+
+func finished(job *batchv1.Job) bool {
+	return jobCondition(job, batchv1.JobComplete) || jobCondition(job, batchv1.JobFailed)
+}
+
+func failed(job *batchv1.Job) bool {
+	return jobCondition(job, batchv1.JobFailed)
+}
+
+func jobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == conditionType && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// SetupWithManager setup our manager
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&arov1alpha1.Cluster{}, builder.WithPredicates(predicate.And(predicates.AROCluster, predicate.GenerationChangedPredicate{}))).
+		Owns(&batchv1.Job{}).
+		Named(ControllerName).
+		Complete(r)
+}
@@ -0,0 +1,344 @@
+package etcdbackup
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/cmp"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	testclienthelper "github.com/Azure/ARO-RP/test/util/clienthelper"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestReconcile(t *testing.T) {
+	const image = "arosvc.azurecr.io/aro:latest"
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: operatorDeploymentName, Namespace: operator.Namespace},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					ServiceAccountName: "aro-operator-master",
+					Containers: []corev1.Container{
+						{
+							Name:  "aro-operator",
+							Image: image,
+							Env:   []corev1.EnvVar{{Name: "AZURE_CLIENT_ID", Value: "client-id"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	job := func(created time.Time, conditionType batchv1.JobConditionType) *batchv1.Job {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              jobName(created),
+				Namespace:         operator.Namespace,
+				Labels:            map[string]string{jobLabel: jobLabelValue},
+				CreationTimestamp: metav1.NewTime(created),
+			},
+		}
+		if conditionType != "" {
+			job.Status.Conditions = []batchv1.JobCondition{{Type: conditionType, Status: corev1.ConditionTrue}}
+		}
+		return job
+	}
+
+	key := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: operator.EtcdBackupKeySecretName, Namespace: operator.Namespace},
+		Data:       map[string][]byte{operator.EtcdBackupKeySecretKey: []byte("0123456789abcdef0123456789abcdef")},
+	}
+
+	enabled := arov1alpha1.OperatorFlags{operator.EtcdBackupEnabled: operator.FlagTrue}
+
+	for _, tt := range []struct {
+		name           string
+		flags          arov1alpha1.OperatorFlags
+		lastBackupTime time.Time
+		objects        []client.Object
+		noKey          bool
+		workloadID     bool
+		message        string
+		wantJobs       []string
+		wantMessage    string
+		wantResult     reconcile.Result
+		wantErr        string
+	}{
+		{
+			name:    "disabled",
+			flags:   arov1alpha1.OperatorFlags{operator.EtcdBackupEnabled: operator.FlagFalse},
+			message: workloadIdentityUnsupportedMessage,
+			objects: []client.Object{
+				job(now.Add(-time.Hour), ""),
+				job(now.Add(-25*time.Hour), batchv1.JobComplete),
+			},
+		},
+		{
+			name:       "workload identity",
+			flags:      enabled,
+			workloadID: true,
+			objects: []client.Object{
+				deployment,
+				job(now.Add(-time.Hour), ""),
+			},
+			noKey:       true,
+			wantMessage: workloadIdentityUnsupportedMessage,
+		},
+		{
+			name:       "never backed up",
+			flags:      enabled,
+			message:    workloadIdentityUnsupportedMessage,
+			objects:    []client.Object{deployment},
+			wantJobs:   []string{jobName(now)},
+			wantResult: reconcile.Result{},
+		},
+		{
+			name:           "backup not due",
+			flags:          enabled,
+			lastBackupTime: now.Add(-20 * time.Hour),
+			objects:        []client.Object{deployment},
+			wantResult:     reconcile.Result{RequeueAfter: 4 * time.Hour},
+		},
+		{
+			name:           "backup due with a custom interval",
+			flags:          arov1alpha1.OperatorFlags{operator.EtcdBackupEnabled: operator.FlagTrue, controllerIntervalHours: "12"},
+			lastBackupTime: now.Add(-20 * time.Hour),
+			objects:        []client.Object{deployment},
+			wantJobs:       []string{jobName(now)},
+		},
+		{
+			name:           "backup running",
+			flags:          enabled,
+			lastBackupTime: now.Add(-48 * time.Hour),
+			objects: []client.Object{
+				deployment,
+				job(now.Add(-10*time.Minute), ""),
+				job(now.Add(-48*time.Hour), batchv1.JobComplete),
+			},
+			wantJobs: []string{jobName(now.Add(-10 * time.Minute))},
+		},
+		{
+			name:           "backup completed but not yet seen in the status",
+			flags:          enabled,
+			lastBackupTime: now.Add(-48 * time.Hour),
+			objects: []client.Object{
+				deployment,
+				job(now.Add(-10*time.Minute), batchv1.JobComplete),
+			},
+			wantJobs:   []string{jobName(now.Add(-10 * time.Minute))},
+			wantResult: reconcile.Result{RequeueAfter: 24*time.Hour - 10*time.Minute},
+		},
+		{
+			name:           "backup failed recently",
+			flags:          enabled,
+			lastBackupTime: now.Add(-48 * time.Hour),
+			objects: []client.Object{
+				deployment,
+				job(now.Add(-10*time.Minute), batchv1.JobFailed),
+			},
+			wantJobs:   []string{jobName(now.Add(-10 * time.Minute))},
+			wantResult: reconcile.Result{RequeueAfter: 50 * time.Minute},
+		},
+		{
+			name:           "backup failed a while ago",
+			flags:          enabled,
+			lastBackupTime: now.Add(-48 * time.Hour),
+			objects: []client.Object{
+				deployment,
+				job(now.Add(-2*time.Hour), batchv1.JobFailed),
+				job(now.Add(-48*time.Hour), batchv1.JobComplete),
+			},
+			wantJobs: []string{jobName(now), jobName(now.Add(-2 * time.Hour))},
+		},
+		{
+			name:    "no operator deployment",
+			flags:   enabled,
+			wantErr: `deployments.apps "aro-operator-master" not found`,
+		},
+		{
+			name:    "no encryption key",
+			flags:   enabled,
+			objects: []client.Object{deployment},
+			noKey:   true,
+			wantErr: `getting backup encryption key: secrets "aro-etcd-backup-encryption-key" not found`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			instance := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+				Status: arov1alpha1.ClusterStatus{
+					EtcdBackup: arov1alpha1.EtcdBackupStatus{
+						LastBackupTime: metav1.NewTime(tt.lastBackupTime),
+						Message:        tt.message,
+					},
+				},
+			}
+
+			objects := append(tt.objects, instance)
+			if !tt.noKey {
+				objects = append(objects, key)
+			}
+			clientFake := testclienthelper.NewAROFakeClientBuilder(objects...).Build()
+
+			r := NewReconciler(utillog.GetLogger(), clientFake)
+			r.usesWorkloadIdentity = tt.workloadID
+			r.now = func() time.Time { return now }
+
+			result, err := r.Reconcile(ctx, ctrl.Request{})
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if !reflect.DeepEqual(tt.wantResult, result) {
+				t.Error(cmp.Diff(tt.wantResult, result))
+			}
+
+			jobs := &batchv1.JobList{}
+			err = clientFake.List(ctx, jobs, client.InNamespace(operator.Namespace))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var names []string
+			for _, job := range jobs.Items {
+				names = append(names, job.Name)
+			}
+			slices.Sort(names)
+			slices.Sort(tt.wantJobs)
+			if !reflect.DeepEqual(tt.wantJobs, names) {
+				t.Error(cmp.Diff(tt.wantJobs, names))
+			}
+
+			err = clientFake.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if instance.Status.EtcdBackup.Message != tt.wantMessage {
+				t.Errorf("got message %q, wanted %q", instance.Status.EtcdBackup.Message, tt.wantMessage)
+			}
+
+		})
+	}
+}
+
+func TestJob(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: operatorDeploymentName, Namespace: operator.Namespace},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					ServiceAccountName: "aro-operator-master",
+					Containers: []corev1.Container{
+						{
+							Name:         "aro-operator",
+							Image:        "arosvc.azurecr.io/aro:latest",
+							Env:          []corev1.EnvVar{{Name: "AZURE_FEDERATED_TOKEN_FILE", Value: "/var/run/secrets/openshift/serviceaccount/token"}},
+							VolumeMounts: []corev1.VolumeMount{{Name: "bound-sa-token", MountPath: "/var/run/secrets/openshift/serviceaccount"}},
+						},
+					},
+					Volumes: []corev1.Volume{{Name: "bound-sa-token"}},
+				},
+			},
+		},
+	}
+
+	clientFake := testclienthelper.NewAROFakeClientBuilder(deployment).Build()
+
+	job, err := NewReconciler(utillog.GetLogger(), clientFake).job(ctx, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if job.Name != "aro-etcd-backup-1767323045" {
+		t.Errorf("got name %s", job.Name)
+	}
+
+	spec := job.Spec.Template.Spec
+	if spec.ServiceAccountName != "aro-operator-master" {
+		t.Errorf("got service account %s", spec.ServiceAccountName)
+	}
+	if _, ok := spec.NodeSelector["node-role.kubernetes.io/master"]; !ok {
+		t.Errorf("got node selector %v", spec.NodeSelector)
+	}
+
+	var volumes []string
+	for _, v := range spec.Volumes {
+		volumes = append(volumes, v.Name)
+	}
+	if !reflect.DeepEqual(volumes, []string{"host", backupVolumeName, "bound-sa-token"}) {
+		t.Errorf("got volumes %v", volumes)
+	}
+
+	upload := spec.Containers[0]
+	if !reflect.DeepEqual(upload.Args, []string{"operator", operator.RoleMaster, "etcdbackup"}) {
+		t.Errorf("got args %v", upload.Args)
+	}
+	wantEnv := []corev1.EnvVar{
+		{Name: backupDirEnvVar, Value: backupMountPath},
+		{Name: "AZURE_FEDERATED_TOKEN_FILE", Value: "/var/run/secrets/openshift/serviceaccount/token"},
+	}
+	if !reflect.DeepEqual(upload.Env, wantEnv) {
+		t.Error(cmp.Diff(wantEnv, upload.Env))
+	}
+	if len(upload.VolumeMounts) != 2 || !upload.VolumeMounts[0].ReadOnly {
+		t.Errorf("got volume mounts %v", upload.VolumeMounts)
+	}
+}
+
+func TestInterval(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		flags arov1alpha1.OperatorFlags
+		want  time.Duration
+	}{
+		{
+			name: "default",
+			want: 24 * time.Hour,
+		},
+		{
+			name:  "set",
+			flags: arov1alpha1.OperatorFlags{controllerIntervalHours: "6"},
+			want:  6 * time.Hour,
+		},
+		{
+			name:  "invalid",
+			flags: arov1alpha1.OperatorFlags{controllerIntervalHours: "0"},
+			want:  24 * time.Hour,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Interval(tt.flags)
+			if got != tt.want {
+				t.Errorf("got %v, wanted %v", got, tt.want)
+			}
+		})
+	}
+}
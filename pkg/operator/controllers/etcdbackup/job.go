@@ -0,0 +1,168 @@
+package etcdbackup
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	"github.com/Azure/ARO-RP/pkg/util/pointerutils"
+)
+
+const (
+	jobLabel      = "app"
+	jobLabelValue = "aro-etcd-backup"
+
+	// operatorDeploymentName is the deployment whose image, credentials and
+	// service account the backup Jobs use
+	operatorDeploymentName = "aro-operator-master"
+
+	// jobDeadline is how long a backup Job may run before it is failed
+	jobDeadline = time.Hour
+
+	backupVolumeName = "backup"
+	backupMountPath  = "/backup"
+
+	// hostBackupDir is where cluster-backup.sh writes the snapshot on the
+	// master node, before it is moved into the backup volume
+	hostBackupDir = "/var/lib/aro-etcd-backup"
+)
+
+// snapshotScript takes a snapshot of etcd and of the static pod resources on
+// the master node, using the same script as the OpenShift backup procedure
+var snapshotScript = fmt.Sprintf(`set -euxo pipefail
+rm -rf /host%[1]s
+chroot /host /usr/local/bin/cluster-backup.sh %[1]s
+mv /host%[1]s/* %[2]s/
+chmod -R a+r %[2]s
+rm -rf /host%[1]s
+`, hostBackupDir, backupMountPath)
+
+func jobName(t time.Time) string {
+	return jobLabelValue + "-" + strconv.FormatInt(t.Unix(), 10)
+}
+
+// job returns a Job which snapshots etcd on a master node in an init
+// container, then uploads the snapshot with the operator's credentials
+func (r *Reconciler) job(ctx context.Context, now time.Time) (*batchv1.Job, error) {
+	deployment := &appsv1.Deployment{}
+	err := r.client.Get(ctx, types.NamespacedName{Namespace: operator.Namespace, Name: operatorDeploymentName}, deployment)
+	if err != nil {
+		return nil, err
+	}
+	if len(deployment.Spec.Template.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("deployment %s has no containers", operatorDeploymentName)
+	}
+	operatorContainer := deployment.Spec.Template.Spec.Containers[0]
+
+	volumes := append([]corev1.Volume{
+		{
+			Name: "host",
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{
+					Path: "/",
+				},
+			},
+		},
+		{
+			Name: backupVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		},
+	}, deployment.Spec.Template.Spec.Volumes...)
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName(now),
+			Namespace: operator.Namespace,
+			Labels:    map[string]string{jobLabel: jobLabelValue},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          pointerutils.ToPtr(int32(0)),
+			ActiveDeadlineSeconds: pointerutils.ToPtr(int64(jobDeadline.Seconds())),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{jobLabel: jobLabelValue},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: deployment.Spec.Template.Spec.ServiceAccountName,
+					InitContainers: []corev1.Container{
+						{
+							Name:    "snapshot",
+							Image:   operatorContainer.Image,
+							Command: []string{"/bin/bash", "-c", snapshotScript},
+							SecurityContext: &corev1.SecurityContext{
+								Privileged: pointerutils.ToPtr(true),
+								RunAsUser:  pointerutils.ToPtr(int64(0)),
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "host",
+									MountPath: "/host",
+								},
+								{
+									Name:      backupVolumeName,
+									MountPath: backupMountPath,
+								},
+							},
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name:    "upload",
+							Image:   operatorContainer.Image,
+							Command: []string{"aro"},
+							Args:    []string{"operator", operator.RoleMaster, "etcdbackup"},
+							Env: append([]corev1.EnvVar{
+								{
+									Name:  backupDirEnvVar,
+									Value: backupMountPath,
+								},
+							}, operatorContainer.Env...),
+							VolumeMounts: append([]corev1.VolumeMount{
+								{
+									Name:      backupVolumeName,
+									MountPath: backupMountPath,
+									ReadOnly:  true,
+								},
+							}, operatorContainer.VolumeMounts...),
+							SecurityContext: &corev1.SecurityContext{
+								AllowPrivilegeEscalation: pointerutils.ToPtr(false),
+								Capabilities: &corev1.Capabilities{
+									Drop: []corev1.Capability{"ALL"},
+								},
+								RunAsNonRoot: pointerutils.ToPtr(true),
+							},
+						},
+					},
+					Volumes: volumes,
+					NodeSelector: map[string]string{
+						"node-role.kubernetes.io/master": "",
+					},
+					Tolerations: []corev1.Toleration{
+						{
+							Effect:   corev1.TaintEffectNoExecute,
+							Operator: corev1.TolerationOpExists,
+						},
+						{
+							Effect:   corev1.TaintEffectNoSchedule,
+							Operator: corev1.TolerationOpExists,
+						},
+					},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,335 @@
+package etcdbackup
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/storage/armstorage"
+	sdkazblob "github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/azuresdk/azblob"
+	"github.com/Azure/ARO-RP/pkg/util/pointerutils"
+	"github.com/Azure/ARO-RP/pkg/util/storage"
+	"github.com/Azure/ARO-RP/pkg/util/stringutils"
+)
+
+const (
+	// backupDirEnvVar holds the directory the backup Job's snapshot is in
+	backupDirEnvVar = "BACKUP_DIR"
+
+	// containerName is the container in the cluster storage account which
+	// holds the backups. The blobs of each backup are named
+	// <backup name>/<file name>.
+	containerName = "etcd-backups"
+
+	// backupNameLayout names backups by when they were uploaded, so that they
+	// sort in the order they were taken
+	backupNameLayout = "20060102T150405Z"
+)
+
+// Uploader uploads the snapshot taken by a backup Job to the cluster storage
+// account, removes the oldest backups beyond aro.etcdbackup.retention and
+// records the backup in the cluster's status
+type Uploader struct {
+	log *logrus.Entry
+	dir string
+
+	newBlobsClient func(context.Context, *arov1alpha1.Cluster) (azblob.BlobsClient, error)
+	now            func() time.Time
+
+	client client.Client
+}
+
+func NewUploader(log *logrus.Entry, client client.Client) (*Uploader, error) {
+	dir := os.Getenv(backupDirEnvVar)
+	if dir == "" {
+		return nil, fmt.Errorf("%s must be set", backupDirEnvVar)
+	}
+
+	return &Uploader{
+		log: log,
+		dir: dir,
+
+		newBlobsClient: newBlobsClient,
+		now:            time.Now,
+
+		client: client,
+	}, nil
+}
+
+// newBlobsClient returns a client for the cluster storage account, using the
+// operator's credentials
+func newBlobsClient(ctx context.Context, instance *arov1alpha1.Cluster) (azblob.BlobsClient, error) {
+	azEnv, err := azureclient.EnvironmentFromName(instance.Spec.AZEnvironment)
+	if err != nil {
+		return nil, err
+	}
+
+	resource, err := arm.ParseResourceID(instance.Spec.ResourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenCredential, err := azEnv.NewTokenCredential()
+	if err != nil {
+		return nil, err
+	}
+
+	manager, err := storage.NewManager(resource.SubscriptionID, azEnv.StorageEndpointSuffix, tokenCredential, usesWorkloadIdentity(), azEnv.ArmClientOptions())
+	if err != nil {
+		return nil, err
+	}
+
+	resourceGroup := stringutils.LastTokenByte(instance.Spec.ClusterResourceGroupID, '/')
+	return manager.BlobService(ctx, resourceGroup, "cluster"+instance.Spec.StorageSuffix, armstorage.Permissions("rwdlc"), armstorage.SignedResourceTypes("co"))
+}
+
+// usesWorkloadIdentity returns whether the operator authenticates with
+// workload identity, which its deployment configures with a federated token
+func usesWorkloadIdentity() bool {
+	return os.Getenv("AZURE_FEDERATED_TOKEN_FILE") != ""
+}
+
+func (u *Uploader) Run(ctx context.Context) error {
+	instance := &arov1alpha1.Cluster{}
+	err := u.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+	if err != nil {
+		return err
+	}
+
+	cpk, err := u.cpkInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	files, err := u.files()
+	if err != nil {
+		return err
+	}
+
+	blobs, err := u.newBlobsClient(ctx, instance)
+	if err != nil {
+		return err
+	}
+
+	err = blobs.CreateContainer(ctx, containerName)
+	if err != nil {
+		return err
+	}
+
+	now := u.now().UTC().Truncate(time.Second)
+	name := now.Format(backupNameLayout)
+	for _, file := range files {
+		err = u.upload(ctx, blobs, cpk, file, name+"/"+file)
+		if err != nil {
+			return err
+		}
+	}
+	u.log.Infof("uploaded backup %s", name)
+
+	retained, err := u.prune(ctx, blobs, retention(instance.Spec.OperatorFlags))
+	if err != nil {
+		return err
+	}
+
+	return u.setStatus(ctx, arov1alpha1.EtcdBackupStatus{
+		LastBackupTime:  metav1.NewTime(now),
+		LastBackupName:  name,
+		RetainedBackups: retained,
+	})
+}
+
+// cpkInfo returns the customer-provided key the blobs are encrypted with by
+// the storage service, which the RP deploys from the cluster document
+func (u *Uploader) cpkInfo(ctx context.Context) (*blob.CPKInfo, error) {
+	secret := &corev1.Secret{}
+	err := u.client.Get(ctx, types.NamespacedName{Namespace: operator.Namespace, Name: operator.EtcdBackupKeySecretName}, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	cpk, err := CPKInfo(secret.Data[operator.EtcdBackupKeySecretKey])
+	if err != nil {
+		return nil, fmt.Errorf("secret %s: %w", operator.EtcdBackupKeySecretName, err)
+	}
+
+	return cpk, nil
+}
+
+// CPKInfo returns the customer-provided key info the storage service needs to
+// encrypt and decrypt the blobs of a backup with key
+func CPKInfo(key []byte) (*blob.CPKInfo, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("key must be 32 bytes, not %d", len(key))
+	}
+	hash := sha256.Sum256(key)
+
+	return &blob.CPKInfo{
+		EncryptionAlgorithm: pointerutils.ToPtr(blob.EncryptionAlgorithmTypeAES256),
+		EncryptionKey:       pointerutils.ToPtr(base64.StdEncoding.EncodeToString(key)),
+		EncryptionKeySHA256: pointerutils.ToPtr(base64.StdEncoding.EncodeToString(hash[:])),
+	}, nil
+}
+
+// files returns the names of the files in the snapshot
+func (u *Uploader) files() ([]string, error) {
+	entries, err := os.ReadDir(u.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, e := range entries {
+		if e.Type().IsRegular() {
+			files = append(files, e.Name())
+		}
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no snapshot found in %s", u.dir)
+	}
+
+	return files, nil
+}
+
+func (u *Uploader) upload(ctx context.Context, blobs azblob.BlobsClient, cpk *blob.CPKInfo, file, blobName string) error {
+	f, err := os.Open(filepath.Join(u.dir, file))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = blobs.UploadStream(ctx, containerName, blobName, f, &sdkazblob.UploadStreamOptions{
+		CPKInfo: cpk,
+	})
+	return err
+}
+
+// Download downloads the blobs of a backup to dir, decrypting them with key.
+// It needs nothing from the cluster, so that a lost cluster can be restored
+// with the key kept in its cluster document.
+func Download(ctx context.Context, blobs azblob.BlobsClient, key []byte, backupName, dir string) error {
+	cpk, err := CPKInfo(key)
+	if err != nil {
+		return err
+	}
+
+	names, err := blobs.ListBlobNames(ctx, containerName, backupName+"/")
+	if err != nil {
+		return err
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("backup %s not found", backupName)
+	}
+
+	for _, name := range names {
+		err = download(ctx, blobs, cpk, name, filepath.Join(dir, strings.TrimPrefix(name, backupName+"/")))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func download(ctx context.Context, blobs azblob.BlobsClient, cpk *blob.CPKInfo, blobName, path string) error {
+	resp, err := blobs.DownloadStream(ctx, containerName, blobName, &sdkazblob.DownloadStreamOptions{
+		CPKInfo: cpk,
+	})
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, resp.Body)
+	if err != nil {
+		f.Close()
+		return err
+	}
+
+	return f.Close()
+}
+
+// prune deletes the blobs of all but the newest `retention` backups, and
+// returns how many backups are left
+func (u *Uploader) prune(ctx context.Context, blobs azblob.BlobsClient, retention int) (int, error) {
+	names, err := blobs.ListBlobNames(ctx, containerName, "")
+	if err != nil {
+		return 0, err
+	}
+
+	backups := map[string][]string{}
+	for _, name := range names {
+		backup, _, ok := strings.Cut(name, "/")
+		if !ok {
+			continue
+		}
+		backups[backup] = append(backups[backup], name)
+	}
+
+	sorted := slices.Sorted(maps.Keys(backups))
+	if len(sorted) <= retention {
+		return len(sorted), nil
+	}
+
+	for _, backup := range sorted[:len(sorted)-retention] {
+		u.log.Infof("deleting backup %s", backup)
+		for _, name := range backups[backup] {
+			_, err = blobs.DeleteBlob(ctx, containerName, name, nil)
+			if err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return retention, nil
+}
+
+func (u *Uploader) setStatus(ctx context.Context, status arov1alpha1.EtcdBackupStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster := &arov1alpha1.Cluster{}
+		err := u.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, cluster)
+		if err != nil {
+			return err
+		}
+
+		if equality.Semantic.DeepEqual(cluster.Status.EtcdBackup, status) {
+			return nil
+		}
+
+		cluster.Status.EtcdBackup = status
+		return u.client.Status().Update(ctx, cluster)
+	})
+}
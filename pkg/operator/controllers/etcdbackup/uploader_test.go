@@ -0,0 +1,304 @@
+package etcdbackup
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sdkazblob "github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/util/azureclient/azuresdk/azblob"
+	"github.com/Azure/ARO-RP/pkg/util/cmp"
+	utillog "github.com/Azure/ARO-RP/pkg/util/log"
+	mock_azblob "github.com/Azure/ARO-RP/pkg/util/mocks/azureclient/azuresdk/azblob"
+	testclienthelper "github.com/Azure/ARO-RP/test/util/clienthelper"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestUploaderRun(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	const name = "20260102T030405Z"
+
+	key := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: operator.EtcdBackupKeySecretName, Namespace: operator.Namespace},
+		Data:       map[string][]byte{operator.EtcdBackupKeySecretKey: []byte("0123456789abcdef0123456789abcdef")},
+	}
+
+	for _, tt := range []struct {
+		name       string
+		flags      arov1alpha1.OperatorFlags
+		files      []string
+		objects    []client.Object
+		mocks      func(*mock_azblob.MockBlobsClient)
+		wantStatus arov1alpha1.EtcdBackupStatus
+		wantErr    string
+	}{
+		{
+			name:    "uploads and prunes backups",
+			flags:   arov1alpha1.OperatorFlags{controllerRetention: "2"},
+			files:   []string{"snapshot_2026-01-02_030400.db", "static_kuberesources_2026-01-02_030400.tar.gz"},
+			objects: []client.Object{key},
+			mocks: func(blobs *mock_azblob.MockBlobsClient) {
+				blobs.EXPECT().CreateContainer(gomock.Any(), containerName).Return(nil)
+				for _, file := range []string{"snapshot_2026-01-02_030400.db", "static_kuberesources_2026-01-02_030400.tar.gz"} {
+					blobs.EXPECT().UploadStream(gomock.Any(), containerName, name+"/"+file, gomock.Any(), gomock.Any()).
+						DoAndReturn(func(ctx context.Context, container, blob string, body io.Reader, o *sdkazblob.UploadStreamOptions) (sdkazblob.UploadStreamResponse, error) {
+							b, err := io.ReadAll(body)
+							if err != nil {
+								t.Error(err)
+							}
+							if string(b) != file {
+								t.Errorf("%s: got %q", blob, string(b))
+							}
+							if *o.CPKInfo.EncryptionKey != "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=" ||
+								*o.CPKInfo.EncryptionKeySHA256 != "PrG9Q5lH63YpmOVmzMLgmceREYsvQFecxPfaK1Bht/k=" {
+								t.Errorf("%s: got CPK info %v", blob, o.CPKInfo)
+							}
+							return sdkazblob.UploadStreamResponse{}, nil
+						})
+				}
+				blobs.EXPECT().ListBlobNames(gomock.Any(), containerName, "").Return([]string{
+					"20251231T030405Z/snapshot_2025-12-31_030400.db",
+					"20251231T030405Z/static_kuberesources_2025-12-31_030400.tar.gz",
+					"20260101T030405Z/snapshot_2026-01-01_030400.db",
+					"20260101T030405Z/static_kuberesources_2026-01-01_030400.tar.gz",
+					name + "/snapshot_2026-01-02_030400.db",
+					name + "/static_kuberesources_2026-01-02_030400.tar.gz",
+				}, nil)
+				blobs.EXPECT().DeleteBlob(gomock.Any(), containerName, "20251231T030405Z/snapshot_2025-12-31_030400.db", nil).Return(sdkazblob.DeleteBlobResponse{}, nil)
+				blobs.EXPECT().DeleteBlob(gomock.Any(), containerName, "20251231T030405Z/static_kuberesources_2025-12-31_030400.tar.gz", nil).Return(sdkazblob.DeleteBlobResponse{}, nil)
+			},
+			wantStatus: arov1alpha1.EtcdBackupStatus{
+				LastBackupTime:  metav1.NewTime(now),
+				LastBackupName:  name,
+				RetainedBackups: 2,
+			},
+		},
+		{
+			name:    "upload fails",
+			files:   []string{"snapshot_2026-01-02_030400.db"},
+			objects: []client.Object{key},
+			mocks: func(blobs *mock_azblob.MockBlobsClient) {
+				blobs.EXPECT().CreateContainer(gomock.Any(), containerName).Return(nil)
+				blobs.EXPECT().UploadStream(gomock.Any(), containerName, name+"/snapshot_2026-01-02_030400.db", gomock.Any(), gomock.Any()).
+					Return(sdkazblob.UploadStreamResponse{}, errors.New("random error"))
+			},
+			wantErr: "random error",
+		},
+		{
+			name:    "no snapshot",
+			objects: []client.Object{key},
+			wantErr: "no snapshot found in ",
+		},
+		{
+			name:    "no key",
+			files:   []string{"snapshot_2026-01-02_030400.db"},
+			wantErr: `secrets "aro-etcd-backup-encryption-key" not found`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			controller := gomock.NewController(t)
+			defer controller.Finish()
+
+			blobs := mock_azblob.NewMockBlobsClient(controller)
+			if tt.mocks != nil {
+				tt.mocks(blobs)
+			}
+
+			dir := t.TempDir()
+			for _, file := range tt.files {
+				err := os.WriteFile(filepath.Join(dir, file), []byte(file), 0o600)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tt.wantErr == "no snapshot found in " {
+				tt.wantErr += dir
+			}
+
+			instance := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: tt.flags,
+				},
+			}
+
+			clientFake := testclienthelper.NewAROFakeClientBuilder(append(tt.objects, instance)...).Build()
+
+			u := &Uploader{
+				log: utillog.GetLogger(),
+				dir: dir,
+
+				newBlobsClient: func(context.Context, *arov1alpha1.Cluster) (azblob.BlobsClient, error) {
+					return blobs, nil
+				},
+				now: func() time.Time { return now },
+
+				client: clientFake,
+			}
+
+			err := u.Run(ctx)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			err = clientFake.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !equality.Semantic.DeepEqual(tt.wantStatus, instance.Status.EtcdBackup) {
+				t.Error(cmp.Diff(tt.wantStatus, instance.Status.EtcdBackup))
+			}
+		})
+	}
+}
+
+// TestRestoreWithClusterDocumentKey uploads a backup with the key the RP
+// deploys to the cluster, then downloads it with nothing but the key from the
+// cluster document, as when restoring a lost cluster
+func TestRestoreWithClusterDocumentKey(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	const name = "20260102T030405Z"
+
+	oc := &api.OpenShiftCluster{
+		Properties: api.OpenShiftClusterProperties{
+			EtcdBackupEncryptionKey: api.SecureBytes("0123456789abcdef0123456789abcdef"),
+		},
+	}
+	files := map[string]string{
+		"snapshot_2026-01-02_030400.db":                 "snapshot",
+		"static_kuberesources_2026-01-02_030400.tar.gz": "static pod resources",
+	}
+
+	controller := gomock.NewController(t)
+	defer controller.Finish()
+
+	// the storage service keeps the hash of the key each blob was encrypted
+	// with, and refuses to decrypt it with any other key
+	type storedBlob struct {
+		data      []byte
+		keySHA256 string
+	}
+	stored := map[string]storedBlob{}
+
+	blobs := mock_azblob.NewMockBlobsClient(controller)
+	blobs.EXPECT().CreateContainer(gomock.Any(), containerName).Return(nil)
+	blobs.EXPECT().UploadStream(gomock.Any(), containerName, gomock.Any(), gomock.Any(), gomock.Any()).Times(len(files)).
+		DoAndReturn(func(ctx context.Context, container, blobName string, body io.Reader, o *sdkazblob.UploadStreamOptions) (sdkazblob.UploadStreamResponse, error) {
+			b, err := io.ReadAll(body)
+			if err != nil {
+				return sdkazblob.UploadStreamResponse{}, err
+			}
+			stored[blobName] = storedBlob{data: b, keySHA256: *o.CPKInfo.EncryptionKeySHA256}
+			return sdkazblob.UploadStreamResponse{}, nil
+		})
+	blobs.EXPECT().ListBlobNames(gomock.Any(), containerName, gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, container, prefix string) ([]string, error) {
+			var names []string
+			for name := range stored {
+				if strings.HasPrefix(name, prefix) {
+					names = append(names, name)
+				}
+			}
+			slices.Sort(names)
+			return names, nil
+		})
+	blobs.EXPECT().DownloadStream(gomock.Any(), containerName, gomock.Any(), gomock.Any()).AnyTimes().
+		DoAndReturn(func(ctx context.Context, container, blobName string, o *sdkazblob.DownloadStreamOptions) (sdkazblob.DownloadStreamResponse, error) {
+			stored, ok := stored[blobName]
+			if !ok {
+				return sdkazblob.DownloadStreamResponse{}, fmt.Errorf("blob %s not found", blobName)
+			}
+			if o == nil || o.CPKInfo == nil || *o.CPKInfo.EncryptionKeySHA256 != stored.keySHA256 {
+				return sdkazblob.DownloadStreamResponse{}, fmt.Errorf("blob %s: wrong encryption key", blobName)
+			}
+			return sdkazblob.DownloadStreamResponse{
+				DownloadResponse: blob.DownloadResponse{Body: io.NopCloser(bytes.NewReader(stored.data))},
+			}, nil
+		})
+
+	// back up, with the key deployed to the cluster from its document
+	backupDir := t.TempDir()
+	for file, content := range files {
+		err := os.WriteFile(filepath.Join(backupDir, file), []byte(content), 0o600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	clientFake := testclienthelper.NewAROFakeClientBuilder(
+		&arov1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: arov1alpha1.SingletonClusterName}},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: operator.EtcdBackupKeySecretName, Namespace: operator.Namespace},
+			Data:       map[string][]byte{operator.EtcdBackupKeySecretKey: oc.Properties.EtcdBackupEncryptionKey},
+		},
+	).Build()
+
+	u := &Uploader{
+		log: utillog.GetLogger(),
+		dir: backupDir,
+
+		newBlobsClient: func(context.Context, *arov1alpha1.Cluster) (azblob.BlobsClient, error) {
+			return blobs, nil
+		},
+		now: func() time.Time { return now },
+
+		client: clientFake,
+	}
+
+	err := u.Run(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// restore, with only the key from the cluster document
+	restoreDir := t.TempDir()
+	err = Download(ctx, blobs, oc.Properties.EtcdBackupEncryptionKey, name, restoreDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for file, content := range files {
+		b, err := os.ReadFile(filepath.Join(restoreDir, file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != content {
+			t.Errorf("%s: got %q, wanted %q", file, string(b), content)
+		}
+	}
+
+	// any other key is refused
+	err = Download(ctx, blobs, []byte("fedcba9876543210fedcba9876543210"), name, t.TempDir())
+	utilerror.AssertErrorMessage(t, err, "blob "+name+"/snapshot_2026-01-02_030400.db: wrong encryption key")
+
+	err = Download(ctx, blobs, oc.Properties.EtcdBackupEncryptionKey, "20260101T030405Z", t.TempDir())
+	utilerror.AssertErrorMessage(t, err, "backup 20260101T030405Z not found")
+}
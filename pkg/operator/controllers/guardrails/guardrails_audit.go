@@ -0,0 +1,387 @@
+package guardrails
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/prometheus/common/model"
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/retry"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/guardrails/config"
+	"github.com/Azure/ARO-RP/pkg/util/conditions"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+	"github.com/Azure/ARO-RP/pkg/util/portforward"
+)
+
+const (
+	// vapFailedChecksQuery sums the ValidatingAdmissionPolicy checks which
+	// failed in the last day across all the API servers, by policy. Requests
+	// are allowed when a check errors and the policy's failure policy is
+	// Ignore, which is not a violation.
+	vapFailedChecksQuery = `sum by (policy) (increase(apiserver_validating_admission_policy_check_total{enforcement_action!="allow"}[1d]))`
+
+	// maxAuditSamples is how many violations of each Gatekeeper constraint are
+	// described in the cluster's status
+	maxAuditSamples = 5
+)
+
+// audit records the violations of the policies managed with method in the
+// cluster's status, and summarizes them in the GuardRailsPolicyViolations
+// condition
+func (r *Reconciler) audit(ctx context.Context, method string) error {
+	instance := &arov1alpha1.Cluster{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, instance); err != nil {
+		return err
+	}
+
+	var policies []arov1alpha1.GuardRailsPolicyAudit
+	var auditErr error
+	switch method {
+	case operator.GuardrailsMethodGatekeeper:
+		policies, auditErr = r.auditGatekeeper(ctx, instance)
+	case operator.GuardrailsMethodVAP:
+		policies, auditErr = r.auditVAP(ctx, instance)
+	default:
+		auditErr = fmt.Errorf("unrecognised guardrails method %s", method)
+	}
+
+	if auditErr != nil {
+		return errors.Join(auditErr, conditions.SetCondition(ctx, r.client, &operatorv1.OperatorCondition{
+			Type:    arov1alpha1.GuardRailsPolicyViolations,
+			Status:  operatorv1.ConditionUnknown,
+			Message: auditErr.Error(),
+			Reason:  "AuditFailed",
+		}, operator.RoleMaster))
+	}
+
+	err := r.setAudit(ctx, arov1alpha1.GuardRailsAuditStatus{
+		Method:        method,
+		Policies:      policies,
+		LastAuditTime: metav1.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return conditions.SetCondition(ctx, r.client, violationsCondition(policies), operator.RoleMaster)
+}
+
+// clearAudit removes the last audit and the GuardRailsPolicyViolations
+// condition from the cluster's status, once the controller no longer manages
+// the policies. An Unknown condition would be reported as unexpected by the
+// monitor for as long as the policies are not managed.
+func (r *Reconciler) clearAudit(ctx context.Context) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster := &arov1alpha1.Cluster{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, cluster)
+		if err != nil {
+			return err
+		}
+
+		conditions := slices.DeleteFunc(slices.Clone(cluster.Status.Conditions), func(c operatorv1.OperatorCondition) bool {
+			return c.Type == arov1alpha1.GuardRailsPolicyViolations
+		})
+
+		if equality.Semantic.DeepEqual(cluster.Status.GuardRailsAudit, arov1alpha1.GuardRailsAuditStatus{}) &&
+			len(conditions) == len(cluster.Status.Conditions) {
+			return nil
+		}
+
+		cluster.Status.GuardRailsAudit = arov1alpha1.GuardRailsAuditStatus{}
+		cluster.Status.Conditions = conditions
+		return r.client.Status().Update(ctx, cluster)
+	})
+}
+
+func (r *Reconciler) setAudit(ctx context.Context, audit arov1alpha1.GuardRailsAuditStatus) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cluster := &arov1alpha1.Cluster{}
+		err := r.client.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, cluster)
+		if err != nil {
+			return err
+		}
+
+		if equality.Semantic.DeepEqual(cluster.Status.GuardRailsAudit, audit) {
+			return nil
+		}
+
+		cluster.Status.GuardRailsAudit = audit
+		return r.client.Status().Update(ctx, cluster)
+	})
+}
+
+// auditGatekeeper summarizes the violations which Gatekeeper's audit found for
+// each managed constraint
+func (r *Reconciler) auditGatekeeper(ctx context.Context, instance *arov1alpha1.Cluster) ([]arov1alpha1.GuardRailsPolicyAudit, error) {
+	constraints, err := template.ParseFS(gkPolicyConstraints, filepath.Join(gkConstraintsPath, "*"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Templates() is in no particular order, so sort them to keep the status
+	// stable between audits
+	templates := constraints.Templates()
+	slices.SortFunc(templates, func(a, b *template.Template) int { return strings.Compare(a.Name(), b.Name()) })
+
+	var policies []arov1alpha1.GuardRailsPolicyAudit
+	for _, templ := range templates {
+		managed, enforcement, err := r.getPolicyConfig(ctx, instance, templ.Name())
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(managed, "true") {
+			continue
+		}
+
+		buffer := new(bytes.Buffer)
+		err = templ.Execute(buffer, &config.GuardRailsPolicyConfig{Enforcement: enforcement})
+		if err != nil {
+			return nil, err
+		}
+
+		uns, err := dynamichelper.DecodeUnstructured(buffer.Bytes())
+		if err != nil {
+			return nil, err
+		}
+
+		constraint := &unstructured.Unstructured{}
+		constraint.SetGroupVersionKind(uns.GroupVersionKind())
+		err = r.client.Get(ctx, types.NamespacedName{Name: uns.GetName()}, constraint)
+		if kerrors.IsNotFound(err) {
+			// not created yet, so it cannot have been audited
+			constraint.SetName(uns.GetName())
+		} else if err != nil {
+			return nil, err
+		}
+
+		policies = append(policies, constraintAudit(constraint, enforcement))
+	}
+
+	return policies, nil
+}
+
+// constraintAudit summarizes the violations in the status of a Gatekeeper
+// constraint
+func constraintAudit(constraint *unstructured.Unstructured, enforcement string) arov1alpha1.GuardRailsPolicyAudit {
+	audit := arov1alpha1.GuardRailsPolicyAudit{
+		Name:        constraint.GetName(),
+		Enforcement: enforcement,
+	}
+
+	audit.Violations, _, _ = unstructured.NestedInt64(constraint.Object, "status", "totalViolations")
+
+	violations, _, _ := unstructured.NestedSlice(constraint.Object, "status", "violations")
+	for _, v := range violations {
+		if len(audit.Samples) == maxAuditSamples {
+			break
+		}
+
+		violation, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		kind, _, _ := unstructured.NestedString(violation, "kind")
+		namespace, _, _ := unstructured.NestedString(violation, "namespace")
+		name, _, _ := unstructured.NestedString(violation, "name")
+		message, _, _ := unstructured.NestedString(violation, "message")
+		if namespace != "" {
+			name = namespace + "/" + name
+		}
+
+		audit.Samples = append(audit.Samples, fmt.Sprintf("%s %s: %s", kind, name, message))
+	}
+
+	return audit
+}
+
+// auditVAP summarizes the requests which failed each managed
+// ValidatingAdmissionPolicy in the last day, as counted by the API servers and
+// scraped by the cluster's Prometheus. VAP does not audit existing objects, so
+// these are only the requests made in that time.
+func (r *Reconciler) auditVAP(ctx context.Context, instance *arov1alpha1.Cluster) ([]arov1alpha1.GuardRailsPolicyAudit, error) {
+	entries, err := fs.ReadDir(vapPolicies, vapPolicyPath)
+	if err != nil {
+		return nil, fmt.Errorf("reading VAP policy directory: %w", err)
+	}
+
+	body, err := r.queryPrometheus(ctx, vapFailedChecksQuery)
+	if err != nil {
+		return nil, fmt.Errorf("querying Prometheus: %w", err)
+	}
+
+	failed, err := vapFailedChecks(body)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Prometheus response: %w", err)
+	}
+
+	var policies []arov1alpha1.GuardRailsPolicyAudit
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		policyName := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		if isMandatoryVAPPolicy(policyName) {
+			continue
+		}
+
+		managed, enforcement, err := r.getPolicyConfig(ctx, instance, entry.Name())
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(managed, "true") {
+			continue
+		}
+
+		policies = append(policies, arov1alpha1.GuardRailsPolicyAudit{
+			Name:        policyName,
+			Enforcement: enforcement,
+			Violations:  failed[policyName],
+		})
+	}
+
+	return policies, nil
+}
+
+// vapFailedChecks returns how many checks of each ValidatingAdmissionPolicy
+// failed, from the response to vapFailedChecksQuery
+func vapFailedChecks(body []byte) (map[string]int64, error) {
+	var resp struct {
+		Status string `json:"status"`
+		Error  string `json:"error"`
+		Data   struct {
+			ResultType string       `json:"resultType"`
+			Result     model.Vector `json:"result"`
+		} `json:"data"`
+	}
+	err := json.Unmarshal(body, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Status != "success" {
+		return nil, fmt.Errorf("query failed: %s", resp.Error)
+	}
+	if resp.Data.ResultType != model.ValVector.String() {
+		return nil, fmt.Errorf("unexpected result type %q", resp.Data.ResultType)
+	}
+
+	failed := map[string]int64{}
+	for _, sample := range resp.Data.Result {
+		// increase() extrapolates, so its results need not be whole numbers
+		failed[string(sample.Metric["policy"])] += int64(math.Round(float64(sample.Value)))
+	}
+
+	return failed, nil
+}
+
+// queryPrometheus runs an instant query against the cluster's Prometheus and
+// returns the response body. Prometheus only listens on the loopback interface
+// of its pods, so it is reached by port forwarding to either replica.
+func queryPrometheus(ctx context.Context, log *logrus.Entry, restConfig *rest.Config, query string) ([]byte, error) {
+	var err error
+	for i := range 2 {
+		var body []byte
+		body, err = queryPrometheusPod(ctx, log, restConfig, fmt.Sprintf("prometheus-k8s-%d", i), query)
+		if err == nil {
+			return body, nil
+		}
+	}
+
+	return nil, err
+}
+
+func queryPrometheusPod(ctx context.Context, log *logrus.Entry, restConfig *rest.Config, pod, query string) ([]byte, error) {
+	hc := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				_, port, err := net.SplitHostPort(address)
+				if err != nil {
+					return nil, err
+				}
+
+				return portforward.DialContext(ctx, log, restConfig, "openshift-monitoring", pod, port)
+			},
+			// each connection is a new port forward, so don't keep it open
+			DisableKeepAlives: true,
+		},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost:9090/api/v1/query?"+url.Values{"query": []string{query}}.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Prometheus describes failed queries in the body, which vapFailedChecks
+	// reports
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusBadRequest && resp.StatusCode != http.StatusUnprocessableEntity {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return body, nil
+}
+
+// violationsCondition summarizes the policies which were violated
+func violationsCondition(policies []arov1alpha1.GuardRailsPolicyAudit) *operatorv1.OperatorCondition {
+	var violated []string
+	for _, p := range policies {
+		if p.Violations > 0 {
+			violated = append(violated, fmt.Sprintf("%s (%s): %d", p.Name, p.Enforcement, p.Violations))
+		}
+	}
+
+	if len(violated) == 0 {
+		return &operatorv1.OperatorCondition{
+			Type:    arov1alpha1.GuardRailsPolicyViolations,
+			Status:  operatorv1.ConditionFalse,
+			Message: "No Guardrails policy violations",
+			Reason:  "AuditDone",
+		}
+	}
+
+	return &operatorv1.OperatorCondition{
+		Type:    arov1alpha1.GuardRailsPolicyViolations,
+		Status:  operatorv1.ConditionTrue,
+		Message: fmt.Sprintf("%d of %d policies violated: %s", len(violated), len(policies), strings.Join(violated, ", ")),
+		Reason:  "AuditDone",
+	}
+}
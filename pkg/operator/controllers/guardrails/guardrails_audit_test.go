@@ -0,0 +1,358 @@
+package guardrails
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/go-test/deep"
+	"github.com/sirupsen/logrus"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
+	testclienthelper "github.com/Azure/ARO-RP/test/util/clienthelper"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func queryPrometheusForTest(body string) func(context.Context, string) ([]byte, error) {
+	return func(_ context.Context, query string) ([]byte, error) {
+		if query != vapFailedChecksQuery {
+			return nil, fmt.Errorf("unexpected query %q", query)
+		}
+		return []byte(body), nil
+	}
+}
+
+const vapFailedChecksResponseForTest = `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"policy":"aro-machines-deny"},"value":[1767225600,"3"]},{"metric":{"policy":"aro-nodes-deny"},"value":[1767225600,"3.0066666666666664"]}]}}`
+
+const noVAPFailedChecksResponseForTest = `{"status":"success","data":{"resultType":"vector","result":[]}}`
+
+func TestVAPFailedChecks(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		body    string
+		want    map[string]int64
+		wantErr string
+	}{
+		{
+			name: "counts failed checks by policy",
+			body: vapFailedChecksResponseForTest,
+			want: map[string]int64{
+				"aro-machines-deny": 3,
+				"aro-nodes-deny":    3,
+			},
+		},
+		{
+			name: "no checks yet",
+			body: noVAPFailedChecksResponseForTest,
+			want: map[string]int64{},
+		},
+		{
+			name:    "query failed",
+			body:    `{"status":"error","errorType":"bad_data","error":"invalid parameter \"query\""}`,
+			wantErr: `query failed: invalid parameter "query"`,
+		},
+		{
+			name:    "unexpected result type",
+			body:    `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			wantErr: `unexpected result type "matrix"`,
+		},
+		{
+			name:    "invalid response",
+			body:    "<html>",
+			wantErr: "invalid character '<' looking for beginning of value",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := vapFailedChecks([]byte(tt.body))
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			for _, diff := range deep.Equal(got, tt.want) {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestConstraintAudit(t *testing.T) {
+	violations := []interface{}{}
+	for i := range maxAuditSamples + 1 {
+		violations = append(violations, map[string]interface{}{
+			"enforcementAction": "dryrun",
+			"kind":              "Machine",
+			"namespace":         "openshift-machine-api",
+			"name":              fmt.Sprintf("machine-%d", i),
+			"message":           "Main machine label is not allowed",
+		})
+	}
+	violations = append(violations, "not a violation")
+
+	constraint := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name": "aro-machines-deny",
+		},
+		"status": map[string]interface{}{
+			"totalViolations": int64(12),
+			"violations":      violations,
+		},
+	}}
+
+	got := constraintAudit(constraint, "dryrun")
+
+	want := arov1alpha1.GuardRailsPolicyAudit{
+		Name:        "aro-machines-deny",
+		Enforcement: "dryrun",
+		Violations:  12,
+		Samples: []string{
+			"Machine openshift-machine-api/machine-0: Main machine label is not allowed",
+			"Machine openshift-machine-api/machine-1: Main machine label is not allowed",
+			"Machine openshift-machine-api/machine-2: Main machine label is not allowed",
+			"Machine openshift-machine-api/machine-3: Main machine label is not allowed",
+			"Machine openshift-machine-api/machine-4: Main machine label is not allowed",
+		},
+	}
+
+	for _, diff := range deep.Equal(got, want) {
+		t.Error(diff)
+	}
+}
+
+func TestAudit(t *testing.T) {
+	ctx := context.Background()
+
+	machinesDenyConstraint := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "constraints.gatekeeper.sh/v1beta1",
+		"kind":       "ARODenyLabels",
+		"metadata": map[string]interface{}{
+			"name": "aro-machines-deny",
+		},
+		"status": map[string]interface{}{
+			"totalViolations": int64(1),
+			"violations": []interface{}{
+				map[string]interface{}{
+					"kind":      "Machine",
+					"namespace": "openshift-machine-api",
+					"name":      "master-0",
+					"message":   "Main machine label is not allowed",
+				},
+			},
+		},
+	}}
+
+	flags := arov1alpha1.OperatorFlags{
+		fmt.Sprintf(controllerPolicyManagedTemplate, "aro-machines-deny"):     operator.FlagTrue,
+		fmt.Sprintf(controllerPolicyEnforcementTemplate, "aro-machines-deny"): operator.GuardrailsPolicyWarn,
+		fmt.Sprintf(controllerPolicyManagedTemplate, "aro-nodes-deny"):        operator.FlagTrue,
+		fmt.Sprintf(controllerPolicyManagedTemplate, "aro-pull-secret-deny"):  operator.FlagFalse,
+	}
+
+	for _, tt := range []struct {
+		name            string
+		method          string
+		objects         []client.Object
+		queryPrometheus func(context.Context, string) ([]byte, error)
+		wantPolicies    []arov1alpha1.GuardRailsPolicyAudit
+		wantCondition   operatorv1.OperatorCondition
+		wantErr         string
+	}{
+		{
+			name:    "gatekeeper",
+			method:  operator.GuardrailsMethodGatekeeper,
+			objects: []client.Object{machinesDenyConstraint},
+			wantPolicies: []arov1alpha1.GuardRailsPolicyAudit{
+				{
+					Name:        "aro-machines-deny",
+					Enforcement: operator.GuardrailsPolicyWarn,
+					Violations:  1,
+					Samples:     []string{"Machine openshift-machine-api/master-0: Main machine label is not allowed"},
+				},
+				{
+					Name:        "aro-nodes-deny",
+					Enforcement: operator.GuardrailsPolicyDryrun,
+				},
+			},
+			wantCondition: operatorv1.OperatorCondition{
+				Type:    arov1alpha1.GuardRailsPolicyViolations,
+				Status:  operatorv1.ConditionTrue,
+				Message: "1 of 2 policies violated: aro-machines-deny (warn): 1",
+				Reason:  "AuditDone",
+			},
+		},
+		{
+			name:            "vap",
+			method:          operator.GuardrailsMethodVAP,
+			queryPrometheus: queryPrometheusForTest(vapFailedChecksResponseForTest),
+			wantPolicies: []arov1alpha1.GuardRailsPolicyAudit{
+				{
+					Name:        "aro-machines-deny",
+					Enforcement: operator.GuardrailsPolicyWarn,
+					Violations:  3,
+				},
+				{
+					Name:        "aro-nodes-deny",
+					Enforcement: operator.GuardrailsPolicyDryrun,
+					Violations:  3,
+				},
+			},
+			wantCondition: operatorv1.OperatorCondition{
+				Type:    arov1alpha1.GuardRailsPolicyViolations,
+				Status:  operatorv1.ConditionTrue,
+				Message: "2 of 2 policies violated: aro-machines-deny (warn): 3, aro-nodes-deny (dryrun): 3",
+				Reason:  "AuditDone",
+			},
+		},
+		{
+			name:            "vap, no violations",
+			method:          operator.GuardrailsMethodVAP,
+			queryPrometheus: queryPrometheusForTest(noVAPFailedChecksResponseForTest),
+			wantPolicies: []arov1alpha1.GuardRailsPolicyAudit{
+				{
+					Name:        "aro-machines-deny",
+					Enforcement: operator.GuardrailsPolicyWarn,
+				},
+				{
+					Name:        "aro-nodes-deny",
+					Enforcement: operator.GuardrailsPolicyDryrun,
+				},
+			},
+			wantCondition: operatorv1.OperatorCondition{
+				Type:    arov1alpha1.GuardRailsPolicyViolations,
+				Status:  operatorv1.ConditionFalse,
+				Message: "No Guardrails policy violations",
+				Reason:  "AuditDone",
+			},
+		},
+		{
+			name:   "vap, Prometheus unavailable",
+			method: operator.GuardrailsMethodVAP,
+			queryPrometheus: func(context.Context, string) ([]byte, error) {
+				return nil, errors.New("pods \"prometheus-k8s-1\" not found")
+			},
+			wantCondition: operatorv1.OperatorCondition{
+				Type:    arov1alpha1.GuardRailsPolicyViolations,
+				Status:  operatorv1.ConditionUnknown,
+				Message: `querying Prometheus: pods "prometheus-k8s-1" not found`,
+				Reason:  "AuditFailed",
+			},
+			wantErr: `querying Prometheus: pods "prometheus-k8s-1" not found`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			cluster := &arov1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: arov1alpha1.SingletonClusterName,
+				},
+				Spec: arov1alpha1.ClusterSpec{
+					OperatorFlags: flags,
+				},
+			}
+
+			clientFake := testclienthelper.NewAROFakeClientBuilder(append(tt.objects, cluster)...).Build()
+
+			r := &Reconciler{
+				log:    logrus.NewEntry(logrus.StandardLogger()),
+				client: clientFake,
+
+				queryPrometheus: tt.queryPrometheus,
+			}
+
+			err := r.audit(ctx, tt.method)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			err = clientFake.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, cluster)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.wantPolicies != nil {
+				if cluster.Status.GuardRailsAudit.Method != tt.method {
+					t.Errorf("got method %q, wanted %q", cluster.Status.GuardRailsAudit.Method, tt.method)
+				}
+				if cluster.Status.GuardRailsAudit.LastAuditTime.IsZero() {
+					t.Error("last audit time not set")
+				}
+			}
+			for _, diff := range deep.Equal(cluster.Status.GuardRailsAudit.Policies, tt.wantPolicies) {
+				t.Error(diff)
+			}
+
+			if len(cluster.Status.Conditions) != 1 {
+				t.Fatalf("got %d conditions, wanted 1", len(cluster.Status.Conditions))
+			}
+			cluster.Status.Conditions[0].LastTransitionTime = metav1.Time{}
+			for _, diff := range deep.Equal(cluster.Status.Conditions[0], tt.wantCondition) {
+				t.Error(diff)
+			}
+		})
+	}
+}
+
+func TestClearAudit(t *testing.T) {
+	ctx := context.Background()
+
+	internetReachable := operatorv1.OperatorCondition{
+		Type:   arov1alpha1.InternetReachableFromMaster,
+		Status: operatorv1.ConditionTrue,
+	}
+
+	cluster := &arov1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: arov1alpha1.SingletonClusterName,
+		},
+		Status: arov1alpha1.ClusterStatus{
+			GuardRailsAudit: arov1alpha1.GuardRailsAuditStatus{
+				Method: operator.GuardrailsMethodVAP,
+				Policies: []arov1alpha1.GuardRailsPolicyAudit{
+					{Name: "aro-machines-deny", Enforcement: operator.GuardrailsPolicyDryrun, Violations: 3},
+				},
+				LastAuditTime: metav1.Now(),
+			},
+			Conditions: []operatorv1.OperatorCondition{
+				{
+					Type:    arov1alpha1.GuardRailsPolicyViolations,
+					Status:  operatorv1.ConditionTrue,
+					Message: "1 of 1 policies violated: aro-machines-deny (dryrun): 3",
+					Reason:  "AuditDone",
+				},
+				internetReachable,
+			},
+		},
+	}
+
+	clientFake := testclienthelper.NewAROFakeClientBuilder(cluster).Build()
+
+	r := &Reconciler{
+		log:    logrus.NewEntry(logrus.StandardLogger()),
+		client: clientFake,
+	}
+
+	err := r.clearAudit(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = clientFake.Get(ctx, types.NamespacedName{Name: arov1alpha1.SingletonClusterName}, cluster)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, diff := range deep.Equal(cluster.Status.GuardRailsAudit, arov1alpha1.GuardRailsAuditStatus{}) {
+		t.Error(diff)
+	}
+
+	for _, diff := range deep.Equal(cluster.Status.Conditions, []operatorv1.OperatorCondition{internetReachable}) {
+		t.Error(diff)
+	}
+}
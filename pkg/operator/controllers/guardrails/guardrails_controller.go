@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -50,9 +51,14 @@ type Reconciler struct {
 	reconciliationMinutes int
 	cleanupNeeded         bool
 	kubernetescli         kubernetes.Interface
+
+	// queryPrometheus runs a query against the cluster's Prometheus, which
+	// counts the requests failing each ValidatingAdmissionPolicy across the
+	// API servers
+	queryPrometheus func(ctx context.Context, query string) ([]byte, error)
 }
 
-func NewReconciler(log *logrus.Entry, client client.Client, dh dynamichelper.Interface, k8scli kubernetes.Interface) *Reconciler {
+func NewReconciler(log *logrus.Entry, client client.Client, dh dynamichelper.Interface, k8scli kubernetes.Interface, restConfig *rest.Config) *Reconciler {
 	return &Reconciler{
 		log: log,
 
@@ -66,6 +72,10 @@ func NewReconciler(log *logrus.Entry, client client.Client, dh dynamichelper.Int
 		readinessTimeout:  5 * time.Minute,
 		cleanupNeeded:     false,
 		kubernetescli:     k8scli,
+
+		queryPrometheus: func(ctx context.Context, query string) ([]byte, error) {
+			return queryPrometheus(ctx, log, restConfig, query)
+		},
 	}
 }
 
@@ -88,7 +98,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 
 	if !instance.Spec.OperatorFlags.GetSimpleBoolean(operator.GuardrailsEnabled) {
 		r.log.Debug("controller is disabled")
-		return reconcile.Result{}, nil
+		return reconcile.Result{}, r.clearAudit(ctx)
 	}
 
 	r.log.Debug("running")
@@ -140,6 +150,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.
 		return reconcile.Result{}, err
 	}
 
+	if err := r.audit(ctx, operator.GuardrailsMethodVAP); err != nil {
+		r.log.Warnf("failed to audit VAP policies: %s", err.Error())
+	}
+
 	r.startVAPTicker(ctx, instance)
 
 	return reconcile.Result{}, nil
@@ -184,6 +198,10 @@ func (r *Reconciler) deployGatekeeper(ctx context.Context, instance *arov1alpha1
 		if err := r.ensurePolicy(ctx, gkPolicyConstraints, gkConstraintsPath); err != nil {
 			return reconcile.Result{}, err
 		}
+
+		if err := r.audit(ctx, operator.GuardrailsMethodGatekeeper); err != nil {
+			r.log.Warnf("failed to audit Gatekeeper constraints: %s", err.Error())
+		}
 	}
 
 	r.startGKTicker(ctx, instance)
@@ -222,6 +240,10 @@ func (r *Reconciler) resolveGuardrailsMethod(method string, lt417 bool) bool {
 // useGatekeeper reflects the resolved method for this cluster (see
 // resolveGuardrailsMethod).
 func (r *Reconciler) cleanupManaged(ctx context.Context, instance *arov1alpha1.Cluster, useGatekeeper bool) (ctrl.Result, error) {
+	if err := r.clearAudit(ctx); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	if useGatekeeper {
 		return r.cleanupGatekeeperManaged(ctx, instance)
 	}
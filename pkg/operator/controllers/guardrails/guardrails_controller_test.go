@@ -285,38 +285,50 @@ func TestReconcileVAP(t *testing.T) {
 		{
 			name: "VAP: managed=true, deploys VAP policies",
 			flags: arov1alpha1.OperatorFlags{
-				operator.GuardrailsEnabled:                            operator.FlagTrue,
-				operator.GuardrailsDeployManaged:                      operator.FlagTrue,
-				operator.GuardrailsMethod:                             operator.GuardrailsMethodAuto,
-				operator.GuardrailsPolicyMachineDenyManaged:           operator.FlagTrue,
-				operator.GuardrailsPolicyMachineDenyEnforcement:       operator.GuardrailsPolicyDeny,
-				operator.GuardrailsPolicyMachineConfigDenyManaged:     operator.FlagTrue,
-				operator.GuardrailsPolicyMachineConfigDenyEnforcement: operator.GuardrailsPolicyDryrun,
-				operator.GuardrailsPolicyNodesDenyManaged:             operator.FlagTrue,
-				operator.GuardrailsPolicyNodesDenyEnforcement:         operator.GuardrailsPolicyDeny,
-				operator.GuardrailsPolicyPrivNamespaceDenyManaged:     operator.FlagTrue,
-				operator.GuardrailsPolicyPrivNamespaceDenyEnforcement: operator.GuardrailsPolicyWarn,
+				operator.GuardrailsEnabled:                                  operator.FlagTrue,
+				operator.GuardrailsDeployManaged:                            operator.FlagTrue,
+				operator.GuardrailsMethod:                                   operator.GuardrailsMethodAuto,
+				operator.GuardrailsPolicyMachineDenyManaged:                 operator.FlagTrue,
+				operator.GuardrailsPolicyMachineDenyEnforcement:             operator.GuardrailsPolicyDeny,
+				operator.GuardrailsPolicyMachineConfigDenyManaged:           operator.FlagTrue,
+				operator.GuardrailsPolicyMachineConfigDenyEnforcement:       operator.GuardrailsPolicyDryrun,
+				operator.GuardrailsPolicyNodesDenyManaged:                   operator.FlagTrue,
+				operator.GuardrailsPolicyNodesDenyEnforcement:               operator.GuardrailsPolicyDeny,
+				operator.GuardrailsPolicyPrivNamespaceDenyManaged:           operator.FlagTrue,
+				operator.GuardrailsPolicyPrivNamespaceDenyEnforcement:       operator.GuardrailsPolicyWarn,
+				operator.GuardrailsPolicyCloudProviderConfigDenyManaged:     operator.FlagTrue,
+				operator.GuardrailsPolicyCloudProviderConfigDenyEnforcement: operator.GuardrailsPolicyDeny,
+				operator.GuardrailsPolicyOperatorClusterDenyManaged:         operator.FlagTrue,
+				operator.GuardrailsPolicyOperatorClusterDenyEnforcement:     operator.GuardrailsPolicyDryrun,
+				operator.GuardrailsPolicyOperatorNamespaceDenyManaged:       operator.FlagTrue,
+				operator.GuardrailsPolicyOperatorNamespaceDenyEnforcement:   operator.GuardrailsPolicyWarn,
 			},
 			dhMocks: func(dh *mock_dynamichelper.MockInterface) {
-				// 1 mandatory and 4 optional policies, each with one binding.
-				dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).Return(nil).Times(10)
+				// 1 mandatory and 7 optional policies, each with one binding.
+				dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).Return(nil).Times(16)
 				dh.EXPECT().EnsureDeletedGVR(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
 			},
 		},
 		{
 			name: "VAP: managed=true with gatekeeper migration (upgrade from pre-4.17)",
 			flags: arov1alpha1.OperatorFlags{
-				operator.GuardrailsEnabled:                            operator.FlagTrue,
-				operator.GuardrailsDeployManaged:                      operator.FlagTrue,
-				operator.GuardrailsMethod:                             operator.GuardrailsMethodAuto,
-				operator.GuardrailsPolicyMachineDenyManaged:           operator.FlagTrue,
-				operator.GuardrailsPolicyMachineDenyEnforcement:       operator.GuardrailsPolicyDeny,
-				operator.GuardrailsPolicyMachineConfigDenyManaged:     operator.FlagTrue,
-				operator.GuardrailsPolicyMachineConfigDenyEnforcement: operator.GuardrailsPolicyDryrun,
-				operator.GuardrailsPolicyNodesDenyManaged:             operator.FlagTrue,
-				operator.GuardrailsPolicyNodesDenyEnforcement:         operator.GuardrailsPolicyDeny,
-				operator.GuardrailsPolicyPrivNamespaceDenyManaged:     operator.FlagTrue,
-				operator.GuardrailsPolicyPrivNamespaceDenyEnforcement: operator.GuardrailsPolicyWarn,
+				operator.GuardrailsEnabled:                                  operator.FlagTrue,
+				operator.GuardrailsDeployManaged:                            operator.FlagTrue,
+				operator.GuardrailsMethod:                                   operator.GuardrailsMethodAuto,
+				operator.GuardrailsPolicyMachineDenyManaged:                 operator.FlagTrue,
+				operator.GuardrailsPolicyMachineDenyEnforcement:             operator.GuardrailsPolicyDeny,
+				operator.GuardrailsPolicyMachineConfigDenyManaged:           operator.FlagTrue,
+				operator.GuardrailsPolicyMachineConfigDenyEnforcement:       operator.GuardrailsPolicyDryrun,
+				operator.GuardrailsPolicyNodesDenyManaged:                   operator.FlagTrue,
+				operator.GuardrailsPolicyNodesDenyEnforcement:               operator.GuardrailsPolicyDeny,
+				operator.GuardrailsPolicyPrivNamespaceDenyManaged:           operator.FlagTrue,
+				operator.GuardrailsPolicyPrivNamespaceDenyEnforcement:       operator.GuardrailsPolicyWarn,
+				operator.GuardrailsPolicyCloudProviderConfigDenyManaged:     operator.FlagTrue,
+				operator.GuardrailsPolicyCloudProviderConfigDenyEnforcement: operator.GuardrailsPolicyDeny,
+				operator.GuardrailsPolicyOperatorClusterDenyManaged:         operator.FlagTrue,
+				operator.GuardrailsPolicyOperatorClusterDenyEnforcement:     operator.GuardrailsPolicyDryrun,
+				operator.GuardrailsPolicyOperatorNamespaceDenyManaged:       operator.FlagTrue,
+				operator.GuardrailsPolicyOperatorNamespaceDenyEnforcement:   operator.GuardrailsPolicyWarn,
 			},
 			cleanupNeeded: true,
 			depMocks: func(md *mock_deployer.MockDeployer) {
@@ -324,9 +336,9 @@ func TestReconcileVAP(t *testing.T) {
 			},
 			dhMocks: func(dh *mock_dynamichelper.MockInterface) {
 				// cleanupGatekeeper: removePolicy calls EnsureDeletedGVR for GK constraints
-				// then deployVAP: 1 mandatory and 4 optional policies and bindings
+				// then deployVAP: 1 mandatory and 7 optional policies and bindings
 				dh.EXPECT().EnsureDeletedGVR(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
-				dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).Return(nil).Times(10)
+				dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).Return(nil).Times(16)
 			},
 		},
 		{
@@ -337,9 +349,9 @@ func TestReconcileVAP(t *testing.T) {
 				operator.GuardrailsMethod:        operator.GuardrailsMethodAuto,
 			},
 			dhMocks: func(dh *mock_dynamichelper.MockInterface) {
-				// The mandatory policy remains; 4 optional and 1 deprecated policy and binding are removed.
+				// The mandatory policy remains; 7 optional and 1 deprecated policy and binding are removed.
 				dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).Return(nil).Times(2)
-				dh.EXPECT().EnsureDeletedGVR(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(10)
+				dh.EXPECT().EnsureDeletedGVR(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(16)
 			},
 		},
 		{
@@ -387,6 +399,8 @@ func TestReconcileVAP(t *testing.T) {
 				client:        testclienthelper.NewAROFakeClientBuilder(cluster, cv).Build(),
 				dh:            dh,
 				cleanupNeeded: tt.cleanupNeeded,
+
+				queryPrometheus: queryPrometheusForTest(noVAPFailedChecksResponseForTest),
 			}
 			_, err := r.Reconcile(context.Background(), reconcile.Request{})
 			if err != nil && err.Error() != tt.wantErr {
@@ -681,20 +695,26 @@ func TestReconcileMethodSelection(t *testing.T) {
 		{
 			name: "method=vap on 4.17+ deploys VAP",
 			flags: arov1alpha1.OperatorFlags{
-				operator.GuardrailsEnabled:                            operator.FlagTrue,
-				operator.GuardrailsDeployManaged:                      operator.FlagTrue,
-				operator.GuardrailsMethod:                             operator.GuardrailsMethodVAP,
-				operator.GuardrailsPolicyMachineDenyManaged:           operator.FlagTrue,
-				operator.GuardrailsPolicyMachineDenyEnforcement:       operator.GuardrailsPolicyDeny,
-				operator.GuardrailsPolicyMachineConfigDenyManaged:     operator.FlagTrue,
-				operator.GuardrailsPolicyMachineConfigDenyEnforcement: operator.GuardrailsPolicyDryrun,
-				operator.GuardrailsPolicyNodesDenyManaged:             operator.FlagTrue,
-				operator.GuardrailsPolicyNodesDenyEnforcement:         operator.GuardrailsPolicyDeny,
-				operator.GuardrailsPolicyPrivNamespaceDenyManaged:     operator.FlagTrue,
-				operator.GuardrailsPolicyPrivNamespaceDenyEnforcement: operator.GuardrailsPolicyWarn,
+				operator.GuardrailsEnabled:                                  operator.FlagTrue,
+				operator.GuardrailsDeployManaged:                            operator.FlagTrue,
+				operator.GuardrailsMethod:                                   operator.GuardrailsMethodVAP,
+				operator.GuardrailsPolicyMachineDenyManaged:                 operator.FlagTrue,
+				operator.GuardrailsPolicyMachineDenyEnforcement:             operator.GuardrailsPolicyDeny,
+				operator.GuardrailsPolicyMachineConfigDenyManaged:           operator.FlagTrue,
+				operator.GuardrailsPolicyMachineConfigDenyEnforcement:       operator.GuardrailsPolicyDryrun,
+				operator.GuardrailsPolicyNodesDenyManaged:                   operator.FlagTrue,
+				operator.GuardrailsPolicyNodesDenyEnforcement:               operator.GuardrailsPolicyDeny,
+				operator.GuardrailsPolicyPrivNamespaceDenyManaged:           operator.FlagTrue,
+				operator.GuardrailsPolicyPrivNamespaceDenyEnforcement:       operator.GuardrailsPolicyWarn,
+				operator.GuardrailsPolicyCloudProviderConfigDenyManaged:     operator.FlagTrue,
+				operator.GuardrailsPolicyCloudProviderConfigDenyEnforcement: operator.GuardrailsPolicyDeny,
+				operator.GuardrailsPolicyOperatorClusterDenyManaged:         operator.FlagTrue,
+				operator.GuardrailsPolicyOperatorClusterDenyEnforcement:     operator.GuardrailsPolicyDryrun,
+				operator.GuardrailsPolicyOperatorNamespaceDenyManaged:       operator.FlagTrue,
+				operator.GuardrailsPolicyOperatorNamespaceDenyEnforcement:   operator.GuardrailsPolicyWarn,
 			},
 			dhMocks: func(dh *mock_dynamichelper.MockInterface) {
-				dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).Return(nil).Times(10)
+				dh.EXPECT().Ensure(gomock.Any(), gomock.Any()).Return(nil).Times(16)
 				dh.EXPECT().EnsureDeletedGVR(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
 			},
 		},
@@ -732,6 +752,8 @@ func TestReconcileMethodSelection(t *testing.T) {
 				client:            testclienthelper.NewAROFakeClientBuilder(cluster, cv).Build(),
 				readinessTimeout:  0 * time.Second,
 				readinessPollTime: 1 * time.Second,
+
+				queryPrometheus: queryPrometheusForTest(noVAPFailedChecksResponseForTest),
 			}
 			if _, err := r.Reconcile(context.Background(), reconcile.Request{}); err != nil {
 				t.Fatalf("Reconcile() error = %v", err)
@@ -20,6 +20,7 @@ import (
 	kruntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/guardrails/config"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
@@ -178,6 +179,11 @@ func (r *Reconciler) gkTicker(ctx context.Context, instance *arov1alpha1.Cluster
 			err = r.ensurePolicy(ctx, gkPolicyConstraints, gkConstraintsPath)
 			if err != nil {
 				r.log.Errorf("gkTicker ensurePolicy error %s", err.Error())
+				continue
+			}
+			err = r.audit(ctx, operator.GuardrailsMethodGatekeeper)
+			if err != nil {
+				r.log.Errorf("gkTicker audit error %s", err.Error())
 			}
 		}
 	}
@@ -17,6 +17,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/Azure/ARO-RP/pkg/operator"
 	arov1alpha1 "github.com/Azure/ARO-RP/pkg/operator/apis/aro.openshift.io/v1alpha1"
 	"github.com/Azure/ARO-RP/pkg/operator/controllers/guardrails/config"
 	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
@@ -206,7 +207,8 @@ func (r *Reconciler) removeAllVAP(ctx context.Context) error {
 	return nil
 }
 
-// vapTicker periodically re-applies VAP policies and bindings to prevent them from being externally deleted.
+// vapTicker periodically re-applies VAP policies and bindings to prevent them from being externally deleted,
+// and audits their violations.
 func (r *Reconciler) vapTicker(ctx context.Context, instance *arov1alpha1.Cluster, done <-chan struct{}) {
 	var err error
 
@@ -233,6 +235,11 @@ func (r *Reconciler) vapTicker(ctx context.Context, instance *arov1alpha1.Cluste
 			err = r.deployVAP(ctx)
 			if err != nil {
 				r.log.Errorf("vapTicker deployVAP error %s", err.Error())
+				continue
+			}
+			err = r.audit(ctx, operator.GuardrailsMethodVAP)
+			if err != nil {
+				r.log.Errorf("vapTicker audit error %s", err.Error())
 			}
 		}
 	}
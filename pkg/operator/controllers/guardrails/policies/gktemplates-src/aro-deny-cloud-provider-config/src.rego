@@ -0,0 +1,18 @@
+package arodenycloudproviderconfig
+import future.keywords.in
+import data.lib.common.is_exempted_account
+import data.lib.common.get_username
+
+violation[{"msg": msg}] {
+    input.review.operation in ["UPDATE", "DELETE"]
+
+    # Check if it is the cloud provider config
+    input.review.object.metadata.namespace == "openshift-config"
+    input.review.object.metadata.name == "cloud-provider-config"
+
+    # Check if it is an exempted user
+    not is_exempted_account(input.review)
+    username := get_username(input.review)
+
+    msg := sprintf("user %v not allowed to %v configmap cloud-provider-config in namespace openshift-config", [username, input.review.operation])
+}
@@ -0,0 +1,68 @@
+package arodenycloudproviderconfig
+
+
+test_input_not_allowed_with_update {
+    input := {
+        "review": fake_config_map_input_review("cloud-provider-config", "openshift-config", "UPDATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 1
+}
+
+test_input_not_allowed_with_delete {
+    input := {
+        "review": fake_config_map_input_review("cloud-provider-config", "openshift-config", "DELETE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 1
+}
+
+test_input_allowed_with_create {
+    input := {
+        "review": fake_config_map_input_review("cloud-provider-config", "openshift-config", "CREATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+test_input_allowed_with_other_config_map {
+    input := {
+        "review": fake_config_map_input_review("user-ca-bundle", "openshift-config", "UPDATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+test_input_allowed_in_other_namespace {
+    input := {
+        "review": fake_config_map_input_review("cloud-provider-config", "mynamespace", "DELETE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+test_input_allowed_with_exempted_user {
+    input := {
+        "review": fake_config_map_input_review("cloud-provider-config", "openshift-config", "UPDATE", "system:admin")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+fake_config_map_input_review(name, namespace, operation, username) = review {
+    review = {
+        "operation": operation,
+        "kind": {
+            "kind": "ConfigMap"
+        },
+        "object": {
+            "metadata": {
+                "name": name,
+                "namespace": namespace
+            }
+        },
+        "userInfo":{
+            "username": username
+        }
+    }
+}
@@ -0,0 +1,19 @@
+package arodenyoperatorcluster
+import future.keywords.in
+import data.lib.common.is_exempted_account
+import data.lib.common.get_username
+
+violation[{"msg": msg}] {
+    input.review.operation in ["UPDATE", "DELETE"]
+
+    # Check if it is the ARO operator's cluster object
+    input.review.kind.group == "aro.openshift.io"
+    input.review.kind.kind == "Cluster"
+    input.review.object.metadata.name == "cluster"
+
+    # Check if it is an exempted user
+    not is_exempted_account(input.review)
+    username := get_username(input.review)
+
+    msg := sprintf("user %v not allowed to %v clusters.aro.openshift.io cluster", [username, input.review.operation])
+}
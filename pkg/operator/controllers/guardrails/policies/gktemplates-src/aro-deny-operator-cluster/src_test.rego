@@ -0,0 +1,68 @@
+package arodenyoperatorcluster
+
+
+test_input_not_allowed_with_update {
+    input := {
+        "review": fake_cluster_input_review("aro.openshift.io", "cluster", "UPDATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 1
+}
+
+test_input_not_allowed_with_delete {
+    input := {
+        "review": fake_cluster_input_review("aro.openshift.io", "cluster", "DELETE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 1
+}
+
+test_input_allowed_with_create {
+    input := {
+        "review": fake_cluster_input_review("aro.openshift.io", "cluster", "CREATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+test_input_allowed_with_other_name {
+    input := {
+        "review": fake_cluster_input_review("aro.openshift.io", "mycluster", "UPDATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+test_input_allowed_with_other_group {
+    input := {
+        "review": fake_cluster_input_review("example.com", "cluster", "DELETE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+test_input_allowed_with_exempted_user {
+    input := {
+        "review": fake_cluster_input_review("aro.openshift.io", "cluster", "UPDATE", "system:aro-service")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+fake_cluster_input_review(group, name, operation, username) = review {
+    review = {
+        "operation": operation,
+        "kind": {
+            "group": group,
+            "kind": "Cluster"
+        },
+        "object": {
+            "metadata": {
+                "name": name
+            }
+        },
+        "userInfo":{
+            "username": username
+        }
+    }
+}
@@ -0,0 +1,23 @@
+package arodenyoperatornamespace
+import future.keywords.in
+import data.lib.common.is_exempted_account
+import data.lib.common.get_username
+
+violation[{"msg": msg}] {
+    input.review.operation in ["CREATE", "UPDATE", "DELETE"]
+
+    # Check if it is a configmap in the ARO operator's namespace; the other
+    # kinds there are covered by aro-privileged-namespace-deny
+    input.review.kind.kind == "ConfigMap"
+    ns := input.review.object.metadata.namespace
+    ns == "openshift-azure-operator"
+
+    # Check if it is an exempted user
+    not is_exempted_account(input.review)
+    username := get_username(input.review)
+
+    # generateName'd objects have no name yet
+    name := object.get(input.review.object.metadata, "name", "")
+
+    msg := sprintf("user %v not allowed to %v %v %v in namespace %v", [username, input.review.operation, input.review.kind.kind, name, ns])
+}
@@ -0,0 +1,90 @@
+package arodenyoperatornamespace
+
+
+test_input_not_allowed_with_create {
+    input := {
+        "review": fake_input_review("ConfigMap", "myconfig", "openshift-azure-operator", "CREATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 1
+}
+
+test_input_not_allowed_with_update {
+    input := {
+        "review": fake_input_review("ConfigMap", "myconfig", "openshift-azure-operator", "UPDATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 1
+}
+
+test_input_not_allowed_with_delete {
+    input := {
+        "review": fake_input_review("ConfigMap", "myconfig", "openshift-azure-operator", "DELETE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 1
+}
+
+test_input_not_allowed_with_generate_name {
+    input := {
+        "review": {
+            "operation": "CREATE",
+            "kind": {
+                "kind": "ConfigMap"
+            },
+            "object": {
+                "metadata": {
+                    "generateName": "myconfig-",
+                    "namespace": "openshift-azure-operator"
+                }
+            },
+            "userInfo":{
+                "username": "testuser"
+            }
+        }
+    }
+    results := violation with input as input
+    count(results) == 1
+}
+
+test_input_allowed_in_other_namespace {
+    input := {
+        "review": fake_input_review("ConfigMap", "myconfig", "mynamespace", "CREATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+test_input_allowed_with_other_kind {
+    input := {
+        "review": fake_input_review("Secret", "cluster", "openshift-azure-operator", "UPDATE", "testuser")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+test_input_allowed_with_exempted_user {
+    input := {
+        "review": fake_input_review("ConfigMap", "myconfig", "openshift-azure-operator", "UPDATE", "system:kube-controller-manager")
+    }
+    results := violation with input as input
+    count(results) == 0
+}
+
+fake_input_review(kind, name, namespace, operation, username) = review {
+    review = {
+        "operation": operation,
+        "kind": {
+            "kind": kind
+        },
+        "object": {
+            "metadata": {
+                "name": name,
+                "namespace": namespace
+            }
+        },
+        "userInfo":{
+            "username": username
+        }
+    }
+}
@@ -0,0 +1,442 @@
+package guardrails
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+
+	// the v1 ValidatingAdmissionPolicy types are not available in our
+	// k8s.io/api version, but v1beta1 has the same schema
+	admissionregistrationv1beta1 "k8s.io/api/admissionregistration/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/Azure/ARO-RP/pkg/operator"
+	"github.com/Azure/ARO-RP/pkg/operator/controllers/guardrails/config"
+	"github.com/Azure/ARO-RP/pkg/util/dynamichelper"
+)
+
+func TestRenderGatekeeperConstraints(t *testing.T) {
+	constraintTemplateKinds := map[string]bool{}
+	err := fs.WalkDir(gkPolicyTemplates, gkTemplatePath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := fs.ReadFile(gkPolicyTemplates, path)
+		if err != nil {
+			return err
+		}
+		uns, err := dynamichelper.DecodeUnstructured(b)
+		if err != nil {
+			return err
+		}
+		kind, _, _ := unstructured.NestedString(uns.Object, "spec", "crd", "spec", "names", "kind")
+		constraintTemplateKinds[kind] = true
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl, err := template.ParseFS(gkPolicyConstraints, filepath.Join(gkConstraintsPath, "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, enforcement := range []string{operator.GuardrailsPolicyDeny, operator.GuardrailsPolicyWarn, operator.GuardrailsPolicyDryrun} {
+		for _, templ := range tmpl.Templates() {
+			t.Run(enforcement+"/"+templ.Name(), func(t *testing.T) {
+				buf := &bytes.Buffer{}
+				err := templ.Execute(buf, &config.GuardRailsPolicyConfig{Enforcement: enforcement})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				uns, err := dynamichelper.DecodeUnstructured(buf.Bytes())
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				// the file name is the flag suffix, so it must match the name
+				if uns.GetName() != strings.TrimSuffix(templ.Name(), ".yaml") {
+					t.Errorf("got name %s for %s", uns.GetName(), templ.Name())
+				}
+				if !constraintTemplateKinds[uns.GetKind()] {
+					t.Errorf("no ConstraintTemplate for kind %s", uns.GetKind())
+				}
+				action, _, _ := unstructured.NestedString(uns.Object, "spec", "enforcementAction")
+				if action != enforcement {
+					t.Errorf("got enforcementAction %s, wanted %s", action, enforcement)
+				}
+			})
+		}
+	}
+}
+
+func TestRenderVAPBindings(t *testing.T) {
+	entries, err := fs.ReadDir(vapPolicies, vapPolicyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, enforcement := range []string{operator.GuardrailsPolicyDeny, operator.GuardrailsPolicyWarn, operator.GuardrailsPolicyDryrun} {
+		for _, entry := range entries {
+			policyName := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+
+			t.Run(enforcement+"/"+policyName, func(t *testing.T) {
+				policy := readVAPPolicy(t, entry.Name())
+				if policy.Name != policyName {
+					t.Errorf("got name %s for %s", policy.Name, entry.Name())
+				}
+
+				tmpl, err := template.ParseFS(vapBindings, filepath.Join(vapBindingPath, policyName+"-binding.yaml"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				buf := &bytes.Buffer{}
+				err = tmpl.Execute(buf, &config.GuardRailsVAPBindingConfig{ValidationAction: vapValidationAction(enforcement)})
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				binding := &admissionregistrationv1beta1.ValidatingAdmissionPolicyBinding{}
+				err = yaml.UnmarshalStrict(buf.Bytes(), binding)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if binding.Name != policyName+"-binding" {
+					t.Errorf("got binding name %s", binding.Name)
+				}
+				if binding.Spec.PolicyName != policyName {
+					t.Errorf("got policyName %s", binding.Spec.PolicyName)
+				}
+				want := []admissionregistrationv1beta1.ValidationAction{admissionregistrationv1beta1.ValidationAction(vapValidationAction(enforcement))}
+				if !slices.Equal(binding.Spec.ValidationActions, want) {
+					t.Errorf("got validationActions %v, wanted %v", binding.Spec.ValidationActions, want)
+				}
+			})
+		}
+	}
+}
+
+// vapRequest is the part of an admission request which the VAP policies look
+// at
+type vapRequest struct {
+	operation string
+	group     string
+	resource  string
+	kind      string
+	name      string
+	namespace string
+	username  string
+	groups    []string
+}
+
+func TestVAPPolicies(t *testing.T) {
+	serviceAccountGroups := []string{"system:serviceaccounts", "system:serviceaccounts:openshift-azure-operator", "system:authenticated"}
+
+	for _, tt := range []struct {
+		name        string
+		policy      string
+		request     vapRequest
+		wantMatch   bool
+		wantMessage string
+	}{
+		{
+			name:        "cloud-provider-config: update is denied",
+			policy:      "aro-cloud-provider-config-deny",
+			request:     vapRequest{operation: "UPDATE", resource: "configmaps", kind: "ConfigMap", name: "cloud-provider-config", namespace: "openshift-config", username: "testuser"},
+			wantMatch:   true,
+			wantMessage: "user testuser not allowed to UPDATE configmap cloud-provider-config in namespace openshift-config",
+		},
+		{
+			name:        "cloud-provider-config: delete is denied",
+			policy:      "aro-cloud-provider-config-deny",
+			request:     vapRequest{operation: "DELETE", resource: "configmaps", kind: "ConfigMap", name: "cloud-provider-config", namespace: "openshift-config", username: "testuser"},
+			wantMatch:   true,
+			wantMessage: "user testuser not allowed to DELETE configmap cloud-provider-config in namespace openshift-config",
+		},
+		{
+			name:      "cloud-provider-config: update by the operator is allowed",
+			policy:    "aro-cloud-provider-config-deny",
+			request:   vapRequest{operation: "UPDATE", resource: "configmaps", kind: "ConfigMap", name: "cloud-provider-config", namespace: "openshift-config", username: "system:serviceaccount:openshift-azure-operator:aro-operator-master", groups: serviceAccountGroups},
+			wantMatch: true,
+		},
+		{
+			name:    "cloud-provider-config: other ConfigMaps are not matched",
+			policy:  "aro-cloud-provider-config-deny",
+			request: vapRequest{operation: "UPDATE", resource: "configmaps", kind: "ConfigMap", name: "user-ca-bundle", namespace: "openshift-config", username: "testuser"},
+		},
+		{
+			name:    "cloud-provider-config: other namespaces are not matched",
+			policy:  "aro-cloud-provider-config-deny",
+			request: vapRequest{operation: "DELETE", resource: "configmaps", kind: "ConfigMap", name: "cloud-provider-config", namespace: "mynamespace", username: "testuser"},
+		},
+		{
+			name:        "operator cluster: update is denied",
+			policy:      "aro-operator-cluster-deny",
+			request:     vapRequest{operation: "UPDATE", group: "aro.openshift.io", resource: "clusters", kind: "Cluster", name: "cluster", username: "testuser"},
+			wantMatch:   true,
+			wantMessage: "user testuser not allowed to UPDATE clusters.aro.openshift.io cluster",
+		},
+		{
+			name:      "operator cluster: delete by the RP is allowed",
+			policy:    "aro-operator-cluster-deny",
+			request:   vapRequest{operation: "DELETE", group: "aro.openshift.io", resource: "clusters", kind: "Cluster", name: "cluster", username: "system:aro-service", groups: []string{"system:masters"}},
+			wantMatch: true,
+		},
+		{
+			name:    "operator cluster: status updates are not matched",
+			policy:  "aro-operator-cluster-deny",
+			request: vapRequest{operation: "UPDATE", group: "aro.openshift.io", resource: "clusters/status", kind: "Cluster", name: "cluster", username: "testuser"},
+		},
+		{
+			name:    "operator cluster: other groups are not matched",
+			policy:  "aro-operator-cluster-deny",
+			request: vapRequest{operation: "UPDATE", group: "config.openshift.io", resource: "clusters", kind: "Cluster", name: "cluster", username: "testuser"},
+		},
+		{
+			name:        "operator namespace: create is denied",
+			policy:      "aro-operator-namespace-deny",
+			request:     vapRequest{operation: "CREATE", resource: "configmaps", kind: "ConfigMap", name: "myconfig", namespace: "openshift-azure-operator", username: "testuser"},
+			wantMatch:   true,
+			wantMessage: "user testuser not allowed to CREATE ConfigMap myconfig in namespace openshift-azure-operator",
+		},
+		{
+			name:        "operator namespace: create with generateName is denied",
+			policy:      "aro-operator-namespace-deny",
+			request:     vapRequest{operation: "CREATE", resource: "configmaps", kind: "ConfigMap", namespace: "openshift-azure-operator", username: "testuser"},
+			wantMatch:   true,
+			wantMessage: "user testuser not allowed to CREATE ConfigMap unknown in namespace openshift-azure-operator",
+		},
+		{
+			name:        "operator namespace: delete is denied",
+			policy:      "aro-operator-namespace-deny",
+			request:     vapRequest{operation: "DELETE", resource: "configmaps", kind: "ConfigMap", name: "myconfig", namespace: "openshift-azure-operator", username: "testuser"},
+			wantMatch:   true,
+			wantMessage: "user testuser not allowed to DELETE ConfigMap myconfig in namespace openshift-azure-operator",
+		},
+		{
+			name:      "operator namespace: update by the operator is allowed",
+			policy:    "aro-operator-namespace-deny",
+			request:   vapRequest{operation: "UPDATE", resource: "configmaps", kind: "ConfigMap", name: "myconfig", namespace: "openshift-azure-operator", username: "system:serviceaccount:openshift-azure-operator:aro-operator-master", groups: serviceAccountGroups},
+			wantMatch: true,
+		},
+		{
+			name:    "operator namespace: kinds covered by aro-privileged-namespace-deny are not matched",
+			policy:  "aro-operator-namespace-deny",
+			request: vapRequest{operation: "DELETE", resource: "secrets", kind: "Secret", name: "cluster", namespace: "openshift-azure-operator", username: "testuser"},
+		},
+		{
+			name:    "operator namespace: other namespaces are not matched",
+			policy:  "aro-operator-namespace-deny",
+			request: vapRequest{operation: "CREATE", resource: "configmaps", kind: "ConfigMap", name: "myconfig", namespace: "mynamespace", username: "testuser"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := readVAPPolicy(t, tt.policy+".yaml")
+
+			match := vapMatches(t, policy, &tt.request)
+			if match != tt.wantMatch {
+				t.Fatalf("got match %t, wanted %t", match, tt.wantMatch)
+			}
+			if !match {
+				return
+			}
+
+			message := evaluateVAP(t, policy, &tt.request)
+			if message != tt.wantMessage {
+				t.Errorf("got message %q, wanted %q", message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+func TestVAPPoliciesCompile(t *testing.T) {
+	entries, err := fs.ReadDir(vapPolicies, vapPolicyPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	env := vapEnv(t)
+	for _, entry := range entries {
+		t.Run(entry.Name(), func(t *testing.T) {
+			policy := readVAPPolicy(t, entry.Name())
+
+			var expressions []string
+			for _, c := range policy.Spec.MatchConditions {
+				expressions = append(expressions, c.Expression)
+			}
+			for _, v := range policy.Spec.Variables {
+				expressions = append(expressions, v.Expression)
+			}
+			for _, v := range policy.Spec.Validations {
+				expressions = append(expressions, v.Expression)
+				if v.MessageExpression != "" {
+					expressions = append(expressions, v.MessageExpression)
+				}
+			}
+
+			for _, expression := range expressions {
+				_, iss := env.Compile(expression)
+				if iss.Err() != nil {
+					t.Errorf("%s: %v", expression, iss.Err())
+				}
+			}
+		})
+	}
+}
+
+func readVAPPolicy(t *testing.T, filename string) *admissionregistrationv1beta1.ValidatingAdmissionPolicy {
+	t.Helper()
+
+	b, err := fs.ReadFile(vapPolicies, filepath.Join(vapPolicyPath, filename))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	policy := &admissionregistrationv1beta1.ValidatingAdmissionPolicy{}
+	err = yaml.UnmarshalStrict(b, policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return policy
+}
+
+// vapMatches returns whether the policy's match constraints select the
+// request, for the subset of the match constraints our policies use
+func vapMatches(t *testing.T, policy *admissionregistrationv1beta1.ValidatingAdmissionPolicy, request *vapRequest) bool {
+	t.Helper()
+
+	constraints := policy.Spec.MatchConstraints
+	if constraints.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(constraints.NamespaceSelector)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if request.namespace == "" || !selector.Matches(labels.Set{"kubernetes.io/metadata.name": request.namespace}) {
+			return false
+		}
+	}
+
+	matchesAny := func(values []string, value string) bool {
+		return slices.Contains(values, "*") || slices.Contains(values, value)
+	}
+
+	for _, rule := range constraints.ResourceRules {
+		operations := make([]string, 0, len(rule.Operations))
+		for _, op := range rule.Operations {
+			operations = append(operations, string(op))
+		}
+
+		// "*" matches all resources, but not their subresources
+		resourceMatches := slices.Contains(rule.Resources, request.resource) ||
+			(slices.Contains(rule.Resources, "*") && !strings.Contains(request.resource, "/"))
+
+		if matchesAny(operations, request.operation) &&
+			matchesAny(rule.APIGroups, request.group) &&
+			resourceMatches &&
+			(len(rule.ResourceNames) == 0 || slices.Contains(rule.ResourceNames, request.name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func vapEnv(t *testing.T) *cel.Env {
+	t.Helper()
+
+	env, err := cel.NewEnv(
+		cel.Variable("request", cel.DynType),
+		cel.Variable("object", cel.DynType),
+		cel.Variable("oldObject", cel.DynType),
+		cel.Variable("variables", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return env
+}
+
+// evaluateVAP evaluates the policy's variables and validations against the
+// request, returning the message of the first failing validation, or "" if
+// they all pass
+func evaluateVAP(t *testing.T, policy *admissionregistrationv1beta1.ValidatingAdmissionPolicy, request *vapRequest) string {
+	t.Helper()
+
+	env := vapEnv(t)
+
+	userInfo := map[string]any{"username": request.username}
+	if request.groups != nil {
+		userInfo["groups"] = request.groups
+	}
+	object := map[string]any{"metadata": map[string]any{"name": request.name, "namespace": request.namespace}}
+
+	activation := map[string]any{
+		"request": map[string]any{
+			"operation": request.operation,
+			"kind":      map[string]any{"group": request.group, "kind": request.kind},
+			"name":      request.name,
+			"namespace": request.namespace,
+			"userInfo":  userInfo,
+		},
+		"object":    object,
+		"oldObject": object,
+	}
+	switch request.operation {
+	case "CREATE":
+		activation["oldObject"] = types.NullValue
+	case "DELETE":
+		activation["object"] = types.NullValue
+	}
+
+	eval := func(expression string) any {
+		ast, iss := env.Compile(expression)
+		if iss.Err() != nil {
+			t.Fatalf("%s: %v", expression, iss.Err())
+		}
+		prg, err := env.Program(ast)
+		if err != nil {
+			t.Fatal(err)
+		}
+		val, _, err := prg.Eval(activation)
+		if err != nil {
+			t.Fatalf("%s: %v", expression, err)
+		}
+		return val
+	}
+
+	variables := map[string]any{}
+	activation["variables"] = variables
+	for _, v := range policy.Spec.Variables {
+		variables[v.Name] = eval(v.Expression)
+	}
+
+	for _, v := range policy.Spec.Validations {
+		if eval(v.Expression) == types.True {
+			continue
+		}
+		if v.MessageExpression == "" {
+			return v.Message
+		}
+		return strings.TrimSpace(eval(v.MessageExpression).(types.String).Value().(string))
+	}
+
+	return ""
+}
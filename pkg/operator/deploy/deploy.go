@@ -217,6 +217,20 @@ func (o *operator) resources(ctx context.Context) ([]kruntime.Object, error) {
 		results = append(results, operatorIdentitySecret)
 	}
 
+	// the key is kept in the cluster document, so that the backups can be
+	// decrypted without the cluster
+	if len(o.oc.Properties.EtcdBackupEncryptionKey) != 0 {
+		results = append(results, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pkgoperator.EtcdBackupKeySecretName,
+				Namespace: pkgoperator.Namespace,
+			},
+			Data: map[string][]byte{
+				pkgoperator.EtcdBackupKeySecretKey: o.oc.Properties.EtcdBackupEncryptionKey,
+			},
+		})
+	}
+
 	ps, err := pullsecret.Build(o.oc, "")
 	if err != nil {
 		return nil, err
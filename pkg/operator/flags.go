@@ -11,6 +11,7 @@ const (
 	BannerEnabled                       = "aro.banner.enabled"
 	CCOPprofNetworkPolicyEnabled        = "aro.ccopprof.enabled"
 	CheckerEnabled                      = "aro.checker.enabled"
+	InternetCheckerDaemonSetEnabled     = "aro.checker.internet.daemonset.enabled"
 	CPMSEnabled                         = "aro.cpms.enabled"
 	DnsmasqEnabled                      = "aro.dnsmasq.enabled"
 	RestartDnsmasqEnabled               = "aro.restartdnsmasq.enabled"
@@ -47,21 +48,28 @@ const (
 	ForceReconciliation                 = "aro.forcereconciliation"
 	EtcHostsEnabled                     = "aro.etchosts.enabled" // true = enable etchosts controller
 	EtcHostsManaged                     = "aro.etchosts.managed" // true = apply etchosts mc | false = remove etchosts mc
+	EtcdBackupEnabled                   = "aro.etcdbackup.enabled"
 	FlagTrue                            = "true"
 	FlagFalse                           = "false"
 
 	// Guardrails policies switches
-	GuardrailsPolicyMachineDenyManaged           = "aro.guardrails.policies.aro-machines-deny.managed"
-	GuardrailsPolicyMachineDenyEnforcement       = "aro.guardrails.policies.aro-machines-deny.enforcement"
-	GuardrailsPolicyMachineConfigDenyManaged     = "aro.guardrails.policies.aro-machine-config-deny.managed"
-	GuardrailsPolicyMachineConfigDenyEnforcement = "aro.guardrails.policies.aro-machine-config-deny.enforcement"
-	GuardrailsPolicyNodesDenyManaged             = "aro.guardrails.policies.aro-nodes-deny.managed"
-	GuardrailsPolicyNodesDenyEnforcement         = "aro.guardrails.policies.aro-nodes-deny.enforcement"
-	GuardrailsPolicyPrivNamespaceDenyManaged     = "aro.guardrails.policies.aro-privileged-namespace-deny.managed"
-	GuardrailsPolicyPrivNamespaceDenyEnforcement = "aro.guardrails.policies.aro-privileged-namespace-deny.enforcement"
-	GuardrailsPolicyDryrun                       = "dryrun"
-	GuardrailsPolicyWarn                         = "warn"
-	GuardrailsPolicyDeny                         = "deny"
+	GuardrailsPolicyMachineDenyManaged                 = "aro.guardrails.policies.aro-machines-deny.managed"
+	GuardrailsPolicyMachineDenyEnforcement             = "aro.guardrails.policies.aro-machines-deny.enforcement"
+	GuardrailsPolicyMachineConfigDenyManaged           = "aro.guardrails.policies.aro-machine-config-deny.managed"
+	GuardrailsPolicyMachineConfigDenyEnforcement       = "aro.guardrails.policies.aro-machine-config-deny.enforcement"
+	GuardrailsPolicyNodesDenyManaged                   = "aro.guardrails.policies.aro-nodes-deny.managed"
+	GuardrailsPolicyNodesDenyEnforcement               = "aro.guardrails.policies.aro-nodes-deny.enforcement"
+	GuardrailsPolicyPrivNamespaceDenyManaged           = "aro.guardrails.policies.aro-privileged-namespace-deny.managed"
+	GuardrailsPolicyPrivNamespaceDenyEnforcement       = "aro.guardrails.policies.aro-privileged-namespace-deny.enforcement"
+	GuardrailsPolicyCloudProviderConfigDenyManaged     = "aro.guardrails.policies.aro-cloud-provider-config-deny.managed"
+	GuardrailsPolicyCloudProviderConfigDenyEnforcement = "aro.guardrails.policies.aro-cloud-provider-config-deny.enforcement"
+	GuardrailsPolicyOperatorClusterDenyManaged         = "aro.guardrails.policies.aro-operator-cluster-deny.managed"
+	GuardrailsPolicyOperatorClusterDenyEnforcement     = "aro.guardrails.policies.aro-operator-cluster-deny.enforcement"
+	GuardrailsPolicyOperatorNamespaceDenyManaged       = "aro.guardrails.policies.aro-operator-namespace-deny.managed"
+	GuardrailsPolicyOperatorNamespaceDenyEnforcement   = "aro.guardrails.policies.aro-operator-namespace-deny.enforcement"
+	GuardrailsPolicyDryrun                             = "dryrun"
+	GuardrailsPolicyWarn                               = "warn"
+	GuardrailsPolicyDeny                               = "deny"
 
 	// GuardrailsMethod selects how the Guardrails controller enforces
 	// policies on a cluster. Allowed values:
@@ -87,6 +95,7 @@ func DefaultOperatorFlags() map[string]string {
 		BannerEnabled:                      FlagFalse,
 		CCOPprofNetworkPolicyEnabled:       FlagTrue,
 		CheckerEnabled:                     FlagTrue,
+		InternetCheckerDaemonSetEnabled:    FlagFalse,
 		CPMSEnabled:                        FlagFalse,
 		DnsmasqEnabled:                     FlagTrue,
 		RestartDnsmasqEnabled:              FlagFalse,
@@ -117,16 +126,23 @@ func DefaultOperatorFlags() map[string]string {
 		ForceReconciliation:                FlagFalse,
 		EtcHostsEnabled:                    FlagTrue,
 		EtcHostsManaged:                    FlagTrue,
+		EtcdBackupEnabled:                  FlagFalse,
 
 		// Guardrails policies switches
-		GuardrailsPolicyMachineDenyManaged:           FlagTrue,
-		GuardrailsPolicyMachineDenyEnforcement:       GuardrailsPolicyDeny,
-		GuardrailsPolicyMachineConfigDenyManaged:     FlagTrue,
-		GuardrailsPolicyMachineConfigDenyEnforcement: GuardrailsPolicyDryrun,
-		GuardrailsPolicyNodesDenyManaged:             FlagTrue,
-		GuardrailsPolicyNodesDenyEnforcement:         GuardrailsPolicyDeny,
-		GuardrailsPolicyPrivNamespaceDenyManaged:     FlagTrue,
-		GuardrailsPolicyPrivNamespaceDenyEnforcement: GuardrailsPolicyDryrun,
+		GuardrailsPolicyMachineDenyManaged:                 FlagTrue,
+		GuardrailsPolicyMachineDenyEnforcement:             GuardrailsPolicyDeny,
+		GuardrailsPolicyMachineConfigDenyManaged:           FlagTrue,
+		GuardrailsPolicyMachineConfigDenyEnforcement:       GuardrailsPolicyDryrun,
+		GuardrailsPolicyNodesDenyManaged:                   FlagTrue,
+		GuardrailsPolicyNodesDenyEnforcement:               GuardrailsPolicyDeny,
+		GuardrailsPolicyPrivNamespaceDenyManaged:           FlagTrue,
+		GuardrailsPolicyPrivNamespaceDenyEnforcement:       GuardrailsPolicyDryrun,
+		GuardrailsPolicyCloudProviderConfigDenyManaged:     FlagTrue,
+		GuardrailsPolicyCloudProviderConfigDenyEnforcement: GuardrailsPolicyDryrun,
+		GuardrailsPolicyOperatorClusterDenyManaged:         FlagTrue,
+		GuardrailsPolicyOperatorClusterDenyEnforcement:     GuardrailsPolicyDryrun,
+		GuardrailsPolicyOperatorNamespaceDenyManaged:       FlagTrue,
+		GuardrailsPolicyOperatorNamespaceDenyEnforcement:   GuardrailsPolicyDryrun,
 
 		// New clusters opt-in to automatic VAP-on-4.17+ selection. Existing
 		// clusters that do not yet have this flag fall back to Gatekeeper via
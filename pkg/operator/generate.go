@@ -10,5 +10,5 @@ package operator
 // for master deployment
 //go:generate controller-gen crd paths="./apis/..." output:crd:dir=deploy/staticresources
 // for worker deployment - less privileges as it only runs the internetchecker
-// rbac (based on in-code tags - search for "+kubebuilder:rbac")
-//go:generate controller-gen rbac:roleName=aro-operator-worker paths="./controllers/checkers/internetchecker/..." output:dir=deploy/staticresources/worker
+// and dnsresolutionchecker rbac (based on in-code tags - search for "+kubebuilder:rbac")
+//go:generate controller-gen rbac:roleName=aro-operator-worker paths={"./controllers/checkers/internetchecker/...","./controllers/checkers/dnsresolutionchecker/..."} output:dir=deploy/staticresources/worker
@@ -0,0 +1,99 @@
+package tracing
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var errExporterShutdown = errors.New("exporter is shut down")
+
+// jsonSpan is the representation of a span written by the JSON exporter.
+type jsonSpan struct {
+	Name         string            `json:"name"`
+	TraceID      string            `json:"traceId"`
+	SpanID       string            `json:"spanId"`
+	ParentSpanID string            `json:"parentSpanId,omitempty"`
+	StartTime    time.Time         `json:"startTime"`
+	EndTime      time.Time         `json:"endTime"`
+	Status       string            `json:"status"`
+	Description  string            `json:"description,omitempty"`
+	Attributes   map[string]string `json:"attributes,omitempty"`
+}
+
+// JSONExporter is a span exporter which writes one JSON object per span to
+// an io.WriteCloser. It is intended for local development and testing.
+type JSONExporter struct {
+	mu       sync.Mutex
+	w        io.WriteCloser
+	enc      *json.Encoder
+	shutdown bool
+}
+
+var _ sdktrace.SpanExporter = &JSONExporter{}
+
+// NewJSONExporter returns a JSONExporter writing to w. w is closed when the
+// exporter is shut down.
+func NewJSONExporter(w io.WriteCloser) *JSONExporter {
+	return &JSONExporter{
+		w:   w,
+		enc: json.NewEncoder(w),
+	}
+}
+
+func (e *JSONExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.shutdown {
+		return errExporterShutdown
+	}
+
+	for _, s := range spans {
+		js := jsonSpan{
+			Name:        s.Name(),
+			TraceID:     s.SpanContext().TraceID().String(),
+			SpanID:      s.SpanContext().SpanID().String(),
+			StartTime:   s.StartTime(),
+			EndTime:     s.EndTime(),
+			Status:      s.Status().Code.String(),
+			Description: s.Status().Description,
+		}
+		if s.Parent().IsValid() {
+			js.ParentSpanID = s.Parent().SpanID().String()
+		}
+		if attrs := s.Attributes(); len(attrs) > 0 {
+			js.Attributes = make(map[string]string, len(attrs))
+			for _, a := range attrs {
+				js.Attributes[string(a.Key)] = a.Value.Emit()
+			}
+		}
+
+		err := e.enc.Encode(js)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *JSONExporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.shutdown {
+		return nil
+	}
+	e.shutdown = true
+
+	return e.w.Close()
+}
@@ -0,0 +1,129 @@
+package tracing
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/Azure/ARO-RP"
+
+	// exporterEnvVar selects the trace exporter: "otlp", "stdout", "file"
+	// or "none" (the default). The OTLP exporter is further configured by
+	// the standard OTEL_EXPORTER_OTLP_* environment variables.
+	exporterEnvVar = "OTEL_TRACES_EXPORTER"
+	// fileEnvVar is the path spans are written to by the "file" exporter.
+	fileEnvVar = "ARO_OTEL_TRACES_FILE"
+)
+
+// Attribute keys shared by ARO spans.
+const (
+	AttributeResourceID    = attribute.Key("aro.resource_id")
+	AttributeCorrelationID = attribute.Key("aro.correlation_id")
+	AttributeOperation     = attribute.Key("aro.operation")
+	AttributeStep          = attribute.Key("aro.step")
+)
+
+// Setup configures the global OpenTelemetry tracer provider from the
+// environment. It returns a function which flushes any buffered spans and
+// shuts the provider down. If tracing is not enabled, the global no-op
+// provider is left in place.
+func Setup(ctx context.Context, log *logrus.Entry, serviceName string) (func(context.Context) error, error) {
+	exporter, err := newExporter(ctx, os.Getenv(exporterEnvVar))
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	log.Infof("exporting traces using %q exporter", os.Getenv(exporterEnvVar))
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+func newExporter(ctx context.Context, name string) (sdktrace.SpanExporter, error) {
+	switch name {
+	case "", "none":
+		return nil, nil
+	case "otlp":
+		return otlptracehttp.New(ctx)
+	case "stdout":
+		return NewJSONExporter(nopCloser{os.Stdout}), nil
+	case "file":
+		path := os.Getenv(fileEnvVar)
+		if path == "" {
+			return nil, fmt.Errorf("environment variable %q must be set for the file trace exporter", fileEnvVar)
+		}
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return NewJSONExporter(f), nil
+	default:
+		return nil, fmt.Errorf("unsupported trace exporter %q", name)
+	}
+}
+
+type attributesKey struct{}
+
+// ContextWithAttributes returns a context carrying attributes which are
+// added to every span subsequently started from it with Start. This allows
+// identifying attributes (e.g. the cluster resource ID) to be set once for an
+// operation and inherited by the spans of its steps.
+func ContextWithAttributes(ctx context.Context, attrs ...attribute.KeyValue) context.Context {
+	existing := attributesFromContext(ctx)
+	merged := make([]attribute.KeyValue, 0, len(existing)+len(attrs))
+	merged = append(merged, existing...)
+	merged = append(merged, attrs...)
+	return context.WithValue(ctx, attributesKey{}, merged)
+}
+
+func attributesFromContext(ctx context.Context) []attribute.KeyValue {
+	attrs, _ := ctx.Value(attributesKey{}).([]attribute.KeyValue)
+	return attrs
+}
+
+// Start starts a span named `name` as a child of any span in `ctx`, with the
+// attributes carried by `ctx` and `attrs`.
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	all := append(append([]attribute.KeyValue{}, attributesFromContext(ctx)...), attrs...)
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(all...))
+}
+
+// End records `err`, if any, on `span` and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
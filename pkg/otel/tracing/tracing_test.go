@@ -0,0 +1,165 @@
+package tracing
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestStartInheritsContextAttributes(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(tp)
+
+	ctx := ContextWithAttributes(context.Background(), AttributeResourceID.String("/subscriptions/sub/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster"))
+	ctx = ContextWithAttributes(ctx, AttributeCorrelationID.String("correlation"))
+
+	ctx, parent := Start(ctx, "parent")
+	_, child := Start(ctx, "child", AttributeStep.String("step"))
+	End(child, errors.New("oh no!"))
+	End(parent, nil)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("wanted 2 spans, got %d", len(spans))
+	}
+
+	c, p := spans[0], spans[1]
+	if c.Parent.SpanID() != p.SpanContext.SpanID() {
+		t.Error("child span is not nested under parent span")
+	}
+
+	attrs := map[string]string{}
+	for _, a := range c.Attributes {
+		attrs[string(a.Key)] = a.Value.Emit()
+	}
+	for k, v := range map[string]string{
+		"aro.resource_id":    "/subscriptions/sub/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster",
+		"aro.correlation_id": "correlation",
+		"aro.step":           "step",
+	} {
+		if attrs[k] != v {
+			t.Errorf("wanted attribute %s=%q, got %q", k, v, attrs[k])
+		}
+	}
+
+	if c.Status.Code != codes.Error || c.Status.Description != "oh no!" {
+		t.Errorf("unexpected child status %v", c.Status)
+	}
+	if p.Status.Code != codes.Unset {
+		t.Errorf("unexpected parent status %v", p.Status)
+	}
+}
+
+type bufferCloser struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *bufferCloser) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestJSONExporter(t *testing.T) {
+	ctx := context.Background()
+	buf := &bufferCloser{}
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(NewJSONExporter(buf)))
+
+	ctx, parent := tp.Tracer("test").Start(ctx, "parent")
+	_, child := tp.Tracer("test").Start(ctx, "child")
+	child.SetAttributes(AttributeStep.String("step"))
+	child.End()
+	parent.End()
+
+	err := tp.Shutdown(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !buf.closed {
+		t.Error("expected writer to be closed on shutdown")
+	}
+
+	dec := json.NewDecoder(&buf.Buffer)
+	var spans []jsonSpan
+	for dec.More() {
+		var s jsonSpan
+		err := dec.Decode(&s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		spans = append(spans, s)
+	}
+
+	if len(spans) != 2 {
+		t.Fatalf("wanted 2 spans, got %d", len(spans))
+	}
+	if spans[0].Name != "child" || spans[0].ParentSpanID != spans[1].SpanID || spans[0].Attributes["aro.step"] != "step" {
+		t.Errorf("unexpected child span %#v", spans[0])
+	}
+	if spans[1].Name != "parent" || spans[1].ParentSpanID != "" {
+		t.Errorf("unexpected parent span %#v", spans[1])
+	}
+}
+
+func TestNewExporter(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		exporter string
+		file     string
+		wantNil  bool
+		wantErr  string
+	}{
+		{
+			name:    "disabled by default",
+			wantNil: true,
+		},
+		{
+			name:     "none",
+			exporter: "none",
+			wantNil:  true,
+		},
+		{
+			name:     "stdout",
+			exporter: "stdout",
+		},
+		{
+			name:     "file",
+			exporter: "file",
+			file:     t.TempDir() + "/traces.json",
+		},
+		{
+			name:     "file without path",
+			exporter: "file",
+			wantErr:  `environment variable "ARO_OTEL_TRACES_FILE" must be set for the file trace exporter`,
+		},
+		{
+			name:     "unknown",
+			exporter: "zipkin",
+			wantErr:  `unsupported trace exporter "zipkin"`,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(fileEnvVar, tt.file)
+
+			exporter, err := newExporter(context.Background(), tt.exporter)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if err == nil && (exporter == nil) != tt.wantNil {
+				t.Errorf("wanted nil exporter: %t, got %v", tt.wantNil, exporter)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package portal
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
+)
+
+func (p *portal) healthSnapshot(w http.ResponseWriter, r *http.Request) {
+	dbOpenShiftClusters, err := p.dbGroup.OpenShiftClusters()
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	dbClusterHealthSnapshots, err := p.dbGroup.ClusterHealthSnapshots()
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	ctx := r.Context()
+
+	apiVars := mux.Vars(r)
+	resourceId := p.getResourceID(apiVars["subscription"], apiVars["resourceGroup"], apiVars["clusterName"])
+
+	doc, err := dbOpenShiftClusters.Get(ctx, resourceId)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		http.Error(w, "Cluster not found", http.StatusNotFound)
+		return
+	case err != nil:
+		p.internalServerError(w, err)
+		return
+	}
+
+	snapshot, err := dbClusterHealthSnapshots.Get(ctx, doc.ID)
+	switch {
+	case cosmosdb.IsErrorStatusCode(err, http.StatusNotFound):
+		http.Error(w, "Health snapshot not found", http.StatusNotFound)
+		return
+	case err != nil:
+		p.internalServerError(w, err)
+		return
+	}
+
+	b, err := json.MarshalIndent(api.APIs[admin.APIVersion].ClusterHealthSnapshotConverter.ToExternal(snapshot), "", "    ")
+	if err != nil {
+		p.internalServerError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
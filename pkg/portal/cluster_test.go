@@ -14,8 +14,11 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/api/admin"
 	"github.com/Azure/ARO-RP/pkg/database"
+	"github.com/Azure/ARO-RP/pkg/database/cosmosdb"
 	testdatabase "github.com/Azure/ARO-RP/test/database"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
 )
 
 func TestClusterList(t *testing.T) {
@@ -242,3 +245,137 @@ func TestClusterDetail(t *testing.T) {
 		t.Error(l)
 	}
 }
+
+func TestHealthSnapshot(t *testing.T) {
+	dbOpenShiftClusters, _ := testdatabase.NewFakeOpenShiftClusters()
+	dbClusterHealthSnapshots, clusterHealthSnapshotsClient := testdatabase.NewFakeClusterHealthSnapshots()
+
+	fixture := testdatabase.NewFixture().
+		WithOpenShiftClusters(dbOpenShiftClusters).
+		WithClusterHealthSnapshots(dbClusterHealthSnapshots)
+
+	fixture.AddOpenShiftClusterDocuments(
+		&api.OpenShiftClusterDocument{
+			ID:  "00000000-0000-0000-0000-000000000000",
+			Key: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/healthy",
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroupName/providers/microsoft.redhatopenshift/openshiftclusters/healthy",
+			},
+		},
+		&api.OpenShiftClusterDocument{
+			ID:  "00000000-0000-0000-0000-000000000001",
+			Key: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourcegroupname/providers/microsoft.redhatopenshift/openshiftclusters/unmonitored",
+			OpenShiftCluster: &api.OpenShiftCluster{
+				ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroupName/providers/microsoft.redhatopenshift/openshiftclusters/unmonitored",
+			},
+		})
+
+	fixture.AddClusterHealthSnapshotDocuments(
+		&api.ClusterHealthSnapshotDocument{
+			ID:                "00000000-0000-0000-0000-000000000000",
+			ClusterResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroupName/providers/microsoft.redhatopenshift/openshiftclusters/healthy",
+			ClusterHealthSnapshot: api.ClusterHealthSnapshot{
+				CollectedAt: 1000,
+				Collectors: []api.ClusterHealthCollectorResult{
+					{
+						Name:        "emitClusterOperatorConditions",
+						CollectedAt: 1000,
+						Conditions: []api.ClusterHealthCondition{
+							{Name: "ingress", Type: "Degraded", Status: "True"},
+						},
+					},
+				},
+			},
+		})
+
+	err := fixture.Create()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dbg := database.NewDBGroup().
+		WithOpenShiftClusters(dbOpenShiftClusters).
+		WithClusterHealthSnapshots(dbClusterHealthSnapshots)
+
+	_, log := testlog.New()
+
+	p := &portal{
+		log:     log,
+		dbGroup: dbg,
+	}
+
+	for _, tt := range []struct {
+		name           string
+		url            string
+		dbErr          error
+		wantStatusCode int
+		wantResponse   *admin.ClusterHealthSnapshot
+	}{
+		{
+			name:           "snapshot",
+			url:            "/api/00000000-0000-0000-0000-000000000000/resourcegroupname/healthy/healthsnapshot",
+			wantStatusCode: http.StatusOK,
+			wantResponse: &admin.ClusterHealthSnapshot{
+				ClusterResourceID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/resourceGroupName/providers/microsoft.redhatopenshift/openshiftclusters/healthy",
+				CollectedAt:       1000,
+				Collectors: []admin.ClusterHealthCollectorResult{
+					{
+						Name:        "emitClusterOperatorConditions",
+						CollectedAt: 1000,
+						Conditions: []admin.ClusterHealthCondition{
+							{Name: "ingress", Type: "Degraded", Status: "True"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:           "no snapshot recorded",
+			url:            "/api/00000000-0000-0000-0000-000000000000/resourcegroupname/unmonitored/healthsnapshot",
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "cluster not found",
+			url:            "/api/00000000-0000-0000-0000-000000000000/resourcegroupname/missing/healthsnapshot",
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "database error",
+			url:            "/api/00000000-0000-0000-0000-000000000000/resourcegroupname/healthy/healthsnapshot",
+			dbErr:          &cosmosdb.Error{StatusCode: http.StatusTooManyRequests},
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			clusterHealthSnapshotsClient.SetError(tt.dbErr)
+
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			aadAuthenticatedRouter := mux.NewRouter()
+			p.aadAuthenticatedRoutes(aadAuthenticatedRouter, nil, nil, nil)
+			w := httptest.NewRecorder()
+			aadAuthenticatedRouter.ServeHTTP(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatal(w.Code)
+			}
+
+			if tt.wantResponse == nil {
+				return
+			}
+
+			var r *admin.ClusterHealthSnapshot
+			err = json.NewDecoder(w.Body).Decode(&r)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, l := range deep.Equal(tt.wantResponse, r) {
+				t.Error(l)
+			}
+		})
+	}
+}
@@ -44,6 +44,7 @@ import (
 type portalDBs interface {
 	database.DatabaseGroupWithOpenShiftClusters
 	database.DatabaseGroupWithPortal
+	database.DatabaseGroupWithClusterHealthSnapshots
 }
 
 type Runnable interface {
@@ -302,6 +303,7 @@ func (p *portal) aadAuthenticatedRoutes(r *mux.Router, prom *prometheus.Promethe
 	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}/machines").HandlerFunc(p.machines)
 	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}/machine-sets").HandlerFunc(p.machineSets)
 	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}/statistics/{statisticsType}").HandlerFunc(p.statistics)
+	r.Methods(http.MethodGet).Path("/api/{subscription}/{resourceGroup}/{clusterName}/healthsnapshot").HandlerFunc(p.healthSnapshot)
 	r.Path("/api/{subscription}/{resourceGroup}/{clusterName}").HandlerFunc(p.clusterInfo)
 
 	// prometheus
@@ -17,6 +17,7 @@ import (
 	v20231122 "github.com/Azure/ARO-RP/pkg/api/v20231122"
 	v20240812preview "github.com/Azure/ARO-RP/pkg/api/v20240812preview"
 	v20250725 "github.com/Azure/ARO-RP/pkg/api/v20250725"
+	v20261001preview "github.com/Azure/ARO-RP/pkg/api/v20261001preview"
 )
 
 const (
@@ -30,6 +31,7 @@ const (
 	apiv20231122Path        = "github.com/Azure/ARO-RP/pkg/api/v20231122"
 	apiv20240812previewPath = "github.com/Azure/ARO-RP/pkg/api/v20240812preview"
 	apiv20250725Path        = "github.com/Azure/ARO-RP/pkg/api/v20250725"
+	apiv20261001previewPath = "github.com/Azure/ARO-RP/pkg/api/v20261001preview"
 )
 
 type generator struct {
@@ -237,6 +239,31 @@ var apis = map[string]*generator{
 		examplePlatformWorkloadIdentityRoleSetResponse:     v20250725.ExamplePlatformWorkloadIdentityRoleSetResponse,
 		exampleOperationListResponse:                       api.ExampleOperationListResponse,
 
+		xmsEnum:                []string{"ProvisioningState", "PreconfiguredNSG", "EncryptionAtHost", "FipsValidatedModules", "SoftwareDefinedNetwork", "Visibility", "OutboundType", "ManagedServiceIdentityType"},
+		xmsSecretList:          []string{"kubeconfig", "kubeadminPassword", "secretResources"},
+		xmsIdentifiers:         []string{},
+		commonTypesVersion:     "v6",
+		managedServiceIdentity: true,
+		systemData:             true,
+		installVersionList:     true,
+		installVersionGet:      true,
+		kubeConfig:             true,
+		workerProfilesStatus:   true,
+		roleSetList:            true,
+	}, apiv20261001previewPath: {
+		exampleOpenShiftClusterPutParameter:                v20261001preview.ExampleOpenShiftClusterPutParameter,
+		exampleOpenShiftClusterPatchParameter:              v20261001preview.ExampleOpenShiftClusterPatchParameter,
+		exampleOpenShiftClusterGetResponse:                 v20261001preview.ExampleOpenShiftClusterGetResponse,
+		exampleOpenShiftClusterPutOrPatchResponse:          v20261001preview.ExampleOpenShiftClusterPutOrPatchResponse,
+		exampleOpenShiftClusterCredentialsResponse:         v20261001preview.ExampleOpenShiftClusterCredentialsResponse,
+		exampleOpenShiftClusterListResponse:                v20261001preview.ExampleOpenShiftClusterListResponse,
+		exampleOpenShiftClusterAdminKubeconfigResponse:     v20261001preview.ExampleOpenShiftClusterAdminKubeconfigResponse,
+		exampleOpenShiftVersionListResponse:                v20261001preview.ExampleOpenShiftVersionListResponse,
+		exampleOpenShiftVersionResponse:                    v20261001preview.ExampleOpenShiftVersionResponse,
+		examplePlatformWorkloadIdentityRoleSetListResponse: v20261001preview.ExamplePlatformWorkloadIdentityRoleSetListResponse,
+		examplePlatformWorkloadIdentityRoleSetResponse:     v20261001preview.ExamplePlatformWorkloadIdentityRoleSetResponse,
+		exampleOperationListResponse:                       api.ExampleOperationListResponse,
+
 		xmsEnum:                []string{"ProvisioningState", "PreconfiguredNSG", "EncryptionAtHost", "FipsValidatedModules", "SoftwareDefinedNetwork", "Visibility", "OutboundType", "ManagedServiceIdentityType"},
 		xmsSecretList:          []string{"kubeconfig", "kubeadminPassword", "secretResources"},
 		xmsIdentifiers:         []string{},
@@ -6,12 +6,15 @@ package azblob
 import (
 	"context"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
 	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
 )
 
 type BlobsClientAddons interface {
 	BlobExists(ctx context.Context, container string, blobPath string) (bool, error)
+	CreateContainer(ctx context.Context, container string) error
 	DeleteContainer(ctx context.Context, container string) error
+	ListBlobNames(ctx context.Context, container string, prefix string) ([]string, error)
 }
 
 func (client *blobsClient) BlobExists(ctx context.Context, container string, blobPath string) (bool, error) {
@@ -33,6 +36,20 @@ func (client *blobsClient) BlobExists(ctx context.Context, container string, blo
 	return true, nil
 }
 
+func (client *blobsClient) CreateContainer(ctx context.Context, container string) error {
+	containerRef := client.ServiceClient().NewContainerClient(container)
+	_, err := containerRef.Create(ctx, nil)
+	if err != nil {
+		if bloberror.HasCode(
+			err,
+			bloberror.ContainerAlreadyExists,
+		) {
+			return nil
+		}
+	}
+	return err
+}
+
 func (client *blobsClient) DeleteContainer(ctx context.Context, container string) error {
 	containerRef := client.ServiceClient().NewContainerClient(container)
 	_, err := containerRef.Delete(ctx, nil)
@@ -47,3 +64,31 @@ func (client *blobsClient) DeleteContainer(ctx context.Context, container string
 	}
 	return err
 }
+
+func (client *blobsClient) ListBlobNames(ctx context.Context, container string, prefix string) ([]string, error) {
+	var names []string
+
+	pager := client.NewListBlobsFlatPager(container, &azblob.ListBlobsFlatOptions{
+		Prefix: &prefix,
+	})
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			if bloberror.HasCode(
+				err,
+				bloberror.ContainerNotFound,
+			) {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		for _, item := range page.Segment.BlobItems {
+			if item.Name != nil {
+				names = append(names, *item.Name)
+			}
+		}
+	}
+
+	return names, nil
+}
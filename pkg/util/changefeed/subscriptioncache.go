@@ -103,9 +103,14 @@ func (r *subscriptionsChangeFeedResponder) OnDoc(sub *api.SubscriptionDocument)
 		return
 	}
 
+	var tenantID string
+	if sub.Subscription.Properties != nil {
+		tenantID = strings.ToLower(sub.Subscription.Properties.TenantID)
+	}
+
 	r.subs.Compute(id, func(oldValue subscriptionInfo, loaded bool) (subscriptionInfo, xsync.ComputeOp) {
 		new := subscriptionInfo{
-			TenantID: strings.ToLower(sub.Subscription.Properties.TenantID),
+			TenantID: tenantID,
 			State:    sub.Subscription.State,
 		}
 
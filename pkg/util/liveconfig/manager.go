@@ -26,6 +26,9 @@ type Manager interface {
 
 	// Allows overriding the default installer pullspec for Prod, if the OpenShiftVersions database is not populated
 	DefaultInstallerPullSpecOverride(context.Context) string
+
+	// Overrides which cluster monitor collectors run
+	MonitorCollectors(context.Context) (*MonitorCollectors, error)
 }
 
 type dev struct {
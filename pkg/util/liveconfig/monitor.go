@@ -0,0 +1,88 @@
+package liveconfig
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+const monitorCollectorsEnvVar = "ARO_MONITOR_COLLECTORS"
+
+// MonitorCollectors enables and disables cluster monitor collectors by name,
+// overriding whether they are enabled by default.
+type MonitorCollectors struct {
+	Enabled  []string `json:"enabled,omitempty"`
+	Disabled []string `json:"disabled,omitempty"`
+
+	// Clusters holds overrides for individual clusters, keyed by resource ID.
+	// They take precedence over Enabled and Disabled.
+	Clusters map[string]MonitorCollectorsOverride `json:"clusters,omitempty"`
+}
+
+// MonitorCollectorsOverride enables and disables collectors for a single
+// cluster.
+type MonitorCollectorsOverride struct {
+	Enabled  []string `json:"enabled,omitempty"`
+	Disabled []string `json:"disabled,omitempty"`
+}
+
+// IsEnabled returns whether the collector called `name` should run on the
+// cluster `resourceID`, given whether it is enabled by default.
+func (c *MonitorCollectors) IsEnabled(resourceID, name string, enabledByDefault bool) bool {
+	if c == nil {
+		return enabledByDefault
+	}
+
+	for id, o := range c.Clusters {
+		if !strings.EqualFold(id, resourceID) {
+			continue
+		}
+		if slices.Contains(o.Disabled, name) {
+			return false
+		}
+		if slices.Contains(o.Enabled, name) {
+			return true
+		}
+	}
+
+	if slices.Contains(c.Disabled, name) {
+		return false
+	}
+	if slices.Contains(c.Enabled, name) {
+		return true
+	}
+
+	return enabledByDefault
+}
+
+// monitorCollectors parses the JSON formatted MonitorCollectors in the
+// ARO_MONITOR_COLLECTORS environment variable, if set
+func monitorCollectors() (*MonitorCollectors, error) {
+	// TODO: Replace with RP Live Service Config (KeyVault)
+	s := os.Getenv(monitorCollectorsEnvVar)
+	if s == "" {
+		return &MonitorCollectors{}, nil
+	}
+
+	c := &MonitorCollectors{}
+	err := json.Unmarshal([]byte(s), c)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", monitorCollectorsEnvVar, err)
+	}
+
+	return c, nil
+}
+
+func (d *dev) MonitorCollectors(ctx context.Context) (*MonitorCollectors, error) {
+	return monitorCollectors()
+}
+
+func (p *prod) MonitorCollectors(ctx context.Context) (*MonitorCollectors, error) {
+	return monitorCollectors()
+}
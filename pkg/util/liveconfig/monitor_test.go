@@ -0,0 +1,75 @@
+package liveconfig
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+)
+
+func TestMonitorCollectors(t *testing.T) {
+	const resourceID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.RedHatOpenShift/openShiftClusters/cluster"
+
+	for _, tt := range []struct {
+		name             string
+		env              string
+		collector        string
+		enabledByDefault bool
+		want             bool
+		wantErr          string
+	}{
+		{
+			name:             "unset keeps the default",
+			collector:        "emitPodConditions",
+			enabledByDefault: true,
+			want:             true,
+		},
+		{
+			name:             "disabled for all clusters",
+			env:              `{"disabled":["emitPrometheusAlerts"]}`,
+			collector:        "emitPrometheusAlerts",
+			enabledByDefault: true,
+		},
+		{
+			name:      "enabled for all clusters",
+			env:       `{"enabled":["emitPrometheusAlerts"]}`,
+			collector: "emitPrometheusAlerts",
+			want:      true,
+		},
+		{
+			name:             "disabled for all clusters but enabled for this one",
+			env:              `{"disabled":["emitPrometheusAlerts"],"clusters":{"` + resourceID + `":{"enabled":["emitPrometheusAlerts"]}}}`,
+			collector:        "emitPrometheusAlerts",
+			enabledByDefault: true,
+			want:             true,
+		},
+		{
+			name:             "disabled for this cluster, matching the resource ID case-insensitively",
+			env:              `{"clusters":{"/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/resourcegroups/rg/providers/microsoft.redhatopenshift/openshiftclusters/cluster":{"disabled":["emitPrometheusAlerts"]}}}`,
+			collector:        "emitPrometheusAlerts",
+			enabledByDefault: true,
+		},
+		{
+			name:    "invalid",
+			env:     `{"disabled":"emitPrometheusAlerts"}`,
+			wantErr: "invalid ARO_MONITOR_COLLECTORS: json: cannot unmarshal string into Go struct field MonitorCollectors.disabled of type []string",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(monitorCollectorsEnvVar, tt.env)
+
+			c, err := monitorCollectors()
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+			if err != nil {
+				return
+			}
+
+			got := c.IsEnabled(resourceID, tt.collector, tt.enabledByDefault)
+			if got != tt.want {
+				t.Errorf("got %t, wanted %t", got, tt.want)
+			}
+		})
+	}
+}
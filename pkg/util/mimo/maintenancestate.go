@@ -0,0 +1,76 @@
+package mimo
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/database"
+)
+
+// ClearMaintenancePending sets the MaintenanceState of a cluster back from
+// Pending to None once none of the manifests created for it by a schedule are
+// still queued, if the Scheduler set it to Pending ahead of their scheduled
+// maintenance. A Pending state set by an SRE through an admin update is kept.
+// This is called whenever a scheduled manifest finishes, times out or is
+// cancelled. It returns whether the state was cleared.
+func ClearMaintenancePending(ctx context.Context, manifests database.MaintenanceManifests, clusters database.OpenShiftClusters, clusterResourceID string) (bool, error) {
+	i, err := manifests.GetByClusterResourceID(ctx, clusterResourceID, "")
+	if err != nil {
+		return false, fmt.Errorf("failed getting manifests: %w", err)
+	}
+
+	for {
+		docs, err := i.Next(ctx, 50)
+		if err != nil {
+			return false, fmt.Errorf("failed reading next manifest document: %w", err)
+		}
+		if docs == nil {
+			break
+		}
+
+		for _, doc := range docs.MaintenanceManifestDocuments {
+			if doc.MaintenanceManifest.CreatedBySchedule == "" {
+				continue
+			}
+			switch doc.MaintenanceManifest.State {
+			case api.MaintenanceManifestStatePending, api.MaintenanceManifestStateInProgress:
+				return false, nil
+			}
+		}
+	}
+
+	oc, err := clusters.Get(ctx, clusterResourceID)
+	if err != nil {
+		return false, fmt.Errorf("failed getting cluster document: %w", err)
+	}
+	if !scheduledPending(oc) {
+		return false, nil
+	}
+
+	cleared := false
+	_, err = clusters.Patch(ctx, clusterResourceID, func(doc *api.OpenShiftClusterDocument) error {
+		// the state may have changed since we read it
+		cleared = scheduledPending(doc)
+		if cleared {
+			doc.OpenShiftCluster.Properties.MaintenanceState = api.MaintenanceStateNone
+			doc.OpenShiftCluster.Properties.MaintenanceStateSetBySchedule = false
+		}
+		return nil
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed clearing pending maintenance state: %w", err)
+	}
+
+	return cleared, nil
+}
+
+// scheduledPending returns whether the cluster was set to Pending by the
+// Scheduler
+func scheduledPending(doc *api.OpenShiftClusterDocument) bool {
+	return doc.OpenShiftCluster.Properties.MaintenanceStateSetBySchedule &&
+		doc.OpenShiftCluster.Properties.MaintenanceState == api.MaintenanceStatePending
+}
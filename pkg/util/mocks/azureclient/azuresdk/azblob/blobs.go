@@ -11,6 +11,7 @@ package mock_azblob
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -58,6 +59,20 @@ func (mr *MockBlobsClientMockRecorder) BlobExists(ctx, container, blobPath any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlobExists", reflect.TypeOf((*MockBlobsClient)(nil).BlobExists), ctx, container, blobPath)
 }
 
+// CreateContainer mocks base method.
+func (m *MockBlobsClient) CreateContainer(ctx context.Context, container string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContainer", ctx, container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateContainer indicates an expected call of CreateContainer.
+func (mr *MockBlobsClientMockRecorder) CreateContainer(ctx, container any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContainer", reflect.TypeOf((*MockBlobsClient)(nil).CreateContainer), ctx, container)
+}
+
 // DeleteBlob mocks base method.
 func (m *MockBlobsClient) DeleteBlob(ctx context.Context, containerName, blobName string, o *azblob.DeleteBlobOptions) (azblob.DeleteBlobResponse, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +117,21 @@ func (mr *MockBlobsClientMockRecorder) DownloadStream(ctx, containerName, blobNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadStream", reflect.TypeOf((*MockBlobsClient)(nil).DownloadStream), ctx, containerName, blobName, o)
 }
 
+// ListBlobNames mocks base method.
+func (m *MockBlobsClient) ListBlobNames(ctx context.Context, container, prefix string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBlobNames", ctx, container, prefix)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBlobNames indicates an expected call of ListBlobNames.
+func (mr *MockBlobsClientMockRecorder) ListBlobNames(ctx, container, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBlobNames", reflect.TypeOf((*MockBlobsClient)(nil).ListBlobNames), ctx, container, prefix)
+}
+
 // ServiceClient mocks base method.
 func (m *MockBlobsClient) ServiceClient() *service.Client {
 	m.ctrl.T.Helper()
@@ -130,3 +160,18 @@ func (mr *MockBlobsClientMockRecorder) UploadBuffer(ctx, containerName, blobName
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadBuffer", reflect.TypeOf((*MockBlobsClient)(nil).UploadBuffer), ctx, containerName, blobName, buffer, o)
 }
+
+// UploadStream mocks base method.
+func (m *MockBlobsClient) UploadStream(ctx context.Context, containerName, blobName string, body io.Reader, o *azblob.UploadStreamOptions) (azblob.UploadStreamResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadStream", ctx, containerName, blobName, body, o)
+	ret0, _ := ret[0].(azblob.UploadStreamResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadStream indicates an expected call of UploadStream.
+func (mr *MockBlobsClientMockRecorder) UploadStream(ctx, containerName, blobName, body, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadStream", reflect.TypeOf((*MockBlobsClient)(nil).UploadStream), ctx, containerName, blobName, body, o)
+}
@@ -39,6 +39,18 @@ func (m *MockEmitter) EXPECT() *MockEmitterMockRecorder {
 	return m.recorder
 }
 
+// EmitCounter mocks base method.
+func (m *MockEmitter) EmitCounter(metricName string, metricValue int64, dimensions map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EmitCounter", metricName, metricValue, dimensions)
+}
+
+// EmitCounter indicates an expected call of EmitCounter.
+func (mr *MockEmitterMockRecorder) EmitCounter(metricName, metricValue, dimensions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmitCounter", reflect.TypeOf((*MockEmitter)(nil).EmitCounter), metricName, metricValue, dimensions)
+}
+
 // EmitFloat mocks base method.
 func (m *MockEmitter) EmitFloat(metricName string, metricValue float64, dimensions map[string]string) {
 	m.ctrl.T.Helper()
@@ -62,3 +74,15 @@ func (mr *MockEmitterMockRecorder) EmitGauge(metricName, metricValue, dimensions
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmitGauge", reflect.TypeOf((*MockEmitter)(nil).EmitGauge), metricName, metricValue, dimensions)
 }
+
+// EmitHistogram mocks base method.
+func (m *MockEmitter) EmitHistogram(metricName string, metricValue float64, buckets []float64, dimensions map[string]string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EmitHistogram", metricName, metricValue, buckets, dimensions)
+}
+
+// EmitHistogram indicates an expected call of EmitHistogram.
+func (mr *MockEmitterMockRecorder) EmitHistogram(metricName, metricValue, buckets, dimensions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EmitHistogram", reflect.TypeOf((*MockEmitter)(nil).EmitHistogram), metricName, metricValue, buckets, dimensions)
+}
@@ -0,0 +1,90 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Checkpoint persists the metrics name of the last successfully completed
+// step of a run, so that an interrupted run can be resumed where it left off.
+type Checkpoint interface {
+	// LastCompletedStep returns the metrics name of the last step recorded by
+	// SetLastCompletedStep, or "" if there is nothing to resume from.
+	LastCompletedStep() string
+	SetLastCompletedStep(ctx context.Context, name string) error
+}
+
+// AlwaysRun returns a wrapper Step which is never skipped when a run is
+// resumed from a Checkpoint. Use it for steps which initialise in-memory state
+// needed by later steps, or which gate later steps on the cluster being ready.
+func AlwaysRun(s Step) Step {
+	return alwaysRunStep{Step: s}
+}
+
+type alwaysRunStep struct {
+	Step
+}
+
+func isAlwaysRun(s Step) bool {
+	_, ok := s.(alwaysRunStep)
+	return ok
+}
+
+// RunWithCheckpoint behaves like Run, but additionally records each completed
+// step in `checkpoint`. If `checkpoint` already records a completed step, the
+// steps up to and including the first step with that metrics name are skipped,
+// other than those wrapped with AlwaysRun. Steps must be idempotent to be
+// safely resumed.
+//
+// Where a step appears more than once in `steps`, the run resumes after its
+// first occurrence, re-running some steps rather than skipping too many.
+func RunWithCheckpoint(ctx context.Context, log *logrus.Entry, pollInterval time.Duration, steps []Step, now func() time.Time, managedRGName string, checkpoint Checkpoint) (map[string]int64, error) {
+	resumeFrom := 0
+	if last := checkpoint.LastCompletedStep(); last != "" {
+		for i, step := range steps {
+			if step.metricsName() == last {
+				resumeFrom = i + 1
+				log.Infof("resuming after step %s", step)
+				break
+			}
+		}
+	}
+
+	stepTimeRun := make(map[string]int64)
+	for i, step := range steps {
+		if i < resumeFrom && !isAlwaysRun(step) {
+			log.Infof("skipping completed step %s", step)
+			continue
+		}
+
+		log.Infof("running step %s", step)
+
+		startTime := time.Now()
+		err := runStep(ctx, log, step)
+		if err != nil {
+			err = convertStepError(log, err, managedRGName)
+			log.Errorf("step %s encountered error: %s", step, err.Error())
+			return nil, err
+		}
+
+		if now != nil {
+			currentTime := now()
+			stepTimeRun[step.metricsName()] = int64(currentTime.Sub(startTime).Seconds())
+		}
+
+		// Don't move the checkpoint backwards when re-running AlwaysRun steps.
+		if i >= resumeFrom {
+			err = checkpoint.SetLastCompletedStep(ctx, step.metricsName())
+			if err != nil {
+				log.Errorf("failed to record checkpoint after step %s: %s", step, err.Error())
+				return nil, err
+			}
+		}
+	}
+	return stepTimeRun, nil
+}
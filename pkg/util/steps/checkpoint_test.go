@@ -0,0 +1,124 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+type fakeCheckpoint struct {
+	last    string
+	history []string
+	err     error
+}
+
+func (c *fakeCheckpoint) LastCompletedStep() string {
+	return c.last
+}
+
+func (c *fakeCheckpoint) SetLastCompletedStep(ctx context.Context, name string) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.last = name
+	c.history = append(c.history, name)
+	return nil
+}
+
+type checkpointSteps struct {
+	ran []string
+}
+
+func (s *checkpointSteps) first(context.Context) error {
+	s.ran = append(s.ran, "first")
+	return nil
+}
+
+func (s *checkpointSteps) second(context.Context) error {
+	s.ran = append(s.ran, "second")
+	return nil
+}
+
+func (s *checkpointSteps) third(context.Context) error {
+	s.ran = append(s.ran, "third")
+	return nil
+}
+
+func (s *checkpointSteps) initialize(context.Context) error {
+	s.ran = append(s.ran, "initialize")
+	return nil
+}
+
+func TestRunWithCheckpoint(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		checkpoint  *fakeCheckpoint
+		wantRan     []string
+		wantHistory []string
+		wantErr     string
+	}{
+		{
+			name:        "no checkpoint runs all steps",
+			checkpoint:  &fakeCheckpoint{},
+			wantRan:     []string{"initialize", "first", "second", "third"},
+			wantHistory: []string{"action.initialize", "action.first", "action.second", "action.third"},
+		},
+		{
+			name:        "checkpoint skips completed steps but not AlwaysRun steps",
+			checkpoint:  &fakeCheckpoint{last: "action.second"},
+			wantRan:     []string{"initialize", "third"},
+			wantHistory: []string{"action.third"},
+		},
+		{
+			name:        "unknown checkpoint runs all steps",
+			checkpoint:  &fakeCheckpoint{last: "action.removed"},
+			wantRan:     []string{"initialize", "first", "second", "third"},
+			wantHistory: []string{"action.initialize", "action.first", "action.second", "action.third"},
+		},
+		{
+			name:       "failure to record checkpoint fails the run",
+			checkpoint: &fakeCheckpoint{err: errors.New("lost lease")},
+			wantRan:    []string{"initialize"},
+			wantErr:    "lost lease",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, log := testlog.New()
+			s := &checkpointSteps{}
+
+			_, err := RunWithCheckpoint(context.Background(), log, time.Millisecond, []Step{
+				AlwaysRun(Action(s.initialize)),
+				Action(s.first),
+				Action(s.second),
+				Action(s.third),
+			}, currentTimeFunc, "", tt.checkpoint)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if !slices.Equal(s.ran, tt.wantRan) {
+				t.Errorf("wanted steps %v to run, got %v", tt.wantRan, s.ran)
+			}
+			if !slices.Equal(tt.checkpoint.history, tt.wantHistory) {
+				t.Errorf("wanted checkpoints %v, got %v", tt.wantHistory, tt.checkpoint.history)
+			}
+		})
+	}
+}
+
+func TestAlwaysRunPreservesNames(t *testing.T) {
+	step := AlwaysRun(Action(successfulFunc))
+
+	if got := step.String(); got != "[Action pkg/util/steps.successfulFunc]" {
+		t.Errorf("unexpected String(): %s", got)
+	}
+	if got := step.metricsName(); got != "action.successfulFunc" {
+		t.Errorf("unexpected metricsName(): %s", got)
+	}
+}
@@ -0,0 +1,81 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"encoding/json"
+)
+
+// StepDescription is a structured description of a Step, suitable for
+// reporting which steps would be run without running them.
+type StepDescription struct {
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	MetricsName string `json:"metricsName"`
+	Timeout     string `json:"timeout,omitempty"`
+	FailOnError *bool  `json:"failOnError,omitempty"`
+	AlwaysRun   bool   `json:"alwaysRun,omitempty"`
+}
+
+// Describe returns a StepDescription for each of the given steps, in order.
+func Describe(steps []Step) []StepDescription {
+	descriptions := make([]StepDescription, 0, len(steps))
+	for _, s := range steps {
+		descriptions = append(descriptions, describe(s))
+	}
+	return descriptions
+}
+
+// MarshalSteps renders the given steps as a JSON array of StepDescriptions.
+func MarshalSteps(steps []Step) ([]byte, error) {
+	return json.MarshalIndent(Describe(steps), "", "    ")
+}
+
+func describe(s Step) StepDescription {
+	switch s := s.(type) {
+	case alwaysRunStep:
+		d := describe(s.Step)
+		d.AlwaysRun = true
+		return d
+	case actionStep:
+		return StepDescription{
+			Type:        "Action",
+			Name:        FriendlyName(s.f),
+			MetricsName: s.metricsName(),
+		}
+	case conditionStep:
+		return describeCondition(&s)
+	case *conditionStep:
+		return describeCondition(s)
+	case *authorizationRefreshingActionStep:
+		return StepDescription{
+			Type:        "AuthorizationRetryingAction",
+			Name:        FriendlyName(s.f),
+			MetricsName: s.metricsName(),
+		}
+	case retryingActionStep:
+		return StepDescription{
+			Type:        "RetryingAction",
+			Name:        FriendlyName(s.f),
+			MetricsName: s.metricsName(),
+		}
+	default:
+		return StepDescription{
+			Type:        "Unknown",
+			Name:        s.String(),
+			MetricsName: s.metricsName(),
+		}
+	}
+}
+
+func describeCondition(s *conditionStep) StepDescription {
+	fail := s.fail
+	return StepDescription{
+		Type:        "Condition",
+		Name:        FriendlyName(s.f),
+		MetricsName: s.metricsName(),
+		Timeout:     s.timeout.String(),
+		FailOnError: &fail,
+	}
+}
@@ -0,0 +1,60 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-test/deep"
+
+	"github.com/Azure/ARO-RP/pkg/util/pointerutils"
+)
+
+func TestDescribe(t *testing.T) {
+	got := Describe([]Step{
+		Action(successfulFunc),
+		AlwaysRun(Condition(alwaysTrueCondition, 30*time.Minute, true)),
+		Condition(alwaysFalseCondition, time.Minute, false),
+		AuthorizationRetryingAction(nil, successfulFunc, ""),
+		RetryingAction(DefaultRetryPolicy, nil, successfulFunc),
+	})
+
+	want := []StepDescription{
+		{
+			Type:        "Action",
+			Name:        "pkg/util/steps.successfulFunc",
+			MetricsName: "action.successfulFunc",
+		},
+		{
+			Type:        "Condition",
+			Name:        "pkg/util/steps.alwaysTrueCondition",
+			MetricsName: "condition.alwaysTrueCondition",
+			Timeout:     "30m0s",
+			FailOnError: pointerutils.ToPtr(true),
+			AlwaysRun:   true,
+		},
+		{
+			Type:        "Condition",
+			Name:        "pkg/util/steps.alwaysFalseCondition",
+			MetricsName: "condition.alwaysFalseCondition",
+			Timeout:     "1m0s",
+			FailOnError: pointerutils.ToPtr(false),
+		},
+		{
+			Type:        "AuthorizationRetryingAction",
+			Name:        "pkg/util/steps.successfulFunc",
+			MetricsName: "authorizationretryingaction.successfulFunc",
+		},
+		{
+			Type:        "RetryingAction",
+			Name:        "pkg/util/steps.successfulFunc",
+			MetricsName: "action.successfulFunc",
+		},
+	}
+
+	for _, diff := range deep.Equal(got, want) {
+		t.Error(diff)
+	}
+}
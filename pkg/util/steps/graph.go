@@ -0,0 +1,213 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Graph is a set of Steps with dependencies between them. Steps whose
+// dependencies have all completed may be executed concurrently by RunGraph.
+//
+// Graphs are built up with Add and are not safe for concurrent modification.
+type Graph struct {
+	nodes []*graphNode
+	index map[string]*graphNode
+	err   error
+}
+
+type graphNode struct {
+	name      string
+	step      Step
+	dependsOn []string
+}
+
+// NewGraph returns an empty Graph.
+func NewGraph() *Graph {
+	return &Graph{
+		index: map[string]*graphNode{},
+	}
+}
+
+// Add adds `step` to the graph under the unique name `name`. The step will
+// only be executed once all of the steps named in `dependsOn` have completed
+// successfully. Dependencies may be added in any order, but must exist by the
+// time the graph is run.
+func (g *Graph) Add(name string, step Step, dependsOn ...string) *Graph {
+	if g.err != nil {
+		return g
+	}
+
+	if _, found := g.index[name]; found {
+		g.err = fmt.Errorf("duplicate step name %q", name)
+		return g
+	}
+
+	n := &graphNode{
+		name:      name,
+		step:      step,
+		dependsOn: dependsOn,
+	}
+	g.nodes = append(g.nodes, n)
+	g.index[name] = n
+
+	return g
+}
+
+// validate checks that all dependencies exist and that the graph contains no
+// cycles.
+func (g *Graph) validate() error {
+	if g.err != nil {
+		return g.err
+	}
+
+	for _, n := range g.nodes {
+		for _, dep := range n.dependsOn {
+			if _, found := g.index[dep]; !found {
+				return fmt.Errorf("step %q depends on unknown step %q", n.name, dep)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(g.nodes))
+
+	var visit func(n *graphNode) error
+	visit = func(n *graphNode) error {
+		switch state[n.name] {
+		case visiting:
+			return fmt.Errorf("dependency cycle detected at step %q", n.name)
+		case visited:
+			return nil
+		}
+
+		state[n.name] = visiting
+		for _, dep := range n.dependsOn {
+			err := visit(g.index[dep])
+			if err != nil {
+				return err
+			}
+		}
+		state[n.name] = visited
+
+		return nil
+	}
+
+	for _, n := range g.nodes {
+		err := visit(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type graphResult struct {
+	node     *graphNode
+	duration time.Duration
+	err      error
+}
+
+// RunGraph executes the steps in `g`, running at most `maxWorkers` steps at
+// once. Steps become eligible to run once all of their dependencies have
+// completed, and are started in the order they were added to the graph. The
+// first step to fail cancels the context passed to any steps still running,
+// no further steps are started, and its error is returned once the running
+// steps have returned. As with Run, the time cost of each step is recorded
+// for metrics usage.
+func RunGraph(ctx context.Context, log *logrus.Entry, g *Graph, maxWorkers int, now func() time.Time, managedRGName string) (map[string]int64, error) {
+	err := g.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if maxWorkers < 1 {
+		maxWorkers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	remaining := make(map[string]int, len(g.nodes))
+	dependents := make(map[string][]*graphNode, len(g.nodes))
+	ready := []*graphNode{}
+	for _, n := range g.nodes {
+		remaining[n.name] = len(n.dependsOn)
+		for _, dep := range n.dependsOn {
+			dependents[dep] = append(dependents[dep], n)
+		}
+		if len(n.dependsOn) == 0 {
+			ready = append(ready, n)
+		}
+	}
+
+	results := make(chan graphResult)
+	stepTimeRun := make(map[string]int64)
+	running := 0
+	var firstErr error
+
+	for {
+		for firstErr == nil && len(ready) > 0 && running < maxWorkers {
+			n := ready[0]
+			ready = ready[1:]
+			running++
+
+			log.Infof("running step %s", n.step)
+			go func(n *graphNode) {
+				startTime := time.Now()
+				err := runStep(ctx, log, n.step)
+
+				var duration time.Duration
+				if now != nil {
+					duration = now().Sub(startTime)
+				}
+				results <- graphResult{node: n, duration: duration, err: err}
+			}(n)
+		}
+
+		if running == 0 {
+			break
+		}
+
+		r := <-results
+		running--
+
+		if r.err != nil {
+			// Siblings cancelled because of an earlier failure are expected to
+			// error; only the first failure is reported.
+			if firstErr == nil {
+				firstErr = convertStepError(log, r.err, managedRGName)
+				log.Errorf("step %s encountered error: %s", r.node.step, firstErr.Error())
+				cancel()
+			}
+			continue
+		}
+
+		if now != nil {
+			stepTimeRun[r.node.step.metricsName()] = int64(r.duration.Seconds())
+		}
+
+		for _, d := range dependents[r.node.name] {
+			remaining[d.name]--
+			if remaining[d.name] == 0 {
+				ready = append(ready, d)
+			}
+		}
+	}
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return stepTimeRun, nil
+}
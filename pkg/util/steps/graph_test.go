@@ -0,0 +1,180 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+type recorder struct {
+	mu    sync.Mutex
+	order []string
+}
+
+func (r *recorder) action(name string) Step {
+	return Action(func(context.Context) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.order = append(r.order, name)
+		return nil
+	})
+}
+
+func TestRunGraph(t *testing.T) {
+	for _, tt := range []struct {
+		name       string
+		graph      func(r *recorder) *Graph
+		maxWorkers int
+		wantOrder  []string
+		wantErr    string
+	}{
+		{
+			name: "dependencies run before their dependents",
+			graph: func(r *recorder) *Graph {
+				return NewGraph().
+					Add("c", r.action("c"), "b").
+					Add("b", r.action("b"), "a").
+					Add("a", r.action("a"))
+			},
+			maxWorkers: 4,
+			wantOrder:  []string{"a", "b", "c"},
+		},
+		{
+			name: "steps are started in insertion order with a single worker",
+			graph: func(r *recorder) *Graph {
+				return NewGraph().
+					Add("a", r.action("a")).
+					Add("b", r.action("b")).
+					Add("c", r.action("c"), "a").
+					Add("d", r.action("d"))
+			},
+			maxWorkers: 1,
+			wantOrder:  []string{"a", "b", "d", "c"},
+		},
+		{
+			name: "unknown dependency is rejected",
+			graph: func(r *recorder) *Graph {
+				return NewGraph().
+					Add("a", r.action("a"), "missing")
+			},
+			wantErr: `step "a" depends on unknown step "missing"`,
+		},
+		{
+			name: "duplicate name is rejected",
+			graph: func(r *recorder) *Graph {
+				return NewGraph().
+					Add("a", r.action("a")).
+					Add("a", r.action("a"))
+			},
+			wantErr: `duplicate step name "a"`,
+		},
+		{
+			name: "cycle is rejected",
+			graph: func(r *recorder) *Graph {
+				return NewGraph().
+					Add("a", r.action("a"), "c").
+					Add("b", r.action("b"), "a").
+					Add("c", r.action("c"), "b")
+			},
+			wantErr: `dependency cycle detected at step "a"`,
+		},
+		{
+			name: "failure stops dependents from running",
+			graph: func(r *recorder) *Graph {
+				return NewGraph().
+					Add("a", r.action("a")).
+					Add("fail", Action(failingFunc), "a").
+					Add("b", r.action("b"), "fail")
+			},
+			maxWorkers: 2,
+			wantOrder:  []string{"a"},
+			wantErr:    "oh no!",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			_, log := testlog.New()
+			r := &recorder{}
+
+			_, err := RunGraph(context.Background(), log, tt.graph(r), tt.maxWorkers, currentTimeFunc, "")
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if !slices.Equal(r.order, tt.wantOrder) {
+				t.Errorf("wanted order %v, got %v", tt.wantOrder, r.order)
+			}
+		})
+	}
+}
+
+func TestRunGraphConcurrency(t *testing.T) {
+	_, log := testlog.New()
+
+	var current, peak atomic.Int32
+	blocking := func(context.Context) error {
+		n := current.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		current.Add(-1)
+		return nil
+	}
+
+	g := NewGraph()
+	for _, name := range []string{"a", "b", "c", "d", "e"} {
+		g.Add(name, Action(blocking))
+	}
+
+	stepTimeRun, err := RunGraph(context.Background(), log, g, 2, currentTimeFunc, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := peak.Load(); got != 2 {
+		t.Errorf("wanted at most 2 concurrent steps, got %d", got)
+	}
+
+	if _, found := stepTimeRun["action.func1"]; !found {
+		t.Errorf("wanted step timings to be recorded, got %v", stepTimeRun)
+	}
+}
+
+func TestRunGraphCancelsSiblings(t *testing.T) {
+	_, log := testlog.New()
+
+	cancelled := make(chan struct{})
+	waitForCancel := func(ctx context.Context) error {
+		<-ctx.Done()
+		close(cancelled)
+		return ctx.Err()
+	}
+	failAfterStart := func(context.Context) error {
+		time.Sleep(10 * time.Millisecond)
+		return errors.New("oh no!")
+	}
+
+	g := NewGraph().
+		Add("wait", Action(waitForCancel)).
+		Add("fail", Action(failAfterStart))
+
+	_, err := RunGraph(context.Background(), log, g, 2, nil, "")
+	utilerror.AssertErrorMessage(t, err, "oh no!")
+
+	select {
+	case <-cancelled:
+	default:
+		t.Error("expected sibling step to be cancelled")
+	}
+}
@@ -0,0 +1,128 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/Azure/ARO-RP/pkg/metrics"
+	"github.com/Azure/ARO-RP/pkg/util/azureerrors"
+)
+
+// RetryPolicy configures how a RetryingAction retries its action.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times the action is run, including
+	// the first attempt.
+	MaxAttempts int
+
+	// Backoff controls the delay between attempts. Backoff.Steps is ignored
+	// in favour of MaxAttempts.
+	Backoff wait.Backoff
+
+	// Retryable returns true if an error returned by the action should be
+	// retried. If nil, azureerrors.IsRetryableError is used.
+	Retryable func(error) bool
+}
+
+// DefaultRetryPolicy retries transient ARM errors (throttling, retryable
+// conflicts) up to five times, backing off exponentially from 10 seconds.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	Backoff: wait.Backoff{
+		Duration: 10 * time.Second,
+		Factor:   2,
+		Jitter:   0.1,
+		Cap:      2 * time.Minute,
+	},
+	Retryable: azureerrors.IsRetryableError,
+}
+
+// RetryingAction returns a wrapper Step which will rerun `action` with
+// backoff while it returns errors classified as retryable by `policy`, up to
+// policy.MaxAttempts attempts. The last error is returned directly. If `m` is
+// not nil, a metric is emitted for each attempt.
+func RetryingAction(policy RetryPolicy, m metrics.Emitter, action actionFunction) Step {
+	return retryingActionStep{
+		f:      action,
+		policy: policy,
+		m:      m,
+	}
+}
+
+type retryingActionStep struct {
+	f      actionFunction
+	policy RetryPolicy
+	m      metrics.Emitter
+}
+
+func (s retryingActionStep) run(ctx context.Context, log *logrus.Entry) error {
+	retryable := s.policy.Retryable
+	if retryable == nil {
+		retryable = azureerrors.IsRetryableError
+	}
+
+	maxAttempts := s.policy.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	backoff := s.policy.Backoff
+	backoff.Steps = maxAttempts
+
+	for attempt := 1; ; attempt++ {
+		err := s.f(ctx)
+		retry := err != nil && attempt < maxAttempts && retryable(err)
+		s.emitAttempt(attempt, err, retry)
+
+		if !retry {
+			return err
+		}
+
+		delay := backoff.Step()
+		log.Warnf("step %s failed with retryable error on attempt %d of %d, retrying in %s: %s", s, attempt, maxAttempts, delay, err)
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+	}
+}
+
+func (s retryingActionStep) emitAttempt(attempt int, err error, retry bool) {
+	if s.m == nil {
+		return
+	}
+
+	result := "success"
+	switch {
+	case retry:
+		result = "retrying"
+	case err != nil:
+		result = "failed"
+	}
+
+	s.m.EmitGauge("steps.retryingaction.attempt", 1, map[string]string{
+		"step":    shortName(FriendlyName(s.f)),
+		"attempt": strconv.Itoa(attempt),
+		"result":  result,
+	})
+}
+
+func (s retryingActionStep) String() string {
+	return fmt.Sprintf("[RetryingAction %s]", FriendlyName(s.f))
+}
+
+// metricsName matches that of an Action, so that wrapping an existing Action
+// with RetryingAction does not change the name of its duration metric.
+func (s retryingActionStep) metricsName() string {
+	return fmt.Sprintf("action.%s", shortName(FriendlyName(s.f)))
+}
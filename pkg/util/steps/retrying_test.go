@@ -0,0 +1,141 @@
+package steps
+
+// Copyright (c) Microsoft Corporation.
+// Licensed under the Apache License 2.0.
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.uber.org/mock/gomock"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/Azure/ARO-RP/pkg/api"
+	mock_metrics "github.com/Azure/ARO-RP/pkg/util/mocks/metrics"
+	utilerror "github.com/Azure/ARO-RP/test/util/error"
+	testlog "github.com/Azure/ARO-RP/test/util/log"
+)
+
+var errThrottled = api.NewCloudError(http.StatusTooManyRequests, "ThrottlingLimitExceeded", "", "throttled")
+
+type flakyAction struct {
+	errs     []error
+	attempts int
+}
+
+func (a *flakyAction) run(context.Context) error {
+	a.attempts++
+	if len(a.errs) == 0 {
+		return nil
+	}
+	err := a.errs[0]
+	a.errs = a.errs[1:]
+	return err
+}
+
+func TestRetryingAction(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		Backoff: wait.Backoff{
+			Duration: time.Millisecond,
+			Factor:   2,
+		},
+	}
+
+	for _, tt := range []struct {
+		name         string
+		errs         []error
+		retryable    func(error) bool
+		wantAttempts int
+		wantResults  []string
+		wantErr      string
+	}{
+		{
+			name:         "success on first attempt",
+			wantAttempts: 1,
+			wantResults:  []string{"success"},
+		},
+		{
+			name:         "retryable errors are retried until success",
+			errs:         []error{errThrottled, errThrottled},
+			wantAttempts: 3,
+			wantResults:  []string{"retrying", "retrying", "success"},
+		},
+		{
+			name:         "retryable errors are retried until max attempts",
+			errs:         []error{errThrottled, errThrottled, errThrottled, errThrottled},
+			wantAttempts: 3,
+			wantResults:  []string{"retrying", "retrying", "failed"},
+			wantErr:      "429: ThrottlingLimitExceeded: : throttled",
+		},
+		{
+			name:         "non-retryable errors are returned directly",
+			errs:         []error{errors.New("oh no!")},
+			wantAttempts: 1,
+			wantResults:  []string{"failed"},
+			wantErr:      "oh no!",
+		},
+		{
+			name:         "custom classifier is used",
+			errs:         []error{errors.New("try again"), errThrottled},
+			retryable:    func(err error) bool { return err.Error() == "try again" },
+			wantAttempts: 2,
+			wantResults:  []string{"retrying", "failed"},
+			wantErr:      "429: ThrottlingLimitExceeded: : throttled",
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			controller := gomock.NewController(t)
+			m := mock_metrics.NewMockEmitter(controller)
+			_, log := testlog.New()
+
+			var results []string
+			m.EXPECT().EmitGauge("steps.retryingaction.attempt", int64(1), gomock.Any()).
+				Do(func(_ string, _ int64, dims map[string]string) {
+					results = append(results, dims["result"])
+				}).Times(len(tt.wantResults))
+
+			p := policy
+			p.Retryable = tt.retryable
+			a := &flakyAction{errs: tt.errs}
+
+			err := RetryingAction(p, m, a.run).run(context.Background(), log)
+			utilerror.AssertErrorMessage(t, err, tt.wantErr)
+
+			if a.attempts != tt.wantAttempts {
+				t.Errorf("wanted %d attempts, got %d", tt.wantAttempts, a.attempts)
+			}
+			for i := range tt.wantResults {
+				if i >= len(results) || results[i] != tt.wantResults[i] {
+					t.Errorf("wanted results %v, got %v", tt.wantResults, results)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestRetryingActionStopsOnContextCancellation(t *testing.T) {
+	_, log := testlog.New()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	a := &flakyAction{errs: []error{errThrottled, errThrottled}}
+	step := RetryingAction(RetryPolicy{
+		MaxAttempts: 3,
+		Backoff:     wait.Backoff{Duration: time.Hour},
+	}, nil, func(ctx context.Context) error {
+		defer cancel()
+		return a.run(ctx)
+	})
+
+	err := step.run(ctx, log)
+	utilerror.AssertErrorMessage(t, err, "429: ThrottlingLimitExceeded: : throttled")
+
+	if a.attempts != 1 {
+		t.Errorf("wanted 1 attempt, got %d", a.attempts)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/Azure/ARO-RP/pkg/api"
+	"github.com/Azure/ARO-RP/pkg/otel/tracing"
 	"github.com/Azure/ARO-RP/pkg/util/azureerrors"
 	msgraph_errors "github.com/Azure/ARO-RP/pkg/util/graph/graphsdk/models/odataerrors"
 )
@@ -65,36 +66,9 @@ func Run(ctx context.Context, log *logrus.Entry, pollInterval time.Duration, ste
 		log.Infof("running step %s", step)
 
 		startTime := time.Now()
-		err := step.run(ctx, log)
+		err := runStep(ctx, log, step)
 		if err != nil {
-			if managedRGName != "" && azureerrors.IsManagedResourceGroupError(err, managedRGName) {
-				err = api.NewCloudError(
-					http.StatusInternalServerError,
-					api.CloudErrorCodeInternalServerError,
-					"encountered error",
-					err.Error())
-			} else if azureerrors.IsUnauthorizedClientError(err) ||
-				azureerrors.IsInvalidSecretError(err) ||
-				azureerrors.HasAuthorizationFailedError(err) {
-				err = api.NewCloudError(
-					http.StatusBadRequest,
-					api.CloudErrorCodeInvalidServicePrincipalCredentials,
-					"encountered error",
-					err.Error())
-			} else if oDataError := (&msgraph_errors.ODataError{}); errors.As(err, &oDataError) {
-				if *oDataError.GetErrorEscaped().GetCode() == "Authorization_IdentityNotFound" {
-					err = api.NewCloudError(
-						http.StatusBadRequest,
-						api.CloudErrorCodeInvalidServicePrincipalCredentials,
-						"encountered error",
-						fmt.Sprintf(
-							"%s: %s",
-							*oDataError.GetErrorEscaped().GetCode(),
-							*oDataError.GetErrorEscaped().GetMessage()))
-				} else {
-					spew.Fdump(log.Writer(), oDataError.GetErrorEscaped())
-				}
-			}
+			err = convertStepError(log, err, managedRGName)
 			log.Errorf("step %s encountered error: %s", step, err.Error())
 			return nil, err
 		}
@@ -106,3 +80,47 @@ func Run(ctx context.Context, log *logrus.Entry, pollInterval time.Duration, ste
 	}
 	return stepTimeRun, nil
 }
+
+// runStep runs a single step within its own trace span. The step is given the
+// caller's context unchanged, as some callers (e.g. MIMO) pass a context
+// implementation which steps type assert on.
+func runStep(ctx context.Context, log *logrus.Entry, step Step) error {
+	_, span := tracing.Start(ctx, step.metricsName(), tracing.AttributeStep.String(step.String()))
+	err := step.run(ctx, log)
+	tracing.End(span, err)
+	return err
+}
+
+// convertStepError converts well-known Azure and Microsoft Graph errors
+// returned by a step into CloudErrors suitable for returning to the user.
+func convertStepError(log *logrus.Entry, err error, managedRGName string) error {
+	if managedRGName != "" && azureerrors.IsManagedResourceGroupError(err, managedRGName) {
+		err = api.NewCloudError(
+			http.StatusInternalServerError,
+			api.CloudErrorCodeInternalServerError,
+			"encountered error",
+			err.Error())
+	} else if azureerrors.IsUnauthorizedClientError(err) ||
+		azureerrors.IsInvalidSecretError(err) ||
+		azureerrors.HasAuthorizationFailedError(err) {
+		err = api.NewCloudError(
+			http.StatusBadRequest,
+			api.CloudErrorCodeInvalidServicePrincipalCredentials,
+			"encountered error",
+			err.Error())
+	} else if oDataError := (&msgraph_errors.ODataError{}); errors.As(err, &oDataError) {
+		if *oDataError.GetErrorEscaped().GetCode() == "Authorization_IdentityNotFound" {
+			err = api.NewCloudError(
+				http.StatusBadRequest,
+				api.CloudErrorCodeInvalidServicePrincipalCredentials,
+				"encountered error",
+				fmt.Sprintf(
+					"%s: %s",
+					*oDataError.GetErrorEscaped().GetCode(),
+					*oDataError.GetErrorEscaped().GetMessage()))
+		} else {
+			spew.Fdump(log.Writer(), oDataError.GetErrorEscaped())
+		}
+	}
+	return err
+}
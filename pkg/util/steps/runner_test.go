@@ -12,6 +12,11 @@ import (
 
 	"github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 	"go.uber.org/mock/gomock"
 
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -343,3 +348,42 @@ func TestStepMetricsNameFormatting(t *testing.T) {
 		})
 	}
 }
+
+func TestRunTracesSteps(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	defer otel.SetTracerProvider(noop.NewTracerProvider())
+
+	_, log := testlog.New()
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "operation")
+
+	_, err := Run(ctx, log, time.Millisecond, []Step{
+		Action(successfulFunc),
+		Action(failingFunc),
+	}, nil, "")
+	utilerror.AssertErrorMessage(t, err, "oh no!")
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("wanted 3 spans, got %d", len(spans))
+	}
+
+	for i, want := range []struct {
+		name   string
+		status codes.Code
+	}{
+		{name: "action.successfulFunc", status: codes.Unset},
+		{name: "action.failingFunc", status: codes.Error},
+	} {
+		if spans[i].Name != want.name {
+			t.Errorf("span %d: wanted name %s, got %s", i, want.name, spans[i].Name)
+		}
+		if spans[i].Status.Code != want.status {
+			t.Errorf("span %d: wanted status %s, got %s", i, want.status, spans[i].Status.Code)
+		}
+		if spans[i].Parent.SpanID() != parent.SpanContext().SpanID() {
+			t.Errorf("span %d: not nested under the operation span", i)
+		}
+	}
+}
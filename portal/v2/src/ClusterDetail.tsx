@@ -74,6 +74,7 @@ export const kcmStatisticsKey = "kcmstatistics"
 export const dnsStatisticsKey = "dnsstatistics"
 export const ingressStatisticsKey = "ingressstatistics"
 export const clusterOperatorsKey = "clusteroperators"
+export const healthSnapshotKey = "healthsnapshot"
 
 const errorBarStyles: Partial<IMessageBarStyles> = { root: { marginBottom: 15 } }
 
@@ -182,6 +183,12 @@ export function ClusterDetailPanel(props: {
           url: `${resourceID}/${clusterOperatorsKey}`,
           icon: "Shapes",
         },
+        {
+          name: "HealthSnapshot",
+          key: healthSnapshotKey,
+          url: `${resourceID}/${healthSnapshotKey}`,
+          icon: "Health",
+        },
       ],
     },
   ]
@@ -7,12 +7,14 @@ import { MachinesWrapper } from "./ClusterDetailListComponents/MachinesWrapper"
 import { MachineSetsWrapper } from "./ClusterDetailListComponents/MachineSetsWrapper"
 import { Statistics } from "./ClusterDetailListComponents/Statistics/Statistics"
 import { ClusterOperatorsWrapper } from "./ClusterDetailListComponents/ClusterOperatorsWrapper"
+import { HealthSnapshotWrapper } from "./ClusterDetailListComponents/HealthSnapshotWrapper"
 
 import { IClusterCoordinates } from "./App"
 import {
   apiStatisticsKey,
   clusterOperatorsKey,
   dnsStatisticsKey,
+  healthSnapshotKey,
   ingressStatisticsKey,
   kcmStatisticsKey,
   machineSetsKey,
@@ -153,6 +155,16 @@ export function ClusterDetailComponent(props: ClusterDetailComponentProps) {
           />
         }
       />
+      <Route
+        path="healthsnapshot"
+        element={
+          <HealthSnapshotWrapper
+            currentCluster={props.cluster!}
+            detailPanelSelected={healthSnapshotKey}
+            loaded={props.isDataLoaded}
+          />
+        }
+      />
     </Routes>
   )
 }
@@ -0,0 +1,227 @@
+import { useState, useEffect } from "react"
+import { fetchHealthSnapshot } from "../Request"
+import {
+  IMessageBarStyles,
+  MessageBar,
+  MessageBarType,
+  Stack,
+  Text,
+  CommandBar,
+  ICommandBarItemProps,
+  SelectionMode,
+} from "@fluentui/react"
+import { IColumn } from "@fluentui/react/lib/DetailsList"
+import { ShimmeredDetailsList } from "@fluentui/react/lib/ShimmeredDetailsList"
+import { healthSnapshotKey } from "../ClusterDetail"
+import { WrapperProps } from "../ClusterDetailList"
+
+export interface IHealthSnapshotCondition {
+  name: string
+  type: string
+  status: string
+  message?: string
+}
+
+export interface IHealthSnapshotCertificate {
+  namespace: string
+  name: string
+  subject?: string
+  daysUntilExpiration: number
+}
+
+export interface IHealthSnapshotAlert {
+  name: string
+  severity?: string
+  target?: string
+  secondaryTarget?: string
+  count?: number
+}
+
+export interface IHealthSnapshotCollector {
+  name: string
+  collectedAt?: number
+  durationSeconds?: number
+  error?: string
+  conditions?: IHealthSnapshotCondition[]
+  certificates?: IHealthSnapshotCertificate[]
+  alerts?: IHealthSnapshotAlert[]
+}
+
+export interface IHealthSnapshot {
+  clusterResourceID: string
+  collectedAt?: number
+  collectors: IHealthSnapshotCollector[]
+}
+
+interface IHealthSnapshotFinding {
+  key: string
+  collector: string
+  kind: string
+  name: string
+  detail: string
+}
+
+const columns: IColumn[] = [
+  {
+    key: "healthSnapshotCollector",
+    name: "Collector",
+    fieldName: "collector",
+    minWidth: 150,
+    maxWidth: 250,
+    isResizable: true,
+  },
+  {
+    key: "healthSnapshotKind",
+    name: "Kind",
+    fieldName: "kind",
+    minWidth: 80,
+    maxWidth: 100,
+    isResizable: true,
+  },
+  {
+    key: "healthSnapshotName",
+    name: "Name",
+    fieldName: "name",
+    minWidth: 150,
+    maxWidth: 350,
+    isResizable: true,
+  },
+  {
+    key: "healthSnapshotDetail",
+    name: "Detail",
+    fieldName: "detail",
+    minWidth: 250,
+    isResizable: true,
+    isMultiline: true,
+  },
+]
+
+// createFindings flattens the per-collector results of a snapshot into one
+// row per thing the monitor found wrong with the cluster.
+const createFindings = (snapshot: IHealthSnapshot): IHealthSnapshotFinding[] => {
+  const findings: IHealthSnapshotFinding[] = []
+
+  snapshot.collectors?.forEach((collector) => {
+    const add = (kind: string, name: string, detail: string) => {
+      findings.push({
+        key: `${collector.name}/${findings.length}`,
+        collector: collector.name,
+        kind: kind,
+        name: name,
+        detail: detail,
+      })
+    }
+
+    if (collector.error) {
+      add("Error", collector.name, collector.error)
+    }
+    collector.conditions?.forEach((c) => {
+      add("Condition", c.name, `${c.type}=${c.status}` + (c.message ? `: ${c.message}` : ""))
+    })
+    collector.certificates?.forEach((c) => {
+      add(
+        "Certificate",
+        `${c.namespace}/${c.name}`,
+        `expires in ${c.daysUntilExpiration} days` + (c.subject ? ` (${c.subject})` : "")
+      )
+    })
+    collector.alerts?.forEach((a) => {
+      add(
+        "Alert",
+        a.name,
+        [a.severity, a.target, a.secondaryTarget, a.count ? `x${a.count}` : ""]
+          .filter((s) => s)
+          .join(" ")
+      )
+    })
+  })
+
+  return findings
+}
+
+export function HealthSnapshotWrapper(props: WrapperProps) {
+  const [snapshot, setSnapshot] = useState<IHealthSnapshot | null>(null)
+  const [error, setError] = useState<Response | null>(null)
+  const [fetching, setFetching] = useState("")
+
+  const errorBarStyles: Partial<IMessageBarStyles> = { root: { marginBottom: 15 } }
+
+  const errorBar = (): any => {
+    return (
+      <MessageBar
+        messageBarType={MessageBarType.error}
+        isMultiline={false}
+        onDismiss={() => setError(null)}
+        dismissButtonAriaLabel="Close"
+        styles={errorBarStyles}>
+        {error?.status === 404 ? "No health snapshot has been recorded" : error?.statusText}
+      </MessageBar>
+    )
+  }
+
+  const controlStyles = {
+    root: {
+      paddingLeft: 0,
+      float: "right",
+    },
+  }
+
+  const _items: ICommandBarItemProps[] = [
+    {
+      key: "refresh",
+      text: "Refresh",
+      iconProps: { iconName: "Refresh" },
+      onClick: () => {
+        setSnapshot(null)
+        setFetching("")
+      },
+    },
+  ]
+
+  useEffect(() => {
+    const onData = async (result: Response) => {
+      if (result.status === 200) {
+        setSnapshot(await result.json())
+      } else {
+        setError(result)
+      }
+      if (props.currentCluster) {
+        setFetching(props.currentCluster.name)
+      }
+    }
+
+    if (
+      props.detailPanelSelected.toLowerCase() == healthSnapshotKey &&
+      fetching === "" &&
+      props.loaded &&
+      props.currentCluster
+    ) {
+      setFetching("FETCHING")
+      fetchHealthSnapshot(props.currentCluster).then(onData)
+    }
+  }, [snapshot, fetching, props.loaded, props.detailPanelSelected])
+
+  return (
+    <Stack>
+      <Stack.Item grow>{error && errorBar()}</Stack.Item>
+      <Stack>
+        <CommandBar items={_items} ariaLabel="Refresh" styles={controlStyles} />
+        {snapshot?.collectedAt && (
+          <Text variant="small">
+            Collected at {new Date(snapshot.collectedAt * 1000).toISOString()}
+          </Text>
+        )}
+        <ShimmeredDetailsList
+          setKey="healthSnapshotList"
+          compact={true}
+          items={snapshot ? createFindings(snapshot) : []}
+          columns={columns}
+          selectionMode={SelectionMode.none}
+          enableShimmer={snapshot === null && error === null}
+          ariaLabelForShimmer="Content is being fetched"
+          ariaLabelForGrid="Health snapshot findings"
+        />
+      </Stack>
+    </Stack>
+  )
+}
@@ -73,6 +73,12 @@ export const fetchClusterOperators = async (cluster: IClusterCoordinates): Promi
   )
 }
 
+export const fetchHealthSnapshot = async (cluster: IClusterCoordinates): Promise<Response> => {
+  return doFetch(
+    urlJoin("/", "api", cluster.subscription, cluster.resourceGroup, cluster.name, "healthsnapshot")
+  )
+}
+
 export const fetchRegions = async (): Promise<Response> => {
   return doFetch("/api/regions")
 }
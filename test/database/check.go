@@ -29,6 +29,7 @@ type Checker struct {
 	validationResult                         []*api.ValidationResult
 	maintenanceManifestDocuments             []*api.MaintenanceManifestDocument
 	maintenanceScheduleDocuments             []*api.MaintenanceScheduleDocument
+	clusterHealthSnapshotDocuments           []*api.ClusterHealthSnapshotDocument
 }
 
 func NewChecker() *Checker {
@@ -47,6 +48,7 @@ func (f *Checker) Clear() {
 	f.validationResult = []*api.ValidationResult{}
 	f.maintenanceManifestDocuments = []*api.MaintenanceManifestDocument{}
 	f.maintenanceScheduleDocuments = []*api.MaintenanceScheduleDocument{}
+	f.clusterHealthSnapshotDocuments = []*api.ClusterHealthSnapshotDocument{}
 }
 
 func (f *Checker) AddOpenShiftClusterDocuments(docs ...*api.OpenShiftClusterDocument) {
@@ -170,6 +172,17 @@ func (f *Checker) AddMaintenanceScheduleDocuments(docs ...*api.MaintenanceSchedu
 	}
 }
 
+func (f *Checker) AddClusterHealthSnapshotDocuments(docs ...*api.ClusterHealthSnapshotDocument) {
+	for _, doc := range docs {
+		docCopy, err := deepCopy(doc)
+		if err != nil {
+			panic(err)
+		}
+
+		f.clusterHealthSnapshotDocuments = append(f.clusterHealthSnapshotDocuments, docCopy.(*api.ClusterHealthSnapshotDocument))
+	}
+}
+
 func (f *Checker) CheckOpenShiftClusters(openShiftClusters *cosmosdb.FakeOpenShiftClusterDocumentClient) (errs []error) {
 	ctx := context.Background()
 
@@ -395,3 +408,27 @@ func (f *Checker) CheckMaintenanceSchedules(client *cosmosdb.FakeMaintenanceSche
 
 	return errs
 }
+
+func (f *Checker) CheckClusterHealthSnapshots(client *cosmosdb.FakeClusterHealthSnapshotDocumentClient) (errs []error) {
+	ctx := context.Background()
+
+	all, err := client.ListAll(ctx, nil)
+	if err != nil {
+		return []error{err}
+	}
+
+	sort.Slice(all.ClusterHealthSnapshotDocuments, func(i, j int) bool {
+		return all.ClusterHealthSnapshotDocuments[i].ID < all.ClusterHealthSnapshotDocuments[j].ID
+	})
+
+	if len(f.clusterHealthSnapshotDocuments) != 0 && len(all.ClusterHealthSnapshotDocuments) == len(f.clusterHealthSnapshotDocuments) {
+		diff := deep.Equal(all.ClusterHealthSnapshotDocuments, f.clusterHealthSnapshotDocuments)
+		for _, i := range diff {
+			errs = append(errs, errors.New(i))
+		}
+	} else if len(all.ClusterHealthSnapshotDocuments) != 0 || len(f.clusterHealthSnapshotDocuments) != 0 {
+		errs = append(errs, fmt.Errorf("document length different, %d vs %d", len(all.ClusterHealthSnapshotDocuments), len(f.clusterHealthSnapshotDocuments)))
+	}
+
+	return errs
+}
@@ -24,6 +24,7 @@ type Fixture struct {
 	maintenanceManifestDocuments             []*api.MaintenanceManifestDocument
 	maintenanceScheduleDocuments             []*api.MaintenanceScheduleDocument
 	poolWorkerDocuments                      []*api.PoolWorkerDocument
+	clusterHealthSnapshotDocuments           []*api.ClusterHealthSnapshotDocument
 
 	openShiftClustersDatabase                database.OpenShiftClusters
 	billingDatabase                          database.Billing
@@ -36,6 +37,7 @@ type Fixture struct {
 	maintenanceManifestsDatabase             database.MaintenanceManifests
 	maintenanceSchedulesDatabase             database.MaintenanceSchedules
 	poolWorkerDatabase                       database.PoolWorkers
+	clusterHealthSnapshotsDatabase           database.ClusterHealthSnapshots
 
 	openShiftVersionsUUID                uuid.Generator
 	platformWorkloadIdentityRoleSetsUUID uuid.Generator
@@ -57,6 +59,7 @@ func (f *Fixture) Clear() {
 	f.maintenanceManifestDocuments = []*api.MaintenanceManifestDocument{}
 	f.maintenanceScheduleDocuments = []*api.MaintenanceScheduleDocument{}
 	f.poolWorkerDocuments = []*api.PoolWorkerDocument{}
+	f.clusterHealthSnapshotDocuments = []*api.ClusterHealthSnapshotDocument{}
 }
 
 func (f *Fixture) WithOpenShiftClusters(db database.OpenShiftClusters) *Fixture {
@@ -116,6 +119,11 @@ func (f *Fixture) WithPoolWorkers(db database.PoolWorkers) *Fixture {
 	return f
 }
 
+func (f *Fixture) WithClusterHealthSnapshots(db database.ClusterHealthSnapshots) *Fixture {
+	f.clusterHealthSnapshotsDatabase = db
+	return f
+}
+
 func (f *Fixture) AddOpenShiftClusterDocuments(docs ...*api.OpenShiftClusterDocument) {
 	for _, doc := range docs {
 		docCopy, err := deepCopy(doc)
@@ -237,6 +245,17 @@ func (f *Fixture) AddPoolWorkerDocuments(docs ...*api.PoolWorkerDocument) {
 	}
 }
 
+func (f *Fixture) AddClusterHealthSnapshotDocuments(docs ...*api.ClusterHealthSnapshotDocument) {
+	for _, doc := range docs {
+		docCopy, err := deepCopy(doc)
+		if err != nil {
+			panic(err)
+		}
+
+		f.clusterHealthSnapshotDocuments = append(f.clusterHealthSnapshotDocuments, docCopy.(*api.ClusterHealthSnapshotDocument))
+	}
+}
+
 func (f *Fixture) Create() error {
 	ctx := context.Background()
 
@@ -332,5 +351,12 @@ func (f *Fixture) Create() error {
 		}
 	}
 
+	for _, i := range f.clusterHealthSnapshotDocuments {
+		_, err := f.clusterHealthSnapshotsDatabase.Put(ctx, i)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
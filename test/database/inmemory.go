@@ -108,3 +108,9 @@ func NewFakeMaintenanceSchedules() (db database.MaintenanceSchedules, client *co
 	db = database.NewMaintenanceSchedulesWithProvidedClient(client, coll, "", uuid)
 	return db, client
 }
+
+func NewFakeClusterHealthSnapshots() (db database.ClusterHealthSnapshots, client *cosmosdb.FakeClusterHealthSnapshotDocumentClient) {
+	client = cosmosdb.NewFakeClusterHealthSnapshotDocumentClient(jsonHandle)
+	db = database.NewClusterHealthSnapshotsWithProvidedClient(client)
+	return db, client
+}
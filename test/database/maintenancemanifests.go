@@ -31,6 +31,9 @@ func injectMaintenanceManifests(c *cosmosdb.FakeMaintenanceManifestDocumentClien
 	c.SetQueryHandler(database.MaintenanceManifestGetFutureForScheduleID, func(client cosmosdb.MaintenanceManifestDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.MaintenanceManifestDocumentRawIterator {
 		return fakeMaintenanceManifestsForScheduleID(client, query, options, now)
 	})
+	c.SetQueryHandler(database.MaintenanceManifestGetForScheduleIDInWindow, func(client cosmosdb.MaintenanceManifestDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.MaintenanceManifestDocumentRawIterator {
+		return fakeMaintenanceManifestsForScheduleIDInWindow(client, query, options)
+	})
 	c.SetQueryHandler(database.MaintenanceManifestClustersWithRunnableTasksQuery, func(client cosmosdb.MaintenanceManifestDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.MaintenanceManifestDocumentRawIterator {
 		return fakeMaintenanceManifestsClustersWithRunnableTasks(client, query, options, now)
 	})
@@ -181,6 +184,46 @@ func fakeMaintenanceManifestsForScheduleID(client cosmosdb.MaintenanceManifestDo
 	return cosmosdb.NewFakeMaintenanceManifestDocumentIterator(results, startingIndex)
 }
 
+func fakeMaintenanceManifestsForScheduleIDInWindow(client cosmosdb.MaintenanceManifestDocumentClient, query *cosmosdb.Query, options *cosmosdb.Options) cosmosdb.MaintenanceManifestDocumentRawIterator {
+	startingIndex, err := fakeMaintenanceManifestsGetContinuation(options)
+	if err != nil {
+		return cosmosdb.NewFakeMaintenanceManifestDocumentErroringRawIterator(err)
+	}
+
+	input, err := client.ListAll(context.Background(), nil)
+	if err != nil {
+		// TODO: should this never happen?
+		panic(err)
+	}
+
+	scheduleID := query.Parameters[0].Value
+	windowStart, err := strconv.ParseInt(query.Parameters[1].Value, 10, 64)
+	if err != nil {
+		return cosmosdb.NewFakeMaintenanceManifestDocumentErroringRawIterator(err)
+	}
+	windowEnd, err := strconv.ParseInt(query.Parameters[2].Value, 10, 64)
+	if err != nil {
+		return cosmosdb.NewFakeMaintenanceManifestDocumentErroringRawIterator(err)
+	}
+
+	var results []*api.MaintenanceManifestDocument
+	for _, r := range input.MaintenanceManifestDocuments {
+		if string(r.MaintenanceManifest.CreatedBySchedule) != scheduleID {
+			continue
+		}
+		if r.MaintenanceManifest.RunAfter < windowStart || r.MaintenanceManifest.RunAfter >= windowEnd {
+			continue
+		}
+		results = append(results, r)
+	}
+
+	slices.SortFunc(results, func(a, b *api.MaintenanceManifestDocument) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	return cosmosdb.NewFakeMaintenanceManifestDocumentIterator(results, startingIndex)
+}
+
 func fakeMaintenanceManifestsQueuedList(client cosmosdb.MaintenanceManifestDocumentClient, now func() time.Time) []*api.MaintenanceManifestDocument {
 	input, err := client.ListAll(context.Background(), nil)
 	if err != nil {
@@ -18,9 +18,9 @@ var _ = Describe("Monitor", func() {
 	// This is more of an integration test rather than E2E.
 	It("must run and must not return any errors", func(ctx context.Context) {
 		By("creating a new monitor instance for the test cluster")
-		mon, err := cluster.NewMonitor(log, clients.RestConfig, &api.OpenShiftCluster{
+		mon, err := cluster.NewMonitor(log, clients.RestConfig, nil, &api.OpenShiftCluster{
 			ID: resourceIDFromEnv(),
-		}, nil, "", &noop.Noop{}, true)
+		}, nil, "", &noop.Noop{}, true, nil)
 		Expect(err).NotTo(HaveOccurred())
 
 		By("running the monitor once")
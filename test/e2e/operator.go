@@ -896,6 +896,9 @@ var _ = Describe("ARO Operator - Guardrails", func() {
 				"aro-machines-deny",
 				"aro-machine-config-deny",
 				"aro-privileged-namespace-deny",
+				"aro-cloud-provider-config-deny",
+				"aro-operator-cluster-deny",
+				"aro-operator-namespace-deny",
 			}
 
 			for _, name := range expectedPolicies {
@@ -914,6 +917,9 @@ var _ = Describe("ARO Operator - Guardrails", func() {
 				"aro-machines-deny-binding",
 				"aro-machine-config-deny-binding",
 				"aro-privileged-namespace-deny-binding",
+				"aro-cloud-provider-config-deny-binding",
+				"aro-operator-cluster-deny-binding",
+				"aro-operator-namespace-deny-binding",
 			}
 
 			for _, name := range expectedBindings {
@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"maps"
+	"slices"
+	"sync"
 	"testing"
 
 	"github.com/puzpuzpuz/xsync/v4"
@@ -23,10 +25,18 @@ type fakeMetricsEmitter struct {
 	floats           *xsync.Map[string, float64]
 	assertedOnFloats bool // Have we asserted on the values of all floats?
 
+	// Counters and histograms are only required to be asserted upon if they
+	// were emitted
+	counters             *xsync.Map[string, int64]
+	assertedOnCounters   bool
+	histogramsMu         sync.Mutex
+	histograms           map[string][]float64
+	assertedOnHistograms bool
+
 	testOutput *bytes.Buffer
 }
 
-type MetricsAssertion[X int64 | float64] struct {
+type MetricsAssertion[X int64 | float64 | []float64] struct {
 	MetricName string
 	Dimensions map[string]string
 	Value      X
@@ -37,6 +47,8 @@ type FakeMetrics interface {
 
 	AssertFloats(...MetricsAssertion[float64])
 	AssertGauges(...MetricsAssertion[int64])
+	AssertCounters(...MetricsAssertion[int64])
+	AssertHistograms(...MetricsAssertion[[]float64])
 }
 
 func NewFakeMetricsEmitter(t testing.TB) *fakeMetricsEmitter {
@@ -46,8 +58,10 @@ func NewFakeMetricsEmitter(t testing.TB) *fakeMetricsEmitter {
 	e := &fakeMetricsEmitter{
 		t: t,
 
-		gauges: m,
-		floats: f,
+		gauges:     m,
+		floats:     f,
+		counters:   xsync.NewMap[string, int64](),
+		histograms: map[string][]float64{},
 	}
 
 	// handler to check we asserted on values
@@ -86,6 +100,14 @@ func (e *fakeMetricsEmitter) onCleanup() {
 		if !e.assertedOnGauges {
 			e.errorf("!!! did not assert on any metric gauges !!!")
 		}
+		if !e.assertedOnCounters && e.counters.Size() > 0 {
+			e.errorf("!!! did not assert on any metric counters !!!")
+		}
+		e.histogramsMu.Lock()
+		if !e.assertedOnHistograms && len(e.histograms) > 0 {
+			e.errorf("!!! did not assert on any metric histograms !!!")
+		}
+		e.histogramsMu.Unlock()
 	}
 }
 
@@ -99,6 +121,24 @@ func (e *fakeMetricsEmitter) EmitFloat(metricName string, metricValue float64, d
 	e.floats.Store(key, metricValue)
 }
 
+// EmitCounter sums the values emitted to each counter
+func (e *fakeMetricsEmitter) EmitCounter(metricName string, metricValue int64, dimensions map[string]string) {
+	key := getKey(metricName, dimensions)
+	e.counters.Compute(key, func(oldValue int64, loaded bool) (int64, xsync.ComputeOp) {
+		return oldValue + metricValue, xsync.UpdateOp
+	})
+}
+
+// EmitHistogram records each of the values observed by each histogram, in
+// order
+func (e *fakeMetricsEmitter) EmitHistogram(metricName string, metricValue float64, buckets []float64, dimensions map[string]string) {
+	key := getKey(metricName, dimensions)
+
+	e.histogramsMu.Lock()
+	defer e.histogramsMu.Unlock()
+	e.histograms[key] = append(e.histograms[key], metricValue)
+}
+
 func (e *fakeMetricsEmitter) AssertFloats(assertions ...MetricsAssertion[float64]) {
 	// check the assertions we have been given
 	for _, a := range assertions {
@@ -157,6 +197,71 @@ func (e *fakeMetricsEmitter) AssertGauges(assertions ...MetricsAssertion[int64])
 	e.assertedOnGauges = true
 }
 
+func (e *fakeMetricsEmitter) AssertCounters(assertions ...MetricsAssertion[int64]) {
+	// check the assertions we have been given
+	for _, a := range assertions {
+		seekingKey := getKey(a.MetricName, a.Dimensions)
+
+		val, ok := e.counters.LoadAndDelete(seekingKey)
+		if !ok {
+			e.errorf("counter metric '%s' with dims '%v' was not emitted", a.MetricName, a.Dimensions)
+		} else {
+			if val != a.Value {
+				e.errorf("counter metric '%s' with dims '%v' had incorrect emitted value %d, wanted %d", a.MetricName, a.Dimensions, val, a.Value)
+			}
+		}
+	}
+
+	for k := range e.counters.All() {
+		dims := map[string]string{}
+		err := json.Unmarshal([]byte(k), &dims)
+		if err != nil {
+			e.errorf("failed unmarshalling: %s", err.Error())
+		}
+		key := dims["__METRIC_NAME"]
+		delete(dims, "__METRIC_NAME")
+		e.errorf("counter metric '%s' with dims '%v' not asserted upon", key, dims)
+	}
+
+	e.assertedOnCounters = true
+}
+
+// AssertHistograms asserts on the values observed by each histogram, in the
+// order they were emitted
+func (e *fakeMetricsEmitter) AssertHistograms(assertions ...MetricsAssertion[[]float64]) {
+	e.histogramsMu.Lock()
+	defer e.histogramsMu.Unlock()
+
+	// check the assertions we have been given
+	for _, a := range assertions {
+		seekingKey := getKey(a.MetricName, a.Dimensions)
+
+		val, ok := e.histograms[seekingKey]
+		if !ok {
+			e.errorf("histogram metric '%s' with dims '%v' was not emitted", a.MetricName, a.Dimensions)
+		} else {
+			delete(e.histograms, seekingKey)
+			if !slices.Equal(val, a.Value) {
+				e.errorf("histogram metric '%s' with dims '%v' had incorrect emitted values %v, wanted %v", a.MetricName, a.Dimensions, val, a.Value)
+			}
+		}
+	}
+
+	for k := range e.histograms {
+		dims := map[string]string{}
+		err := json.Unmarshal([]byte(k), &dims)
+		if err != nil {
+			e.errorf("failed unmarshalling: %s", err.Error())
+		}
+		key := dims["__METRIC_NAME"]
+		delete(dims, "__METRIC_NAME")
+		e.errorf("histogram metric '%s' with dims '%v' not asserted upon", key, dims)
+	}
+	clear(e.histograms)
+
+	e.assertedOnHistograms = true
+}
+
 // Assert the value of a single gauge at a point in time. This is used for when
 // we are inside an operation (e.g. running a worker) and want to spot-check
 // that a metric was emitted before we got here (e.g. that the worker count was
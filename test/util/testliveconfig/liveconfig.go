@@ -39,6 +39,10 @@ func (t *testLiveConfig) DefaultInstallerPullSpecOverride(ctx context.Context) s
 	return ""
 }
 
+func (t *testLiveConfig) MonitorCollectors(ctx context.Context) (*liveconfig.MonitorCollectors, error) {
+	return &liveconfig.MonitorCollectors{}, nil
+}
+
 func NewTestLiveConfig(adoptByHive, installViaHive bool) liveconfig.Manager {
 	return &testLiveConfig{
 		adoptByHive:    adoptByHive,